@@ -23,6 +23,7 @@ import (
 
 	"github.com/piplabs/story/client/app/keepers"
 	"github.com/piplabs/story/client/comet"
+	evmenginekeeper "github.com/piplabs/story/client/x/evmengine/keeper"
 	evmstakingkeeper "github.com/piplabs/story/client/x/evmstaking/keeper"
 	mintkeeper "github.com/piplabs/story/client/x/mint/keeper"
 	"github.com/piplabs/story/lib/errors"
@@ -109,6 +110,10 @@ func newApp(
 
 		// Route proposed messages to keepers for verification and external state updates.
 		bapp.SetProcessProposal(makeProcessProposalHandler(makeProcessProposalRouter(app), app.txConfig))
+
+		// Validators attest to the execution block of each block via vote extensions.
+		bapp.SetExtendVoteHandler(app.Keepers.EVMEngKeeper.ExtendVote)
+		bapp.SetVerifyVoteExtensionHandler(app.Keepers.EVMEngKeeper.VerifyVoteExtension)
 	})
 
 	app.App = appBuilder.Build(db, nil, baseAppOpts...)
@@ -168,10 +173,6 @@ func (App) LegacyAmino() *codec.LegacyAmino {
 	return nil
 }
 
-func (App) ExportAppStateAndValidators(_ bool, _, _ []string) (servertypes.ExportedApp, error) {
-	return servertypes.ExportedApp{}, errors.New("not implemented")
-}
-
 func (App) SimulationManager() *module.SimulationManager {
 	return nil
 }
@@ -182,6 +183,10 @@ func (a App) SetCometAPI(api comet.API) {
 	a.Keepers.EVMEngKeeper.SetCometAPI(api)
 }
 
+func (a App) GetEvmEngineKeeper() *evmenginekeeper.Keeper {
+	return a.Keepers.EVMEngKeeper
+}
+
 func (a App) GetEvmStakingKeeper() *evmstakingkeeper.Keeper {
 	return a.Keepers.EvmStakingKeeper
 }
@@ -0,0 +1,274 @@
+package app
+
+import (
+	"encoding/json"
+
+	cmtproto "github.com/cometbft/cometbft/proto/tendermint/types"
+	servertypes "github.com/cosmos/cosmos-sdk/server/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	dtypes "github.com/cosmos/cosmos-sdk/x/distribution/types"
+	slashingtypes "github.com/cosmos/cosmos-sdk/x/slashing/types"
+	"github.com/cosmos/cosmos-sdk/x/staking"
+	stypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+
+	"github.com/piplabs/story/lib/errors"
+)
+
+// ExportAppStateAndValidators exports the state of the application at the latest height for a genesis file.
+func (a *App) ExportAppStateAndValidators(forZeroHeight bool, jailAllowedAddrs, modulesToExport []string,
+) (servertypes.ExportedApp, error) {
+	return a.ExportAppStateAndValidatorsAtHeight(a.LastBlockHeight(), forZeroHeight, jailAllowedAddrs, modulesToExport)
+}
+
+// ExportAppStateAndValidatorsAtHeight exports the state of the application at the given committed height
+// for a genesis file. The height must not be pruned. The state is exported from a cached store, so preparing
+// it for a zero height genesis doesn't modify the committed state.
+func (a *App) ExportAppStateAndValidatorsAtHeight(height int64, forZeroHeight bool, jailAllowedAddrs, modulesToExport []string,
+) (servertypes.ExportedApp, error) {
+	if height <= 0 || height > a.LastBlockHeight() {
+		return servertypes.ExportedApp{}, errors.New("invalid export height", "height", height, "latest", a.LastBlockHeight())
+	}
+
+	cms, err := a.CommitMultiStore().CacheMultiStoreWithVersion(height)
+	if err != nil {
+		return servertypes.ExportedApp{}, errors.Wrap(err, "load store version", "height", height)
+	}
+	ctx := sdk.NewContext(cms, cmtproto.Header{ChainID: a.ChainID(), Height: height}, false, a.Logger())
+
+	// The exported genesis continues at the next height, unless the chain restarts at height zero.
+	genesisHeight := height + 1
+	if forZeroHeight {
+		genesisHeight = 0
+		if err := a.prepForZeroHeightGenesis(ctx, jailAllowedAddrs); err != nil {
+			return servertypes.ExportedApp{}, errors.Wrap(err, "prepare zero height genesis")
+		}
+	}
+
+	genState, err := a.ModuleManager.ExportGenesisForModules(ctx, a.appCodec, modulesToExport)
+	if err != nil {
+		return servertypes.ExportedApp{}, errors.Wrap(err, "export genesis")
+	}
+
+	appState, err := json.MarshalIndent(genState, "", "  ")
+	if err != nil {
+		return servertypes.ExportedApp{}, errors.Wrap(err, "marshal app state")
+	}
+
+	validators, err := staking.WriteValidators(ctx, a.Keepers.StakingKeeper)
+	if err != nil {
+		return servertypes.ExportedApp{}, errors.Wrap(err, "write validators")
+	}
+
+	return servertypes.ExportedApp{
+		AppState:        appState,
+		Validators:      validators,
+		Height:          genesisHeight,
+		ConsensusParams: a.GetConsensusParams(ctx),
+	}, nil
+}
+
+// prepForZeroHeightGenesis prepares the state for a chain restarting at height zero.
+// All rewards are withdrawn, so the distribution records can be reinitialized at height zero,
+// and the heights referencing the previous chain are reset. Validators not in jailAllowedAddrs
+// are jailed, unless it is empty.
+func (a *App) prepForZeroHeightGenesis(ctx sdk.Context, jailAllowedAddrs []string) error {
+	allowedAddrs := make(map[string]bool)
+	for _, addr := range jailAllowedAddrs {
+		if _, err := sdk.ValAddressFromBech32(addr); err != nil {
+			return errors.Wrap(err, "invalid jail allowed address", "address", addr)
+		}
+		allowedAddrs[addr] = true
+	}
+
+	if err := a.prepDistributionForZeroHeight(ctx); err != nil {
+		return errors.Wrap(err, "prepare distribution")
+	}
+	if err := a.prepStakingForZeroHeight(ctx, allowedAddrs); err != nil {
+		return errors.Wrap(err, "prepare staking")
+	}
+
+	// Reset the start height of the signing infos.
+	var signingErr error
+	err := a.Keepers.SlashingKeeper.IterateValidatorSigningInfos(ctx, func(addr sdk.ConsAddress, info slashingtypes.ValidatorSigningInfo) bool {
+		info.StartHeight = 0
+		signingErr = a.Keepers.SlashingKeeper.SetValidatorSigningInfo(ctx, addr, info)
+
+		return signingErr != nil
+	})
+	if err != nil {
+		return errors.Wrap(err, "iterate signing infos")
+	} else if signingErr != nil {
+		return errors.Wrap(signingErr, "set signing info")
+	}
+
+	if err := a.Keepers.EvmStakingKeeper.PrepForZeroHeightGenesis(ctx); err != nil {
+		return errors.Wrap(err, "prepare evmstaking")
+	}
+	if err := a.Keepers.EVMEngKeeper.PrepForZeroHeightGenesis(ctx); err != nil {
+		return errors.Wrap(err, "prepare evmengine")
+	}
+
+	return nil
+}
+
+// prepDistributionForZeroHeight withdraws all commissions and delegation rewards, and reinitializes
+// the distribution records of all validators and delegations at height zero. The withdrawn rewards
+// are swept to the EVM by x/evmstaking as claimed rewards once the chain restarts.
+func (a *App) prepDistributionForZeroHeight(ctx sdk.Context) error {
+	distrKeeper, stakingKeeper := a.Keepers.DistrKeeper, a.Keepers.StakingKeeper
+
+	validators, err := stakingKeeper.GetAllValidators(ctx)
+	if err != nil {
+		return errors.Wrap(err, "get validators")
+	}
+	delegations, err := stakingKeeper.GetAllDelegations(ctx)
+	if err != nil {
+		return errors.Wrap(err, "get delegations")
+	}
+
+	valAddrs := make([]sdk.ValAddress, 0, len(validators))
+	for _, val := range validators {
+		valAddr, err := sdk.ValAddressFromBech32(val.GetOperator())
+		if err != nil {
+			return errors.Wrap(err, "validator address from bech32")
+		}
+		valAddrs = append(valAddrs, valAddr)
+
+		if _, err := distrKeeper.WithdrawValidatorCommission(ctx, valAddr); err != nil && !errors.Is(err, dtypes.ErrNoValidatorCommission) {
+			return errors.Wrap(err, "withdraw validator commission", "validator", val.GetOperator())
+		}
+	}
+
+	for _, del := range delegations {
+		valAddr, err := sdk.ValAddressFromBech32(del.GetValidatorAddr())
+		if err != nil {
+			return errors.Wrap(err, "validator address from bech32")
+		}
+		delAddr, err := sdk.AccAddressFromBech32(del.GetDelegatorAddr())
+		if err != nil {
+			return errors.Wrap(err, "delegator address from bech32")
+		}
+		if _, err := distrKeeper.WithdrawDelegationRewards(ctx, delAddr, valAddr); err != nil {
+			return errors.Wrap(err, "withdraw delegation rewards", "delegator", del.GetDelegatorAddr())
+		}
+	}
+
+	distrKeeper.DeleteAllValidatorSlashEvents(ctx)
+	distrKeeper.DeleteAllValidatorHistoricalRewards(ctx)
+
+	// Reinitialize the distribution records at height zero.
+	ctx = ctx.WithBlockHeight(0)
+
+	for _, valAddr := range valAddrs {
+		// Donate any unwithdrawn outstanding reward fractions to the ubi pool.
+		scraps, err := distrKeeper.GetValidatorOutstandingRewardsCoins(ctx, valAddr)
+		if err != nil {
+			return errors.Wrap(err, "get outstanding rewards")
+		}
+		feePool, err := distrKeeper.FeePool.Get(ctx)
+		if err != nil {
+			return errors.Wrap(err, "get fee pool")
+		}
+		feePool.Ubi = feePool.Ubi.Add(scraps...)
+		if err := distrKeeper.FeePool.Set(ctx, feePool); err != nil {
+			return errors.Wrap(err, "set fee pool")
+		}
+
+		if err := distrKeeper.Hooks().AfterValidatorCreated(ctx, valAddr); err != nil {
+			return errors.Wrap(err, "reinitialize validator")
+		}
+	}
+
+	for _, del := range delegations {
+		valAddr, err := sdk.ValAddressFromBech32(del.GetValidatorAddr())
+		if err != nil {
+			return errors.Wrap(err, "validator address from bech32")
+		}
+		delAddr, err := sdk.AccAddressFromBech32(del.GetDelegatorAddr())
+		if err != nil {
+			return errors.Wrap(err, "delegator address from bech32")
+		}
+		if err := distrKeeper.Hooks().BeforeDelegationCreated(ctx, delAddr, valAddr); err != nil {
+			return errors.Wrap(err, "increment validator period")
+		}
+		if err := distrKeeper.Hooks().AfterDelegationModified(ctx, delAddr, valAddr); err != nil {
+			return errors.Wrap(err, "reinitialize delegation")
+		}
+	}
+
+	return nil
+}
+
+// prepStakingForZeroHeight resets the creation and unbonding heights, jails the validators
+// not allowed, and shifts the singularity height by the exported height.
+func (a *App) prepStakingForZeroHeight(ctx sdk.Context, allowedAddrs map[string]bool) error {
+	stakingKeeper := a.Keepers.StakingKeeper
+
+	var redErr error
+	err := stakingKeeper.IterateRedelegations(ctx, func(_ int64, red stypes.Redelegation) bool {
+		for i := range red.Entries {
+			red.Entries[i].CreationHeight = 0
+		}
+		redErr = stakingKeeper.SetRedelegation(ctx, red)
+
+		return redErr != nil
+	})
+	if err != nil {
+		return errors.Wrap(err, "iterate redelegations")
+	} else if redErr != nil {
+		return errors.Wrap(redErr, "set redelegation")
+	}
+
+	var ubdErr error
+	err = stakingKeeper.IterateUnbondingDelegations(ctx, func(_ int64, ubd stypes.UnbondingDelegation) bool {
+		for i := range ubd.Entries {
+			ubd.Entries[i].CreationHeight = 0
+		}
+		ubdErr = stakingKeeper.SetUnbondingDelegation(ctx, ubd)
+
+		return ubdErr != nil
+	})
+	if err != nil {
+		return errors.Wrap(err, "iterate unbonding delegations")
+	} else if ubdErr != nil {
+		return errors.Wrap(ubdErr, "set unbonding delegation")
+	}
+
+	validators, err := stakingKeeper.GetAllValidators(ctx)
+	if err != nil {
+		return errors.Wrap(err, "get validators")
+	}
+	for _, val := range validators {
+		val.UnbondingHeight = 0
+		if err := stakingKeeper.SetValidator(ctx, val); err != nil {
+			return errors.Wrap(err, "set validator")
+		}
+
+		if len(allowedAddrs) == 0 || allowedAddrs[val.GetOperator()] || val.IsJailed() {
+			continue
+		}
+		consAddr, err := val.GetConsAddr()
+		if err != nil {
+			return errors.Wrap(err, "get validator consensus address")
+		}
+		if err := stakingKeeper.Jail(ctx, consAddr); err != nil {
+			return errors.Wrap(err, "jail validator", "validator", val.GetOperator())
+		}
+	}
+
+	if _, err := stakingKeeper.ApplyAndReturnValidatorSetUpdates(ctx); err != nil {
+		return errors.Wrap(err, "apply validator set updates")
+	}
+
+	// The singularity ends at the same point of the restarted chain.
+	params, err := stakingKeeper.GetParams(ctx)
+	if err != nil {
+		return errors.Wrap(err, "get staking params")
+	}
+	params.SingularityHeight -= min(params.SingularityHeight, uint64(ctx.BlockHeight()))
+	if err := stakingKeeper.SetParams(ctx, params); err != nil {
+		return errors.Wrap(err, "set staking params")
+	}
+
+	return nil
+}
@@ -0,0 +1,161 @@
+package app
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+
+	"cosmossdk.io/log"
+	storetypes "cosmossdk.io/store/types"
+
+	abci "github.com/cometbft/cometbft/abci/types"
+	k1 "github.com/cometbft/cometbft/crypto/secp256k1"
+	cmttypes "github.com/cometbft/cometbft/types"
+	dbm "github.com/cosmos/cosmos-db"
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	simtestutil "github.com/cosmos/cosmos-sdk/testutil/sims"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	stypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+	"github.com/stretchr/testify/require"
+
+	evmenginetypes "github.com/piplabs/story/client/x/evmengine/types"
+	evmstakingtypes "github.com/piplabs/story/client/x/evmstaking/types"
+	"github.com/piplabs/story/lib/ethclient"
+)
+
+const (
+	testChainID           = "story-test"
+	testSingularityHeight = 100
+)
+
+//nolint:gochecknoglobals // Fixed test genesis time.
+var testGenesisTime = time.Unix(1_700_000_000, 0)
+
+func TestExportAppStateAndValidatorsAtHeight(t *testing.T) {
+	t.Parallel()
+	app := newTestApp(t)
+
+	finalizeBlock(t, app, 1)
+	latest, err := app.ExportAppStateAndValidators(false, nil, nil)
+	require.NoError(t, err)
+	require.EqualValues(t, 2, latest.Height) // The exported genesis continues at the next height.
+	require.Len(t, latest.Validators, 1)
+
+	finalizeBlock(t, app, 2)
+	next, err := app.ExportAppStateAndValidators(false, nil, nil)
+	require.NoError(t, err)
+	require.NotEqual(t, latest.AppState, next.AppState) // The ubi withdrawal is pruned at height 2.
+
+	// The state is exported at the pinned height, not the latest one.
+	pinned, err := app.ExportAppStateAndValidatorsAtHeight(1, false, nil, nil)
+	require.NoError(t, err)
+	require.Equal(t, latest, pinned)
+
+	_, err = app.ExportAppStateAndValidatorsAtHeight(3, false, nil, nil)
+	require.ErrorContains(t, err, "invalid export height")
+	_, err = app.ExportAppStateAndValidatorsAtHeight(0, false, nil, nil)
+	require.ErrorContains(t, err, "invalid export height")
+}
+
+func TestExportAppStateAndValidatorsAtHeight_ForZeroHeight(t *testing.T) {
+	t.Parallel()
+	app := newTestApp(t)
+	finalizeBlock(t, app, 1)
+	finalizeBlock(t, app, 2)
+
+	// The state is prepared at the pinned height, before the ubi withdrawal is pruned.
+	exported, err := app.ExportAppStateAndValidatorsAtHeight(1, true, nil, nil)
+	require.NoError(t, err)
+	require.EqualValues(t, 0, exported.Height)
+	require.Len(t, exported.Validators, 1)
+
+	var genState map[string]json.RawMessage
+	require.NoError(t, json.Unmarshal(exported.AppState, &genState))
+
+	// The singularity ends at the same point of the restarted chain.
+	var stakingGenesis stypes.GenesisState
+	app.appCodec.MustUnmarshalJSON(genState[stypes.ModuleName], &stakingGenesis)
+	require.EqualValues(t, testSingularityHeight-1, stakingGenesis.Params.SingularityHeight)
+
+	// The evmstaking state keyed by the heights of the previous chain is reset.
+	var evmstakingGenesis evmstakingtypes.GenesisState
+	app.appCodec.MustUnmarshalJSON(genState[evmstakingtypes.ModuleName], &evmstakingGenesis)
+	require.Empty(t, evmstakingGenesis.UbiWithdrawals)
+	require.Len(t, evmstakingGenesis.FailedEvents, 1)
+	require.Zero(t, evmstakingGenesis.FailedEvents[0].Height)
+
+	var evmengGenesis evmenginetypes.GenesisState
+	app.appCodec.MustUnmarshalJSON(genState[evmenginetypes.ModuleName], &evmengGenesis)
+	require.Empty(t, evmengGenesis.HeadHistory)
+
+	// The committed state isn't modified.
+	params, err := app.Keepers.StakingKeeper.GetParams(app.NewContext(true))
+	require.NoError(t, err)
+	require.EqualValues(t, testSingularityHeight, params.SingularityHeight)
+}
+
+// newTestApp returns an app initialized with a single validator, a failed event at height 1, and
+// a ubi withdrawal at height 1 that is pruned at height 2, on a mock execution engine.
+func newTestApp(t *testing.T) *App {
+	t.Helper()
+
+	engineCl, err := ethclient.NewEngineMock(storetypes.NewKVStoreKey("engine_mock"))
+	require.NoError(t, err)
+	app, err := newApp(log.NewNopLogger(), dbm.NewMemDB(), engineCl, baseapp.SetChainID(testChainID))
+	require.NoError(t, err)
+
+	genesisBlock, err := ethclient.MockGenesisBlock()
+	require.NoError(t, err)
+
+	genState := app.DefaultGenesis()
+
+	evmengGenesis := evmenginetypes.DefaultGenesisState()
+	evmengGenesis.Params.ExecutionBlockHash = genesisBlock.Hash().Bytes()
+	genState[evmenginetypes.ModuleName] = app.appCodec.MustMarshalJSON(evmengGenesis)
+
+	evmstakingGenesis := evmstakingtypes.DefaultGenesisState()
+	evmstakingGenesis.Params.UbiWithdrawalRetention = 1
+	evmstakingGenesis.UbiWithdrawals = []evmstakingtypes.Withdrawal{{CreationHeight: 1, Amount: 1}}
+	evmstakingGenesis.FailedEvents = []evmstakingtypes.FailedEvent{{Id: 0, Height: 1, EventType: "Deposit"}}
+	evmstakingGenesis.NextFailedEventId = 1
+	genState[evmstakingtypes.ModuleName] = app.appCodec.MustMarshalJSON(evmstakingGenesis)
+
+	pubKey := k1.GenPrivKey().PubKey()
+	valSet := cmttypes.NewValidatorSet([]*cmttypes.Validator{cmttypes.NewValidator(pubKey, 1)})
+	acc := authtypes.NewBaseAccount(sdk.AccAddress(pubKey.Address()), nil, 0, 0)
+	genState, err = simtestutil.GenesisStateWithValSet(app.appCodec, genState, valSet, []authtypes.GenesisAccount{acc})
+	require.NoError(t, err)
+
+	var stakingGenesis stypes.GenesisState
+	app.appCodec.MustUnmarshalJSON(genState[stypes.ModuleName], &stakingGenesis)
+	stakingGenesis.Params.SingularityHeight = testSingularityHeight
+	genState[stypes.ModuleName] = app.appCodec.MustMarshalJSON(&stakingGenesis)
+
+	appState, err := json.Marshal(genState)
+	require.NoError(t, err)
+
+	_, err = app.InitChain(&abci.RequestInitChain{
+		Time:            testGenesisTime,
+		ChainId:         testChainID,
+		ConsensusParams: simtestutil.DefaultConsensusParams,
+		AppStateBytes:   appState,
+		InitialHeight:   1,
+	})
+	require.NoError(t, err)
+
+	return app
+}
+
+// finalizeBlock finalizes and commits an empty block at the height.
+func finalizeBlock(t *testing.T, app *App, height int64) {
+	t.Helper()
+
+	_, err := app.FinalizeBlock(&abci.RequestFinalizeBlock{
+		Height: height,
+		Time:   testGenesisTime.Add(time.Duration(height) * time.Second),
+	})
+	require.NoError(t, err)
+	_, err = app.Commit()
+	require.NoError(t, err)
+}
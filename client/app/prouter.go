@@ -29,7 +29,7 @@ func makeProcessProposalRouter(app *App) *baseapp.MsgServiceRouter {
 // It also updates some external state.
 func makeProcessProposalHandler(router *baseapp.MsgServiceRouter, txConfig client.TxConfig) sdk.ProcessProposalHandler {
 	return func(ctx sdk.Context, req *abci.RequestProcessProposal) (*abci.ResponseProcessProposal, error) {
-		// Ensure the proposal includes quorum vote extensions (unless first block).
+		// Ensure the proposed last commit includes quorum votes (unless first block).
 		if req.Height > 1 {
 			var totalPower, votedPower int64
 			for _, vote := range req.ProposedLastCommit.Votes {
@@ -40,15 +40,18 @@ func makeProcessProposalHandler(router *baseapp.MsgServiceRouter, txConfig clien
 				votedPower += vote.Validator.Power
 			}
 			if totalPower*2/3 >= votedPower {
-				return rejectProposal(ctx, errors.New("proposed doesn't include quorum votes extensions"))
+				return rejectProposal(ctx, errors.New("proposed last commit doesn't include quorum votes"))
 			}
 		}
 
 		// Ensure only expected messages types are included the expected number of times.
 		allowedMsgCounts := map[string]int{
 			sdk.MsgTypeURL(&evmenginetypes.MsgExecutionPayload{}): 1, // Only a single EVM execution payload is allowed.
+			sdk.MsgTypeURL(&evmenginetypes.MsgAddVotes{}):         1, // Only a single set of vote extensions is allowed.
 		}
 
+		// Note that proposals without transactions are valid, e.g. proposers propose an empty block
+		// if their EVM failed to provide a payload before the proposal deadline.
 		for _, rawTX := range req.Txs {
 			tx, err := txConfig.TxDecoder()(rawTX)
 			if err != nil {
@@ -78,6 +81,12 @@ func makeProcessProposalHandler(router *baseapp.MsgServiceRouter, txConfig clien
 			}
 		}
 
+		// Ensure the vote extensions of the previous block are included once they are enabled,
+		// so that the attestation of its execution block is recorded.
+		if evmenginetypes.VoteExtensionsEnabled(ctx) && allowedMsgCounts[sdk.MsgTypeURL(&evmenginetypes.MsgAddVotes{})] > 0 {
+			return rejectProposal(ctx, errors.New("proposal doesn't include vote extensions"))
+		}
+
 		return &abci.ResponseProcessProposal{Status: abci.ResponseProcessProposal_ACCEPT}, nil
 	}
 }
@@ -79,6 +79,10 @@ func Start(ctx context.Context, cfg Config) (func(context.Context) error, error)
 		return nil, errors.Wrap(err, "verify story config")
 	}
 
+	if err := verifyRandaoForkHeight(cfg); err != nil {
+		return nil, errors.Wrap(err, "verify randao fork height")
+	}
+
 	buildinfo.Instrument(ctx)
 
 	tracerIDs := tracer.Identifiers{Network: cfg.Network, Service: "story", Instance: cfg.Comet.Moniker}
@@ -123,6 +127,10 @@ func Start(ctx context.Context, cfg Config) (func(context.Context) error, error)
 	}
 	app.Keepers.EVMEngKeeper.SetBuildDelay(cfg.EVMBuildDelay)
 	app.Keepers.EVMEngKeeper.SetBuildOptimistic(cfg.EVMBuildOptimistic)
+	app.Keepers.EVMEngKeeper.SetProposalDeadline(cfg.EVMProposalDeadline)
+	app.Keepers.EVMEngKeeper.SetPragueTime(cfg.Network.Static().PragueTime)
+	app.Keepers.EVMEngKeeper.SetRandaoForkHeight(cfg.Network.Static().RandaoForkHeight)
+	app.Keepers.EVMEngKeeper.SetOptimisticPayloadFile(cfg.OptimisticPayloadFile())
 
 	addr, err := k1util.PubKeyToAddress(privVal.Key.PrivKey.PubKey())
 	if err != nil {
@@ -130,6 +138,15 @@ func Start(ctx context.Context, cfg Config) (func(context.Context) error, error)
 	}
 	app.Keepers.EVMEngKeeper.SetValidatorAddress(addr)
 
+	if err := app.Keepers.EVMEngKeeper.LoadOptimisticPayload(app.NewContext(true).WithContext(ctx)); err != nil {
+		return nil, errors.Wrap(err, "load optimistic payload")
+	}
+
+	// Ensure the EL is on the CL execution head before CometBFT starts driving it.
+	if err := app.Keepers.EVMEngKeeper.ReconcileExecutionHead(app.NewContext(true).WithContext(ctx), cfg.EVMReconcileHead); err != nil {
+		return nil, errors.Wrap(err, "reconcile execution head")
+	}
+
 	cmtNode, err := newCometNode(ctx, &cfg.Comet, app, privVal)
 	if err != nil {
 		return nil, errors.Wrap(err, "create comet node")
@@ -218,6 +235,10 @@ func CreateApp(ctx context.Context, cfg Config) *App {
 	}
 	app.Keepers.EVMEngKeeper.SetBuildDelay(cfg.EVMBuildDelay)
 	app.Keepers.EVMEngKeeper.SetBuildOptimistic(cfg.EVMBuildOptimistic)
+	app.Keepers.EVMEngKeeper.SetProposalDeadline(cfg.EVMProposalDeadline)
+	app.Keepers.EVMEngKeeper.SetPragueTime(cfg.Network.Static().PragueTime)
+	app.Keepers.EVMEngKeeper.SetRandaoForkHeight(cfg.Network.Static().RandaoForkHeight)
+	app.Keepers.EVMEngKeeper.SetOptimisticPayloadFile(cfg.OptimisticPayloadFile())
 
 	addr, err := k1util.PubKeyToAddress(privVal.Key.PrivKey.PubKey())
 	if err != nil {
@@ -318,6 +339,32 @@ func chainIDFromGenesis(cfg Config) (string, error) {
 	return genDoc.ChainID, nil
 }
 
+// verifyRandaoForkHeight returns an error if the randao fork of the network is scheduled before vote extensions
+// are enabled by the genesis consensus params, since the randao mix is built from the vote extension signatures.
+func verifyRandaoForkHeight(cfg Config) error {
+	forkHeight := cfg.Network.Static().RandaoForkHeight
+	if forkHeight == nil {
+		return nil
+	}
+
+	genDoc, err := node.DefaultGenesisDocProviderFunc(&cfg.Comet)()
+	if err != nil {
+		return errors.Wrap(err, "load genesis doc")
+	} else if genDoc.ConsensusParams == nil {
+		return errors.New("missing genesis consensus params")
+	}
+
+	enableHeight := genDoc.ConsensusParams.ABCI.VoteExtensionsEnableHeight
+	if enableHeight == 0 || *forkHeight < enableHeight {
+		return errors.New("randao fork height below vote extensions enable height",
+			"fork_height", *forkHeight,
+			"enable_height", enableHeight,
+		)
+	}
+
+	return nil
+}
+
 // newEngineClient returns a new engine API client.
 func newEngineClient(ctx context.Context, cfg Config) (ethclient.EngineClient, error) {
 	jwtBytes, err := ethclient.LoadJWTHexFile(cfg.EngineJWTFile)
@@ -9,6 +9,7 @@ import (
 
 	"github.com/piplabs/story/client/app/upgrades"
 	"github.com/piplabs/story/client/app/upgrades/v0_12_1"
+	"github.com/piplabs/story/client/app/upgrades/v0_13_0"
 )
 
 var (
@@ -16,6 +17,7 @@ var (
 	// New upgrades should be added to this slice after they are implemented.
 	Upgrades = []upgrades.Upgrade{
 		v0_12_1.Upgrade,
+		v0_13_0.Upgrade,
 	}
 	// Forks are for hard forks that breaks backward compatibility.
 	Forks = []upgrades.Fork{}
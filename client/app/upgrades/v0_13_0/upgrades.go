@@ -0,0 +1,105 @@
+//nolint:revive,stylecheck // version underscores
+package v0_13_0
+
+import (
+	"context"
+
+	upgradetypes "cosmossdk.io/x/upgrade/types"
+
+	"github.com/cosmos/cosmos-sdk/types/module"
+
+	"github.com/piplabs/story/client/app/keepers"
+	evmenginetypes "github.com/piplabs/story/client/x/evmengine/types"
+	evmstakingtypes "github.com/piplabs/story/client/x/evmstaking/types"
+	"github.com/piplabs/story/lib/errors"
+	"github.com/piplabs/story/lib/log"
+)
+
+// CreateUpgradeHandler returns the v0.13.0 upgrade handler. The x/evmstaking migrations seed the
+// global withdrawal index from the fronts of the withdrawal queues, so the indexes assigned after
+// the upgrade don't collide with the ones assigned before (Migrate1to2). They also move the single
+// operator of each delegator to the delegator operators, granted all permissions, so the existing
+// operators keep acting on behalf of their delegators (Migrate2to3). The params enabling the features
+// that change the state transition are set after the migrations, so blocks before the upgrade are
+// replayed without them. The IPTokenStaking contract params are seeded from the params of its genesis
+// alloc fixed in the binary, since their events before the upgrade weren't synced.
+func CreateUpgradeHandler(
+	mm *module.Manager,
+	configurator module.Configurator,
+	keepers *keepers.Keepers,
+) upgradetypes.UpgradeHandler {
+	return func(ctx context.Context, _ upgradetypes.Plan, vm module.VersionMap) (module.VersionMap, error) {
+		log.Info(ctx, "Starting module migrations...")
+
+		vm, err := mm.RunMigrations(ctx, configurator, vm)
+		if err != nil {
+			return vm, errors.Wrap(err, "run migrations")
+		}
+
+		log.Info(ctx, "Enabling evmengine params...")
+		if err := enableEvmEngineParams(ctx, keepers); err != nil {
+			return vm, errors.Wrap(err, "enable evmengine params")
+		}
+
+		log.Info(ctx, "Enabling evmstaking params...")
+		if err := enableEvmStakingParams(ctx, keepers); err != nil {
+			return vm, errors.Wrap(err, "enable evmstaking params")
+		}
+
+		log.Info(ctx, "Seeding IPTokenStaking contract params...")
+		if err := seedContractParams(ctx, keepers); err != nil {
+			return vm, errors.Wrap(err, "seed contract params")
+		}
+
+		log.Info(ctx, "Upgrade v0.13.0 complete")
+
+		return vm, nil
+	}
+}
+
+// enableEvmEngineParams includes the positions of EVM events in proposals, and only the verified events of
+// the event processors. It also tracks the UBI distributed to and claimed by validators, validates the gas limit
+// and extra data of proposed payloads, and prunes the execution head history, which was kept in full before.
+func enableEvmEngineParams(ctx context.Context, keepers *keepers.Keepers) error {
+	params, err := keepers.EVMEngKeeper.GetParams(ctx)
+	if err != nil {
+		return errors.Wrap(err, "get params")
+	}
+
+	params.EvmEventPositions = true
+	params.VerifyEventLogs = true
+	params.TrackValidatorUbi = true
+	params.HeadHistoryRetention = evmenginetypes.DefaultHeadHistoryRetention
+	params.TargetGasLimit = evmenginetypes.DefaultTargetGasLimit
+	params.MaxGasLimitDelta = evmenginetypes.DefaultMaxGasLimitDelta
+	params.MaxExtraDataLength = evmenginetypes.DefaultMaxExtraDataLength
+
+	return keepers.EVMEngKeeper.SetParams(ctx, params)
+}
+
+// enableEvmStakingParams records the EVM events that failed to be processed and the UBI withdrawals.
+func enableEvmStakingParams(ctx context.Context, keepers *keepers.Keepers) error {
+	params, err := keepers.EvmStakingKeeper.GetParams(ctx)
+	if err != nil {
+		return errors.Wrap(err, "get params")
+	}
+
+	params.RecordFailedEvents = true
+	params.FailedEventRetention = evmstakingtypes.DefaultFailedEventRetention
+	params.RecordUbiWithdrawals = true
+	params.UbiWithdrawalRetention = evmstakingtypes.DefaultUbiWithdrawalRetention
+
+	return keepers.EvmStakingKeeper.SetParams(ctx, params)
+}
+
+// seedContractParams sets the evmstaking params synced from the IPTokenStaking contract to the params of its
+// genesis alloc, and enables their sync and enforcement. The params are fixed in the binary instead of read from
+// the contract storage via the EL, so that all nodes deterministically seed the same params.
+func seedContractParams(ctx context.Context, keepers *keepers.Keepers) error {
+	return keepers.EvmStakingKeeper.SeedContractParams(ctx,
+		evmstakingtypes.GenesisMinStakeAmount,
+		evmstakingtypes.GenesisMinUnstakeAmount,
+		evmstakingtypes.GenesisMinCommissionRate,
+		evmstakingtypes.GenesisFee,
+	)
+}
@@ -28,6 +28,7 @@ func New() *cobra.Command {
 		newStatusCmd(),
 		newKeyCmds(),
 		newRollbackCmd(app.CreateApp),
+		newExportCmd(func(ctx context.Context, cfg app.Config) exporter { return app.CreateApp(ctx, cfg) }),
 	)
 }
 
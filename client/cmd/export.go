@@ -0,0 +1,124 @@
+package cmd
+
+import (
+	"context"
+	"fmt"
+
+	cmtjson "github.com/cometbft/cometbft/libs/json"
+	cmttypes "github.com/cometbft/cometbft/types"
+	servertypes "github.com/cosmos/cosmos-sdk/server/types"
+	"github.com/spf13/cobra"
+
+	"github.com/piplabs/story/client/app"
+	storycfg "github.com/piplabs/story/client/config"
+	libcmd "github.com/piplabs/story/lib/cmd"
+	"github.com/piplabs/story/lib/errors"
+	"github.com/piplabs/story/lib/log"
+)
+
+// latestHeight is the export height flag value for the latest committed height.
+const latestHeight = -1
+
+// ExportConfig is the config for the export command.
+type ExportConfig struct {
+	Height           int64
+	ForZeroHeight    bool
+	JailAllowedAddrs []string
+	ModulesToExport  []string
+	OutputDocument   string
+}
+
+// exporter exports the application state at a committed height, see app.App.
+type exporter interface {
+	LastBlockHeight() int64
+	ExportAppStateAndValidatorsAtHeight(height int64, forZeroHeight bool, jailAllowedAddrs, modulesToExport []string,
+	) (servertypes.ExportedApp, error)
+}
+
+// newExportCmd returns a new cobra command that exports the story consensus client state to a genesis file.
+func newExportCmd(appCreateFunc func(context.Context, app.Config) exporter) *cobra.Command {
+	storyCfg := storycfg.DefaultConfig()
+	logCfg := log.DefaultConfig()
+	exportCfg := ExportConfig{Height: latestHeight}
+
+	cmd := &cobra.Command{
+		Use:   "export",
+		Short: "Export state to a genesis file",
+		Long: `
+Export the application state at a committed height to a genesis file, e.g., to restart
+the chain after an incident. The node must not be running. The genesis file of the
+node home is used as the template, replacing its app state, validators, initial height
+and consensus params. With --for-zero-height, all rewards are withdrawn and the heights
+referencing the previous chain are reset, so the chain can restart at height zero.
+`,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			ctx, err := log.Init(cmd.Context(), logCfg)
+			if err != nil {
+				return err
+			}
+			if err := libcmd.LogFlags(ctx, cmd.Flags()); err != nil {
+				return err
+			}
+
+			cometCfg, err := parseCometConfig(ctx, storyCfg.HomeDir)
+			if err != nil {
+				return err
+			}
+
+			genDoc, err := cmttypes.GenesisDocFromFile(cometCfg.GenesisFile())
+			if err != nil {
+				return errors.Wrap(err, "load genesis file")
+			}
+
+			app := appCreateFunc(ctx, app.Config{
+				Config: storyCfg,
+				Comet:  cometCfg,
+			})
+
+			height := exportCfg.Height
+			if height == latestHeight {
+				height = app.LastBlockHeight()
+			}
+
+			exported, err := app.ExportAppStateAndValidatorsAtHeight(height, exportCfg.ForZeroHeight,
+				exportCfg.JailAllowedAddrs, exportCfg.ModulesToExport)
+			if err != nil {
+				return errors.Wrap(err, "export app state", "height", height)
+			}
+
+			consensusParams := cmttypes.ConsensusParamsFromProto(exported.ConsensusParams)
+			genDoc.AppState = exported.AppState
+			genDoc.Validators = exported.Validators
+			genDoc.InitialHeight = exported.Height
+			genDoc.ConsensusParams = &consensusParams
+			if err := genDoc.ValidateAndComplete(); err != nil {
+				return errors.Wrap(err, "validate exported genesis")
+			}
+
+			if exportCfg.OutputDocument != "" {
+				if err := genDoc.SaveAs(exportCfg.OutputDocument); err != nil {
+					return errors.Wrap(err, "save exported genesis")
+				}
+
+				log.Info(ctx, "Exported genesis", "height", height, "file", exportCfg.OutputDocument)
+
+				return nil
+			}
+
+			bz, err := cmtjson.MarshalIndent(genDoc, "", "  ")
+			if err != nil {
+				return errors.Wrap(err, "marshal exported genesis")
+			}
+
+			_, err = fmt.Fprintln(cmd.OutOrStdout(), string(bz))
+
+			return err
+		},
+	}
+
+	bindRunFlags(cmd, &storyCfg)
+	bindExportFlags(cmd, &exportCfg)
+	log.BindFlags(cmd.Flags(), &logCfg)
+
+	return cmd
+}
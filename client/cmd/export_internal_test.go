@@ -0,0 +1,106 @@
+package cmd
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	cmttypes "github.com/cometbft/cometbft/types"
+	servertypes "github.com/cosmos/cosmos-sdk/server/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/piplabs/story/client/app"
+)
+
+// fakeExporter records the export arguments and exports an empty app state.
+type fakeExporter struct {
+	latest        int64
+	height        int64
+	forZeroHeight bool
+}
+
+func (e *fakeExporter) LastBlockHeight() int64 {
+	return e.latest
+}
+
+func (e *fakeExporter) ExportAppStateAndValidatorsAtHeight(height int64, forZeroHeight bool, _, _ []string,
+) (servertypes.ExportedApp, error) {
+	e.height, e.forZeroHeight = height, forZeroHeight
+
+	genesisHeight := height + 1
+	if forZeroHeight {
+		genesisHeight = 0
+	}
+
+	return servertypes.ExportedApp{
+		AppState:        json.RawMessage(`{"exported":true}`),
+		Height:          genesisHeight,
+		ConsensusParams: cmttypes.DefaultConsensusParams().ToProto(),
+	}, nil
+}
+
+func TestExportCmd(t *testing.T) {
+	t.Parallel()
+
+	tcs := []struct {
+		name                  string
+		args                  []string
+		expectedHeight        int64
+		expectedForZeroHeight bool
+		expectedInitialHeight int64
+	}{
+		{
+			name:                  "latest height",
+			expectedHeight:        5,
+			expectedInitialHeight: 6,
+		},
+		{
+			name:                  "pinned height",
+			args:                  []string{"--height=3"},
+			expectedHeight:        3,
+			expectedInitialHeight: 4,
+		},
+		{
+			name:                  "zero height",
+			args:                  []string{"--height=3", "--for-zero-height"},
+			expectedHeight:        3,
+			expectedForZeroHeight: true,
+			expectedInitialHeight: 1, // A zero initial height defaults to 1.
+		},
+	}
+
+	for _, tc := range tcs {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			home := t.TempDir()
+			require.NoError(t, os.MkdirAll(filepath.Join(home, "config"), 0o755))
+			genDoc := &cmttypes.GenesisDoc{
+				ChainID:     "story-test",
+				GenesisTime: time.Unix(1_700_000_000, 0).UTC(),
+				AppState:    json.RawMessage(`{}`),
+			}
+			require.NoError(t, genDoc.SaveAs(filepath.Join(home, "config", "genesis.json")))
+
+			exp := &fakeExporter{latest: 5}
+			cmd := newExportCmd(func(context.Context, app.Config) exporter { return exp })
+
+			output := filepath.Join(home, "exported.json")
+			cmd.SetArgs(append([]string{"--home=" + home, "--output-document=" + output}, tc.args...))
+			cmd.SetOut(new(bytes.Buffer))
+			require.NoError(t, cmd.ExecuteContext(context.Background()))
+
+			require.Equal(t, tc.expectedHeight, exp.height)
+			require.Equal(t, tc.expectedForZeroHeight, exp.forZeroHeight)
+
+			exported, err := cmttypes.GenesisDocFromFile(output)
+			require.NoError(t, err)
+			require.Equal(t, genDoc.ChainID, exported.ChainID)
+			require.Equal(t, tc.expectedInitialHeight, exported.InitialHeight)
+			require.JSONEq(t, `{"exported":true}`, string(exported.AppState))
+		})
+	}
+}
@@ -35,6 +35,8 @@ func bindRunFlags(cmd *cobra.Command, cfg *config.Config) {
 	flags.StringVar(&cfg.PruningOption, "pruning", cfg.PruningOption, "Pruning strategy (default|nothing|everything)")
 	flags.DurationVar(&cfg.EVMBuildDelay, "evm-build-delay", cfg.EVMBuildDelay, "Minimum delay between triggering and fetching a EVM payload build")
 	flags.BoolVar(&cfg.EVMBuildOptimistic, "evm-build-optimistic", cfg.EVMBuildOptimistic, "Enables optimistic building of EVM payloads on previous block finalize")
+	flags.DurationVar(&cfg.EVMProposalDeadline, "evm-proposal-deadline", cfg.EVMProposalDeadline, "Maximum duration to build a EVM payload when proposing, after which an empty block is proposed (0 to disable)")
+	flags.BoolVar(&cfg.EVMReconcileHead, "evm-reconcile-head", cfg.EVMReconcileHead, "Drive the EVM to the consensus execution head with a forkchoice update on startup if they differ")
 	flags.BoolVar(&cfg.APIEnable, "api-enable", cfg.APIEnable, "Define if the API server should be enabled")
 	flags.StringVar(&cfg.APIAddress, "api-address", cfg.APIAddress, "The API server address to listen on")
 	flags.BoolVar(&cfg.EnableUnsafeCORS, "enabled-unsafe-cors", cfg.EnableUnsafeCORS, "Enable unsafe CORS for API server")
@@ -82,6 +84,12 @@ func bindRemoveOperatorFlags(cmd *cobra.Command, cfg *operatorConfig) {
 	cmd.Flags().StringVar(&cfg.Operator, "operator", "", "Removes an operator from your delegator")
 }
 
+func bindSetOperatorPermissionsFlags(cmd *cobra.Command, cfg *operatorPermissionsConfig) {
+	bindValidatorBaseFlags(cmd, &cfg.baseConfig)
+	cmd.Flags().StringVar(&cfg.Operator, "operator", "", "Operator of your delegator to set the permissions of")
+	cmd.Flags().Uint32Var(&cfg.Permissions, "permissions", 0, "Bitmask of the operator permissions (1 for unstake, 2 for redelegate, 4 for unjail)")
+}
+
 func bindSetWithdrawalAddressFlags(cmd *cobra.Command, cfg *withdrawalConfig) {
 	bindValidatorBaseFlags(cmd, &cfg.baseConfig)
 	cmd.Flags().StringVar(&cfg.WithdrawalAddress, "withdrawal-address", "", "Address to receive staking and reward withdrawals")
@@ -145,6 +153,14 @@ func bindRollbackFlags(cmd *cobra.Command, cfg *config.Config) {
 	cmd.Flags().BoolVar(&cfg.RemoveBlock, "hard", false, "remove last block as well as state")
 }
 
+func bindExportFlags(cmd *cobra.Command, cfg *ExportConfig) {
+	cmd.Flags().Int64Var(&cfg.Height, "height", cfg.Height, "Export state at this committed height (-1 for the latest height)")
+	cmd.Flags().BoolVar(&cfg.ForZeroHeight, "for-zero-height", cfg.ForZeroHeight, "Export state to restart the chain at height zero")
+	cmd.Flags().StringSliceVar(&cfg.JailAllowedAddrs, "jail-allowed-addrs", cfg.JailAllowedAddrs, "Comma-separated validator operator addresses not to jail with --for-zero-height (all if empty)")
+	cmd.Flags().StringSliceVar(&cfg.ModulesToExport, "modules-to-export", cfg.ModulesToExport, "Comma-separated modules to export (all if empty)")
+	cmd.Flags().StringVar(&cfg.OutputDocument, "output-document", cfg.OutputDocument, "Write the exported genesis to this file instead of stdout")
+}
+
 func bindValidatorUnjailFlags(cmd *cobra.Command, cfg *unjailConfig) {
 	bindValidatorBaseFlags(cmd, &cfg.baseConfig)
 	cmd.Flags().StringVar(&cfg.ValidatorPubKey, "validator-pubkey", "", "Validator's hex-encoded compressed 33-byte secp256k1 public key")
@@ -186,6 +202,13 @@ func validateOperatorFlags(cmd *cobra.Command) error {
 	})
 }
 
+func validateOperatorPermissionsFlags(cmd *cobra.Command) error {
+	return validateFlags(cmd, []string{
+		"operator",
+		"permissions",
+	})
+}
+
 func validateWithdrawalFlags(cmd *cobra.Command) error {
 	return validateFlags(cmd, []string{
 		"withdrawal-address",
@@ -111,6 +111,11 @@ type operatorConfig struct {
 	Operator string
 }
 
+type operatorPermissionsConfig struct {
+	operatorConfig
+	Permissions uint32
+}
+
 type withdrawalConfig struct {
 	baseConfig
 	WithdrawalAddress string
@@ -145,6 +150,7 @@ func newValidatorCmds() *cobra.Command {
 		newValidatorUnstakeOnBehalfCmd(),
 		newValidatorAddOperatorCmd(),
 		newValidatorRemoveOperatorCmd(),
+		newValidatorSetOperatorPermissionsCmd(),
 		newValidatorSetWithdrawalAddressCmd(),
 		newValidatorUnjailCmd(),
 	)
@@ -218,6 +224,27 @@ func newValidatorRemoveOperatorCmd() *cobra.Command {
 	return cmd
 }
 
+func newValidatorSetOperatorPermissionsCmd() *cobra.Command {
+	var cfg operatorPermissionsConfig
+
+	cmd := &cobra.Command{
+		Use:   "set-operator-permissions",
+		Short: "Sets the permissions of an operator of your delegator",
+		Args:  cobra.NoArgs,
+		PreRunE: func(_ *cobra.Command, _ []string) error {
+			return initializeBaseConfig(&cfg.baseConfig)
+		},
+		RunE: runValidatorCommand(
+			validateOperatorPermissionsFlags,
+			func(ctx context.Context) error { return setOperatorPermissions(ctx, cfg) },
+		),
+	}
+
+	bindSetOperatorPermissionsFlags(cmd, &cfg)
+
+	return cmd
+}
+
 func newValidatorSetWithdrawalAddressCmd() *cobra.Command {
 	var cfg withdrawalConfig
 
@@ -528,6 +555,31 @@ func removeOperator(ctx context.Context, cfg operatorConfig) error {
 	return nil
 }
 
+func setOperatorPermissions(ctx context.Context, cfg operatorPermissionsConfig) error {
+	uncompressedPubKey, err := uncompressPrivateKey(cfg.PrivateKey)
+	if err != nil {
+		return err
+	}
+
+	operatorAddress := common.HexToAddress(cfg.Operator)
+
+	fee, err := getUint256(ctx, &cfg.baseConfig, "fee")
+	if err != nil {
+		return err
+	}
+
+	fmt.Printf("Fee for setting operator permissions: %s wei\n", fee.String())
+
+	_, err = prepareAndExecuteTransaction(ctx, &cfg.baseConfig, "setOperatorPermissions", fee, uncompressedPubKey, operatorAddress, cfg.Permissions)
+	if err != nil {
+		return err
+	}
+
+	fmt.Println("Operator permissions set successfully!")
+
+	return nil
+}
+
 func stake(ctx context.Context, cfg stakeConfig) error {
 	uncompressedDelegatorPubKeyBytes, err := uncompressPrivateKey(cfg.PrivateKey)
 	if err != nil {
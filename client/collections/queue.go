@@ -53,6 +53,25 @@ func (q Queue[T]) Initialize(ctx context.Context) error {
 	return nil
 }
 
+// Import sets the front of the queue and the elements after it, e.g. to restore an exported queue.
+// The rear is set to the position after the last element.
+func (q Queue[T]) Import(ctx context.Context, front uint64, elems []T) error {
+	for i, elem := range elems {
+		if err := q.elements.Set(ctx, front+uint64(i), elem); err != nil {
+			return ierrors.Wrap(err, "import elements set")
+		}
+	}
+
+	if err := q.front.Set(ctx, front); err != nil {
+		return ierrors.Wrap(err, "import set front")
+	}
+	if err := q.rear.Set(ctx, front+uint64(len(elems))); err != nil {
+		return ierrors.Wrap(err, "import set rear")
+	}
+
+	return nil
+}
+
 func (q Queue[T]) Enqueue(ctx context.Context, elem T) error {
 	rear, err := q.rear.Get(ctx)
 	if err != nil && !errors.Is(err, collections.ErrNotFound) {
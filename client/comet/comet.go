@@ -20,8 +20,8 @@ const perPageConst = 100
 var _ API = adapter{}
 
 type API interface {
-	// Validators returns the cometBFT validators at the given height or false if not
-	// available (probably due to snapshot sync after height).
+	// Validators returns the cometBFT validators, including their proposer priorities, at the
+	// given height or false if not available (probably due to snapshot sync after height).
 	Validators(ctx context.Context, height int64) (*cmttypes.ValidatorSet, bool, error)
 
 	// IsValidator returns true if the given address is a validator at the latest height.
@@ -95,7 +95,9 @@ func (a adapter) Validators(ctx context.Context, height int64) (*cmttypes.Valida
 		}
 
 		for _, v := range valResp.Validators {
-			vals = append(vals, cmttypes.NewValidator(v.PubKey, v.VotingPower))
+			val := cmttypes.NewValidator(v.PubKey, v.VotingPower)
+			val.ProposerPriority = v.ProposerPriority // Retain the priorities for proposer rotation.
+			vals = append(vals, val)
 		}
 
 		if len(vals) == valResp.Total {
@@ -103,13 +105,11 @@ func (a adapter) Validators(ctx context.Context, height int64) (*cmttypes.Valida
 		}
 	}
 
-	// cmttypes.NewValidatorSet() panics on error, so manually construct it for proper error handling.
-	valset := new(cmttypes.ValidatorSet)
-	if err := valset.UpdateWithChangeSet(vals); err != nil {
-		return nil, false, errors.Wrap(err, "update with change set")
-	}
-	if len(vals) > 0 {
-		valset.IncrementProposerPriority(1) // See cmttypes.NewValidatorSet
+	// cmttypes.NewValidatorSet() resets the proposer priorities (and panics on error),
+	// so rebuild the exact validator set at the height instead.
+	valset, err := cmttypes.ValidatorSetFromExistingValidators(vals)
+	if err != nil {
+		return nil, false, errors.Wrap(err, "validator set from existing validators")
 	}
 
 	if err := valset.ValidateBasic(); err != nil {
@@ -29,6 +29,7 @@ const (
 	configDir       = "config"
 	snapshotDataDir = "snapshots"
 	networkFile     = "network.json"
+	payloadFile     = "optimistic_payload.json"
 
 	DefaultEngineEndpoint     = "http://localhost:8551" // Default host endpoint for the Engine API
 	defaultSnapshotInterval   = 1000                    // Roughly once an hour (given 3s blocks)
@@ -39,96 +40,106 @@ const (
 	defaultDBBackend          = db.GoLevelDBBackend
 	defaultEVMBuildDelay      = time.Millisecond * 600 // 100ms longer than geth's --miner.recommit=500ms.
 	defaultEVMBuildOptimistic = true
+	// defaultEVMProposalDeadline is shorter than CometBFT's default timeout_propose=3s.
+	defaultEVMProposalDeadline = time.Second * 2
 )
 
 var (
 	IliadConfig = Config{
-		HomeDir:            DefaultHomeDir(),
-		Network:            "iliad",
-		EngineEndpoint:     DefaultEngineEndpoint,
-		EngineJWTFile:      DefaultJWTFile("iliad"),
-		SnapshotInterval:   defaultSnapshotInterval,
-		SnapshotKeepRecent: defaultSnapshotKeepRecent,
-		BackendType:        string(defaultDBBackend),
-		MinRetainBlocks:    defaultMinRetainBlocks,
-		PruningOption:      pruningtypes.PruningOptionDefault,
-		EVMBuildDelay:      defaultEVMBuildDelay,
-		EVMBuildOptimistic: false,
-		APIEnable:          false,
-		APIAddress:         "127.0.0.1:1317",
-		EnableUnsafeCORS:   false,
-		Tracer:             tracer.DefaultConfig(),
-		RPCLaddr:           "tcp://127.0.0.1:26657",
-		ExternalAddress:    "",
-		Seeds:              "",
-		SeedMode:           false,
+		HomeDir:             DefaultHomeDir(),
+		Network:             "iliad",
+		EngineEndpoint:      DefaultEngineEndpoint,
+		EngineJWTFile:       DefaultJWTFile("iliad"),
+		SnapshotInterval:    defaultSnapshotInterval,
+		SnapshotKeepRecent:  defaultSnapshotKeepRecent,
+		BackendType:         string(defaultDBBackend),
+		MinRetainBlocks:     defaultMinRetainBlocks,
+		PruningOption:       pruningtypes.PruningOptionDefault,
+		EVMBuildDelay:       defaultEVMBuildDelay,
+		EVMBuildOptimistic:  false,
+		EVMProposalDeadline: defaultEVMProposalDeadline,
+		EVMReconcileHead:    false,
+		APIEnable:           false,
+		APIAddress:          "127.0.0.1:1317",
+		EnableUnsafeCORS:    false,
+		Tracer:              tracer.DefaultConfig(),
+		RPCLaddr:            "tcp://127.0.0.1:26657",
+		ExternalAddress:     "",
+		Seeds:               "",
+		SeedMode:            false,
 	}
 	OdysseyConfig = Config{
-		HomeDir:            DefaultHomeDir(),
-		Network:            "odyssey",
-		EngineEndpoint:     DefaultEngineEndpoint,
-		EngineJWTFile:      DefaultJWTFile("odyssey"),
-		SnapshotInterval:   defaultSnapshotInterval,
-		SnapshotKeepRecent: defaultSnapshotKeepRecent,
-		BackendType:        string(defaultDBBackend),
-		MinRetainBlocks:    defaultMinRetainBlocks,
-		PruningOption:      pruningtypes.PruningOptionDefault,
-		EVMBuildDelay:      defaultEVMBuildDelay,
-		EVMBuildOptimistic: false,
-		APIEnable:          false,
-		APIAddress:         "127.0.0.1:1317",
-		EnableUnsafeCORS:   false,
-		Tracer:             tracer.DefaultConfig(),
-		RPCLaddr:           "tcp://127.0.0.1:26657",
-		ExternalAddress:    "",
-		Seeds:              "",
-		SeedMode:           false,
+		HomeDir:             DefaultHomeDir(),
+		Network:             "odyssey",
+		EngineEndpoint:      DefaultEngineEndpoint,
+		EngineJWTFile:       DefaultJWTFile("odyssey"),
+		SnapshotInterval:    defaultSnapshotInterval,
+		SnapshotKeepRecent:  defaultSnapshotKeepRecent,
+		BackendType:         string(defaultDBBackend),
+		MinRetainBlocks:     defaultMinRetainBlocks,
+		PruningOption:       pruningtypes.PruningOptionDefault,
+		EVMBuildDelay:       defaultEVMBuildDelay,
+		EVMBuildOptimistic:  false,
+		EVMProposalDeadline: defaultEVMProposalDeadline,
+		EVMReconcileHead:    false,
+		APIEnable:           false,
+		APIAddress:          "127.0.0.1:1317",
+		EnableUnsafeCORS:    false,
+		Tracer:              tracer.DefaultConfig(),
+		RPCLaddr:            "tcp://127.0.0.1:26657",
+		ExternalAddress:     "",
+		Seeds:               "",
+		SeedMode:            false,
 	}
 	LocalConfig = Config{
-		HomeDir:            DefaultHomeDir(),
-		Network:            "local",
-		EngineEndpoint:     DefaultEngineEndpoint,
-		EngineJWTFile:      DefaultJWTFile("local"),
-		SnapshotInterval:   defaultSnapshotInterval,
-		SnapshotKeepRecent: defaultSnapshotKeepRecent,
-		BackendType:        string(defaultDBBackend),
-		MinRetainBlocks:    defaultMinRetainBlocks,
-		PruningOption:      pruningtypes.PruningOptionDefault,
-		EVMBuildDelay:      defaultEVMBuildDelay,
-		EVMBuildOptimistic: false,
-		APIEnable:          false,
-		APIAddress:         "127.0.0.1:1317",
-		EnableUnsafeCORS:   false,
-		Tracer:             tracer.DefaultConfig(),
-		RPCLaddr:           "tcp://127.0.0.1:26657",
-		ExternalAddress:    "",
-		Seeds:              "",
-		SeedMode:           false,
+		HomeDir:             DefaultHomeDir(),
+		Network:             "local",
+		EngineEndpoint:      DefaultEngineEndpoint,
+		EngineJWTFile:       DefaultJWTFile("local"),
+		SnapshotInterval:    defaultSnapshotInterval,
+		SnapshotKeepRecent:  defaultSnapshotKeepRecent,
+		BackendType:         string(defaultDBBackend),
+		MinRetainBlocks:     defaultMinRetainBlocks,
+		PruningOption:       pruningtypes.PruningOptionDefault,
+		EVMBuildDelay:       defaultEVMBuildDelay,
+		EVMBuildOptimistic:  false,
+		EVMProposalDeadline: defaultEVMProposalDeadline,
+		EVMReconcileHead:    false,
+		APIEnable:           false,
+		APIAddress:          "127.0.0.1:1317",
+		EnableUnsafeCORS:    false,
+		Tracer:              tracer.DefaultConfig(),
+		RPCLaddr:            "tcp://127.0.0.1:26657",
+		ExternalAddress:     "",
+		Seeds:               "",
+		SeedMode:            false,
 	}
 )
 
 // DefaultConfig returns the default story config.
 func DefaultConfig() Config {
 	return Config{
-		HomeDir:            DefaultHomeDir(),
-		Network:            "",                      // No default
-		EngineEndpoint:     "http://localhost:8551", // No default
-		EngineJWTFile:      "",                      // No default
-		SnapshotInterval:   defaultSnapshotInterval,
-		SnapshotKeepRecent: defaultSnapshotKeepRecent,
-		BackendType:        string(defaultDBBackend),
-		MinRetainBlocks:    defaultMinRetainBlocks,
-		PruningOption:      defaultPruningOption,
-		EVMBuildDelay:      defaultEVMBuildDelay,
-		EVMBuildOptimistic: defaultEVMBuildOptimistic,
-		APIEnable:          false,
-		APIAddress:         "127.0.0.1:1317",
-		EnableUnsafeCORS:   false,
-		Tracer:             tracer.DefaultConfig(),
-		RPCLaddr:           "tcp://127.0.0.1:26657",
-		ExternalAddress:    "",
-		Seeds:              "",
-		SeedMode:           false,
+		HomeDir:             DefaultHomeDir(),
+		Network:             "",                      // No default
+		EngineEndpoint:      "http://localhost:8551", // No default
+		EngineJWTFile:       "",                      // No default
+		SnapshotInterval:    defaultSnapshotInterval,
+		SnapshotKeepRecent:  defaultSnapshotKeepRecent,
+		BackendType:         string(defaultDBBackend),
+		MinRetainBlocks:     defaultMinRetainBlocks,
+		PruningOption:       defaultPruningOption,
+		EVMBuildDelay:       defaultEVMBuildDelay,
+		EVMBuildOptimistic:  defaultEVMBuildOptimistic,
+		EVMProposalDeadline: defaultEVMProposalDeadline,
+		EVMReconcileHead:    false,
+		APIEnable:           false,
+		APIAddress:          "127.0.0.1:1317",
+		EnableUnsafeCORS:    false,
+		Tracer:              tracer.DefaultConfig(),
+		RPCLaddr:            "tcp://127.0.0.1:26657",
+		ExternalAddress:     "",
+		Seeds:               "",
+		SeedMode:            false,
 	}
 }
 
@@ -161,27 +172,29 @@ func baseDir() string {
 
 // Config defines all story specific config.
 type Config struct {
-	HomeDir            string
-	Network            netconf.ID
-	EthKeyPassword     string
-	EngineJWTFile      string
-	EngineEndpoint     string
-	SnapshotInterval   uint64 // See cosmossdk.io/store/snapshots/types/options.go
-	SnapshotKeepRecent uint64 // See cosmossdk.io/store/snapshots/types/options.go
-	BackendType        string // See cosmos-db/db.go
-	MinRetainBlocks    uint64
-	PruningOption      string // See cosmossdk.io/store/pruning/types/options.go
-	EVMBuildDelay      time.Duration
-	EVMBuildOptimistic bool
-	APIEnable          bool
-	APIAddress         string
-	EnableUnsafeCORS   bool
-	Tracer             tracer.Config
-	RPCLaddr           string
-	ExternalAddress    string
-	Seeds              string
-	SeedMode           bool
-	RemoveBlock        bool // See cosmos-sdk/server/rollback.go
+	HomeDir             string
+	Network             netconf.ID
+	EthKeyPassword      string
+	EngineJWTFile       string
+	EngineEndpoint      string
+	SnapshotInterval    uint64 // See cosmossdk.io/store/snapshots/types/options.go
+	SnapshotKeepRecent  uint64 // See cosmossdk.io/store/snapshots/types/options.go
+	BackendType         string // See cosmos-db/db.go
+	MinRetainBlocks     uint64
+	PruningOption       string // See cosmossdk.io/store/pruning/types/options.go
+	EVMBuildDelay       time.Duration
+	EVMBuildOptimistic  bool
+	EVMProposalDeadline time.Duration
+	EVMReconcileHead    bool
+	APIEnable           bool
+	APIAddress          string
+	EnableUnsafeCORS    bool
+	Tracer              tracer.Config
+	RPCLaddr            string
+	ExternalAddress     string
+	Seeds               string
+	SeedMode            bool
+	RemoveBlock         bool // See cosmos-sdk/server/rollback.go
 }
 
 // ConfigFile returns the default path to the toml story config file.
@@ -201,6 +214,11 @@ func (c Config) SnapshotDir() string {
 	return filepath.Join(c.DataDir(), snapshotDataDir)
 }
 
+// OptimisticPayloadFile returns the local (non-consensus) file the optimistic payload build is persisted to.
+func (c Config) OptimisticPayloadFile() string {
+	return filepath.Join(c.DataDir(), payloadFile)
+}
+
 func (c Config) Verify() error {
 	if c.EngineEndpoint == "" {
 		return errors.New("flag --engine-endpoint is empty")
@@ -223,7 +223,15 @@ func defaultAppState(
 	slashingGenesis := sltypes.DefaultGenesisState()
 	slashingGenesis.Params.SignedBlocksWindow = slashingBlocksWindow
 
-	evmengGenesis := evmenginetypes.NewGenesisState(evmenginetypes.Params{ExecutionBlockHash: executionBlockHash.Bytes()})
+	evmengParams := evmenginetypes.DefaultParams()
+	evmengParams.ExecutionBlockHash = executionBlockHash.Bytes()
+	evmengGenesis := evmenginetypes.NewGenesisState(evmengParams)
+
+	evmstakingGenesis := evmstakingtypes.DefaultGenesisState()
+	evmstakingGenesis.Params.MinStakeAmount = evmstakingtypes.GenesisMinStakeAmount
+	evmstakingGenesis.Params.MinUnstakeAmount = evmstakingtypes.GenesisMinUnstakeAmount
+	evmstakingGenesis.Params.MinCommissionRate = evmstakingtypes.GenesisMinCommissionRate
+	evmstakingGenesis.Params.Fee = evmstakingtypes.GenesisFee
 
 	return map[string]json.RawMessage{
 		sttypes.ModuleName:         marshal(stakingGenesis),
@@ -232,7 +240,7 @@ func defaultAppState(
 		btypes.ModuleName:          marshal(btypes.DefaultGenesisState()),
 		dtypes.ModuleName:          marshal(dtypes.DefaultGenesisState()),
 		evmenginetypes.ModuleName:  marshal(evmengGenesis),
-		evmstakingtypes.ModuleName: marshal(evmstakingtypes.DefaultGenesisState()),
+		evmstakingtypes.ModuleName: marshal(evmstakingGenesis),
 	}
 }
 
@@ -0,0 +1,128 @@
+package server
+
+import (
+	"net/http"
+	"strconv"
+
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"github.com/gorilla/mux"
+
+	"github.com/piplabs/story/client/server/utils"
+	evmenginetypes "github.com/piplabs/story/client/x/evmengine/types"
+)
+
+func (s *Server) initEvmEngineRoute() {
+	s.httpMux.HandleFunc("/evmengine/params", utils.SimpleWrap(s.aminoCodec, s.GetEvmEngineParams))
+	s.httpMux.HandleFunc("/evmengine/execution_heads", utils.AutoWrap(s.aminoCodec, s.GetExecutionHeads))
+	s.httpMux.HandleFunc("/evmengine/execution_heads/{height}", utils.SimpleWrap(s.aminoCodec, s.GetExecutionHeadByHeight))
+	s.httpMux.HandleFunc("/evmengine/ubi/percentage", utils.SimpleWrap(s.aminoCodec, s.GetUbiPercentage))
+	s.httpMux.HandleFunc("/evmengine/ubi/balance", utils.SimpleWrap(s.aminoCodec, s.GetUbiBalance))
+	s.httpMux.HandleFunc("/evmengine/ubi/validators/{validator_pubkey}", utils.SimpleWrap(s.aminoCodec, s.GetValidatorUbiByValidatorPubkey))
+}
+
+// GetEvmEngineParams queries the parameters of evmengine module.
+func (s *Server) GetEvmEngineParams(r *http.Request) (resp any, err error) {
+	queryContext, err := s.createQueryContextByHeader(r)
+	if err != nil {
+		return nil, err
+	}
+
+	queryResp, err := s.store.GetEvmEngineKeeper().Params(queryContext, &evmenginetypes.QueryParamsRequest{})
+	if err != nil {
+		return nil, err
+	}
+
+	return queryResp, nil
+}
+
+// GetExecutionHeads queries the retained execution head history in pagination.
+func (s *Server) GetExecutionHeads(req *getExecutionHeadsRequest, r *http.Request) (resp any, err error) {
+	queryContext, err := s.createQueryContextByHeader(r)
+	if err != nil {
+		return nil, err
+	}
+
+	queryResp, err := s.store.GetEvmEngineKeeper().ExecutionHeads(queryContext, &evmenginetypes.QueryExecutionHeadsRequest{
+		Pagination: &query.PageRequest{
+			Key:        []byte(req.Pagination.Key),
+			Offset:     req.Pagination.Offset,
+			Limit:      req.Pagination.Limit,
+			CountTotal: req.Pagination.CountTotal,
+			Reverse:    req.Pagination.Reverse,
+		},
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return queryResp, nil
+}
+
+// GetExecutionHeadByHeight queries the execution block finalized at the given consensus chain height, 0 for the latest.
+func (s *Server) GetExecutionHeadByHeight(r *http.Request) (resp any, err error) {
+	queryContext, err := s.createQueryContextByHeader(r)
+	if err != nil {
+		return nil, err
+	}
+
+	height, err := strconv.ParseUint(mux.Vars(r)["height"], 10, 64)
+	if err != nil {
+		return nil, err
+	}
+
+	queryResp, err := s.store.GetEvmEngineKeeper().ExecutionHead(queryContext, &evmenginetypes.QueryExecutionHeadRequest{
+		Height: height,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return queryResp, nil
+}
+
+// GetUbiPercentage queries the current UBI percentage of the block rewards.
+func (s *Server) GetUbiPercentage(r *http.Request) (resp any, err error) {
+	queryContext, err := s.createQueryContextByHeader(r)
+	if err != nil {
+		return nil, err
+	}
+
+	queryResp, err := s.store.GetEvmEngineKeeper().UbiPercentage(queryContext, &evmenginetypes.QueryUbiPercentageRequest{})
+	if err != nil {
+		return nil, err
+	}
+
+	return queryResp, nil
+}
+
+// GetUbiBalance queries the UBI accrued in the distribution module that isn't withdrawn to the UBIPool yet.
+func (s *Server) GetUbiBalance(r *http.Request) (resp any, err error) {
+	queryContext, err := s.createQueryContextByHeader(r)
+	if err != nil {
+		return nil, err
+	}
+
+	queryResp, err := s.store.GetEvmEngineKeeper().UbiBalance(queryContext, &evmenginetypes.QueryUbiBalanceRequest{})
+	if err != nil {
+		return nil, err
+	}
+
+	return queryResp, nil
+}
+
+// GetValidatorUbiByValidatorPubkey queries the UBI distributed to and claimed by the validator on the UBIPool.
+func (s *Server) GetValidatorUbiByValidatorPubkey(r *http.Request) (resp any, err error) {
+	queryContext, err := s.createQueryContextByHeader(r)
+	if err != nil {
+		return nil, err
+	}
+
+	queryResp, err := s.store.GetEvmEngineKeeper().ValidatorUbi(queryContext, &evmenginetypes.QueryValidatorUbiRequest{
+		ValidatorPubkey: mux.Vars(r)["validator_pubkey"],
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return queryResp, nil
+}
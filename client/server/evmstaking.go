@@ -3,12 +3,23 @@ package server
 import (
 	"net/http"
 
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"github.com/gorilla/mux"
+
 	"github.com/piplabs/story/client/server/utils"
 	evmstakingtypes "github.com/piplabs/story/client/x/evmstaking/types"
 )
 
 func (s *Server) initEvmStakingRoute() {
 	s.httpMux.HandleFunc("/evmstaking/params", utils.SimpleWrap(s.aminoCodec, s.GetEvmStakingParams))
+	s.httpMux.HandleFunc("/evmstaking/withdrawal_queue", utils.AutoWrap(s.aminoCodec, s.GetWithdrawalQueue))
+	s.httpMux.HandleFunc("/evmstaking/reward_withdrawal_queue", utils.AutoWrap(s.aminoCodec, s.GetRewardWithdrawalQueue))
+	s.httpMux.HandleFunc("/evmstaking/delegators/{delegator_addr}/withdraw_address", utils.SimpleWrap(s.aminoCodec, s.GetDelegatorWithdrawAddress))
+	s.httpMux.HandleFunc("/evmstaking/delegators/{delegator_addr}/reward_address", utils.SimpleWrap(s.aminoCodec, s.GetDelegatorRewardAddress))
+	s.httpMux.HandleFunc("/evmstaking/delegators/{delegator_addr}/operator_address", utils.SimpleWrap(s.aminoCodec, s.GetDelegatorOperatorAddress))
+	s.httpMux.HandleFunc("/evmstaking/delegators/{delegator_addr}/operators", utils.AutoWrap(s.aminoCodec, s.GetDelegatorOperators))
+	s.httpMux.HandleFunc("/evmstaking/ubi_withdrawals", utils.AutoWrap(s.aminoCodec, s.GetUbiWithdrawals))
+	s.httpMux.HandleFunc("/evmstaking/failed_events", utils.AutoWrap(s.aminoCodec, s.GetFailedEvents))
 }
 
 // GetEvmStakingParams queries the parameters of evmstaking module.
@@ -25,3 +36,178 @@ func (s *Server) GetEvmStakingParams(r *http.Request) (resp any, err error) {
 
 	return queryResp, nil
 }
+
+// GetWithdrawalQueue queries the pending withdrawals in pagination, optionally filtered by EVM address.
+func (s *Server) GetWithdrawalQueue(req *getWithdrawalQueueRequest, r *http.Request) (resp any, err error) {
+	queryContext, err := s.createQueryContextByHeader(r)
+	if err != nil {
+		return nil, err
+	}
+
+	queryResp, err := s.store.GetEvmStakingKeeper().GetWithdrawalQueue(queryContext, &evmstakingtypes.QueryGetWithdrawalQueueRequest{
+		EvmAddress: req.EvmAddress,
+		Pagination: &query.PageRequest{
+			Key:        []byte(req.Pagination.Key),
+			Offset:     req.Pagination.Offset,
+			Limit:      req.Pagination.Limit,
+			CountTotal: req.Pagination.CountTotal,
+			Reverse:    req.Pagination.Reverse,
+		},
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return queryResp, nil
+}
+
+// GetRewardWithdrawalQueue queries the pending reward withdrawals in pagination, optionally filtered by EVM address.
+func (s *Server) GetRewardWithdrawalQueue(req *getWithdrawalQueueRequest, r *http.Request) (resp any, err error) {
+	queryContext, err := s.createQueryContextByHeader(r)
+	if err != nil {
+		return nil, err
+	}
+
+	queryResp, err := s.store.GetEvmStakingKeeper().GetRewardWithdrawalQueue(queryContext, &evmstakingtypes.QueryGetRewardWithdrawalQueueRequest{
+		EvmAddress: req.EvmAddress,
+		Pagination: &query.PageRequest{
+			Key:        []byte(req.Pagination.Key),
+			Offset:     req.Pagination.Offset,
+			Limit:      req.Pagination.Limit,
+			CountTotal: req.Pagination.CountTotal,
+			Reverse:    req.Pagination.Reverse,
+		},
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return queryResp, nil
+}
+
+// GetDelegatorWithdrawAddress queries the EVM address the unstaked tokens of the delegator are withdrawn to.
+func (s *Server) GetDelegatorWithdrawAddress(r *http.Request) (resp any, err error) {
+	queryContext, err := s.createQueryContextByHeader(r)
+	if err != nil {
+		return nil, err
+	}
+
+	queryResp, err := s.store.GetEvmStakingKeeper().GetDelegatorWithdrawAddress(queryContext, &evmstakingtypes.QueryGetDelegatorWithdrawAddressRequest{
+		DelegatorAddress: mux.Vars(r)["delegator_addr"],
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return queryResp, nil
+}
+
+// GetDelegatorRewardAddress queries the EVM address the rewards of the delegator are withdrawn to.
+func (s *Server) GetDelegatorRewardAddress(r *http.Request) (resp any, err error) {
+	queryContext, err := s.createQueryContextByHeader(r)
+	if err != nil {
+		return nil, err
+	}
+
+	queryResp, err := s.store.GetEvmStakingKeeper().GetDelegatorRewardAddress(queryContext, &evmstakingtypes.QueryGetDelegatorRewardAddressRequest{
+		DelegatorAddress: mux.Vars(r)["delegator_addr"],
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return queryResp, nil
+}
+
+// GetDelegatorOperatorAddress queries the EVM address of an operator of the delegator granted all permissions.
+//
+// Deprecated: Use GetDelegatorOperators instead.
+func (s *Server) GetDelegatorOperatorAddress(r *http.Request) (resp any, err error) {
+	queryContext, err := s.createQueryContextByHeader(r)
+	if err != nil {
+		return nil, err
+	}
+
+	//nolint:staticcheck // Serves the deprecated endpoint.
+	queryResp, err := s.store.GetEvmStakingKeeper().GetDelegatorOperatorAddress(queryContext, &evmstakingtypes.QueryGetDelegatorOperatorAddressRequest{
+		DelegatorAddress: mux.Vars(r)["delegator_addr"],
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return queryResp, nil
+}
+
+// GetDelegatorOperators queries the operators of the delegator and their permissions in pagination.
+func (s *Server) GetDelegatorOperators(req *getDelegatorOperatorsRequest, r *http.Request) (resp any, err error) {
+	queryContext, err := s.createQueryContextByHeader(r)
+	if err != nil {
+		return nil, err
+	}
+
+	queryResp, err := s.store.GetEvmStakingKeeper().GetDelegatorOperators(queryContext, &evmstakingtypes.QueryGetDelegatorOperatorsRequest{
+		DelegatorAddress: mux.Vars(r)["delegator_addr"],
+		Pagination: &query.PageRequest{
+			Key:        []byte(req.Pagination.Key),
+			Offset:     req.Pagination.Offset,
+			Limit:      req.Pagination.Limit,
+			CountTotal: req.Pagination.CountTotal,
+			Reverse:    req.Pagination.Reverse,
+		},
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return queryResp, nil
+}
+
+// GetUbiWithdrawals queries the history of UBI withdrawals to the UBI withdraw address in pagination.
+func (s *Server) GetUbiWithdrawals(req *getUbiWithdrawalsRequest, r *http.Request) (resp any, err error) {
+	queryContext, err := s.createQueryContextByHeader(r)
+	if err != nil {
+		return nil, err
+	}
+
+	queryResp, err := s.store.GetEvmStakingKeeper().GetUbiWithdrawals(queryContext, &evmstakingtypes.QueryGetUbiWithdrawalsRequest{
+		Pagination: &query.PageRequest{
+			Key:        []byte(req.Pagination.Key),
+			Offset:     req.Pagination.Offset,
+			Limit:      req.Pagination.Limit,
+			CountTotal: req.Pagination.CountTotal,
+			Reverse:    req.Pagination.Reverse,
+		},
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return queryResp, nil
+}
+
+// GetFailedEvents queries the EVM events that failed to be processed in pagination,
+// optionally filtered by delegator or validator address.
+func (s *Server) GetFailedEvents(req *getFailedEventsRequest, r *http.Request) (resp any, err error) {
+	queryContext, err := s.createQueryContextByHeader(r)
+	if err != nil {
+		return nil, err
+	}
+
+	queryResp, err := s.store.GetEvmStakingKeeper().GetFailedEvents(queryContext, &evmstakingtypes.QueryGetFailedEventsRequest{
+		DelegatorAddress: req.DelegatorAddress,
+		ValidatorAddress: req.ValidatorAddress,
+		Pagination: &query.PageRequest{
+			Key:        []byte(req.Pagination.Key),
+			Offset:     req.Pagination.Offset,
+			Limit:      req.Pagination.Limit,
+			CountTotal: req.Pagination.CountTotal,
+			Reverse:    req.Pagination.Reverse,
+		},
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return queryResp, nil
+}
@@ -108,3 +108,26 @@ type getValidatorsByDelegatorAddressRequest struct {
 type getModuleVersionsRequest struct {
 	ModuleName string `mapstructure:"module_name"`
 }
+
+type getExecutionHeadsRequest struct {
+	Pagination pagination `mapstructure:"pagination"`
+}
+
+type getWithdrawalQueueRequest struct {
+	EvmAddress string     `mapstructure:"evm_address"`
+	Pagination pagination `mapstructure:"pagination"`
+}
+
+type getDelegatorOperatorsRequest struct {
+	Pagination pagination `mapstructure:"pagination"`
+}
+
+type getUbiWithdrawalsRequest struct {
+	Pagination pagination `mapstructure:"pagination"`
+}
+
+type getFailedEventsRequest struct {
+	DelegatorAddress string     `mapstructure:"delegator_address"`
+	ValidatorAddress string     `mapstructure:"validator_address"`
+	Pagination       pagination `mapstructure:"pagination"`
+}
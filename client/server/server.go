@@ -27,12 +27,14 @@ import (
 	"github.com/gorilla/handlers"
 	"github.com/gorilla/mux"
 
+	evmenginekeeper "github.com/piplabs/story/client/x/evmengine/keeper"
 	evmstakingkeeper "github.com/piplabs/story/client/x/evmstaking/keeper"
 	mintkeeper "github.com/piplabs/story/client/x/mint/keeper"
 )
 
 type Store interface {
 	CreateQueryContext(height int64, prove bool) (sdk.Context, error)
+	GetEvmEngineKeeper() *evmenginekeeper.Keeper
 	GetEvmStakingKeeper() *evmstakingkeeper.Keeper
 	GetStakingKeeper() *stakingkeeper.Keeper
 	GetSlashingKeeper() slashingkeeper.Keeper
@@ -119,6 +121,7 @@ func (s *Server) registerHandle() {
 	s.initBankRoute()
 	s.initCometBFTRoute()
 	s.initDistributionRoute()
+	s.initEvmEngineRoute()
 	s.initEvmStakingRoute()
 	s.initSlashingRoute()
 	s.initStakingRoute()
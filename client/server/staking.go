@@ -4,7 +4,6 @@ package server
 import (
 	"net/http"
 	"strconv"
-	"strings"
 
 	"github.com/cosmos/cosmos-sdk/types/query"
 	"github.com/cosmos/cosmos-sdk/x/staking/keeper"
@@ -12,6 +11,10 @@ import (
 	"github.com/gorilla/mux"
 
 	"github.com/piplabs/story/client/server/utils"
+	evmstakingtypes "github.com/piplabs/story/client/x/evmstaking/types"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 )
 
 func (s *Server) initStakingRoute() {
@@ -305,19 +308,29 @@ func (s *Server) GetDelegatorByDelegatorAddress(r *http.Request) (resp any, err
 		return nil, err
 	}
 
-	delOperatorEvmAddr, err := s.store.GetEvmStakingKeeper().DelegatorOperatorAddress.Get(queryContext, delAddr)
+	delOperators, err := s.store.GetEvmStakingKeeper().GetDelegatorOperators(queryContext, &evmstakingtypes.QueryGetDelegatorOperatorsRequest{
+		DelegatorAddress: delAddr,
+	})
 	if err != nil {
-		if !strings.Contains(err.Error(), "not found") {
-			return nil, err
-		}
-		delOperatorEvmAddr = ""
+		return nil, err
+	}
+
+	//nolint:staticcheck // Keeps the deprecated operator address of the delegator info.
+	delOperatorEvmAddr, err := s.store.GetEvmStakingKeeper().GetDelegatorOperatorAddress(queryContext, &evmstakingtypes.QueryGetDelegatorOperatorAddressRequest{
+		DelegatorAddress: delAddr,
+	})
+	if status.Code(err) == codes.NotFound {
+		delOperatorEvmAddr = &evmstakingtypes.QueryGetDelegatorOperatorAddressResponse{}
+	} else if err != nil {
+		return nil, err
 	}
 
 	return &DelegatorBaseInfo{
 		DelegatorAddr:   delAddr,
 		WithdrawAddress: delWithdrawEvmAddr,
 		RewardAddress:   delRewardEvmAddr,
-		OperatorAddress: delOperatorEvmAddr,
+		OperatorAddress: delOperatorEvmAddr.OperatorAddress,
+		Operators:       delOperators.Operators,
 	}, nil
 }
 
@@ -1,8 +1,12 @@
 package server
 
+import evmstakingtypes "github.com/piplabs/story/client/x/evmstaking/types"
+
 type DelegatorBaseInfo struct {
 	DelegatorAddr   string `json:"delegator_addr"`
 	WithdrawAddress string `json:"withdraw_address"`
 	RewardAddress   string `json:"reward_address"`
-	OperatorAddress string `json:"operator_address"`
+	// Deprecated: Use Operators instead, the operator address is only set for an operator granted all permissions.
+	OperatorAddress string                              `json:"operator_address"`
+	Operators       []evmstakingtypes.DelegatorOperator `json:"operators"`
 }
@@ -6,7 +6,6 @@ import (
 	"fmt"
 	"log/slog"
 	"runtime/debug"
-	"strings"
 	"time"
 
 	abci "github.com/cometbft/cometbft/abci/types"
@@ -19,6 +18,7 @@ import (
 
 	"github.com/piplabs/story/client/x/evmengine/types"
 	"github.com/piplabs/story/lib/errors"
+	"github.com/piplabs/story/lib/ethclient"
 	"github.com/piplabs/story/lib/log"
 )
 
@@ -51,6 +51,20 @@ func (k *Keeper) PrepareProposal(ctx sdk.Context, req *abci.RequestPreparePropos
 		return &abci.ResponsePrepareProposal{}, nil
 	}
 
+	// First, collect all vote extension msgs from the vote provider.
+	voteMsgs, err := k.PrepareVotes(ctx, req.LocalLastCommit)
+	if err != nil {
+		return nil, errors.Wrap(err, "prepare votes")
+	}
+
+	// Bound building the EVM payload by the proposal deadline, proposing an empty block if it is exceeded.
+	buildCtx := context.Context(ctx)
+	if k.proposalDeadline > 0 {
+		var cancel context.CancelFunc
+		buildCtx, cancel = context.WithTimeout(ctx, k.proposalDeadline)
+		defer cancel()
+	}
+
 	appHash := common.BytesToHash(ctx.BlockHeader().AppHash)
 
 	maxWithdrawals, err := k.evmstakingKeeper.MaxWithdrawalPerBlock(ctx)
@@ -62,63 +76,104 @@ func (k *Keeper) PrepareProposal(ctx sdk.Context, req *abci.RequestPreparePropos
 		return nil, errors.Wrap(err, "error on withdrawals dequeue")
 	}
 	maxRewardWithdrawals := maxWithdrawals - uint32(len(withdrawals))
-	rewardWithdrawals, err := k.evmstakingKeeper.PeekEligibleRewardWithdrawals(ctx, maxRewardWithdrawals)
+	rewardWithdrawals, err := k.evmstakingKeeper.PeekEligibleRewardWithdrawals(ctx, maxRewardWithdrawals, uint64(len(withdrawals)))
 	if err != nil {
 		return nil, errors.Wrap(err, "error on reward withdrawals dequeue")
 	}
 	withdrawals = append(withdrawals, rewardWithdrawals...)
 
-	// Either use the optimistic payload or create a new one.
-	payloadID, height, triggeredAt := k.getOptimisticPayload()
-	if uint64(req.Height) != height { //nolint:nestif // no issue
-		// Create a new payload (retrying on network errors).
-		err := retryForever(ctx, func(ctx context.Context) (bool, error) {
-			fcr, err := k.startBuild(ctx, k.validatorAddr, withdrawals, appHash, req.Time)
+	head, err := k.getExecutionHead(ctx)
+	if err != nil {
+		return nil, errors.Wrap(err, "latest execution block")
+	}
+
+	// Either use the optimistic payload, if it is built on top of the current head, or create a new one.
+	optimistic := k.getOptimisticPayload()
+	useOptimistic := uint64(req.Height) == optimistic.Height && optimistic.ParentHash == head.Hash()
+	payloadID, version, triggeredAt := optimistic.ID, optimistic.Version, optimistic.UpdatedAt
+	if k.buildOptimistic {
+		incOptimisticBuild(useOptimistic)
+	}
+
+	var payloadResp *engine.ExecutionPayloadEnvelope
+	var executionRequests [][]byte
+	for {
+		if !useOptimistic { //nolint:nestif // no issue
+			feeRecipient, err := k.feeRecipient(ctx, k.validatorAddr)
 			if err != nil {
-				log.Warn(ctx, "Preparing proposal failed: build new evm payload (will retry)", err)
-				return false, nil
-			} else if fcr.PayloadStatus.Status != engine.VALID {
-				return false, errors.New("status not valid")
-			} else if fcr.PayloadID == nil {
-				return false, errors.New("missing payload ID")
+				return nil, errors.Wrap(err, "fee recipient")
 			}
 
-			payloadID = *fcr.PayloadID
+			// Create a new payload (retrying on network errors).
+			err = retryForever(buildCtx, func(ctx context.Context) (bool, error) {
+				fcr, v, err := k.startBuild(ctx, req.Height, feeRecipient, withdrawals, appHash, req.Time)
+				if err != nil && !ethclient.IsRetryable(err) {
+					return false, errors.Wrap(err, "build new evm payload rejected") // Don't retry
+				} else if err != nil {
+					log.Warn(ctx, "Preparing proposal failed: build new evm payload (will retry)", err)
+					return false, nil
+				} else if fcr.PayloadStatus.Status != engine.VALID {
+					return false, errors.New("status not valid")
+				} else if fcr.PayloadID == nil {
+					return false, errors.New("missing payload ID")
+				}
+
+				payloadID = *fcr.PayloadID
+				version = v
+
+				return true, nil
+			})
+			if proposalDeadlineExceeded(ctx, buildCtx) {
+				return k.emptyProposal(ctx, req.Height, voteMsgs, "evm payload proposal deadline exceeded", err)
+			} else if err != nil {
+				return nil, err
+			}
+			triggeredAt = time.Now()
+		} else {
+			log.Info(ctx, "Using optimistic payload", "height", optimistic.Height, "payload", payloadID.String())
+		}
 
-			return true, nil
-		})
-		if err != nil {
-			return nil, err
+		// Wait the minimum build_delay for the payload to be available.
+		waitTo := triggeredAt.Add(k.buildDelay)
+		select {
+		case <-buildCtx.Done():
+			if proposalDeadlineExceeded(ctx, buildCtx) {
+				return k.emptyProposal(ctx, req.Height, voteMsgs, "evm payload proposal deadline exceeded", buildCtx.Err())
+			}
+
+			return nil, errors.Wrap(ctx.Err(), "context done")
+		case <-time.After(time.Until(waitTo)):
 		}
-		triggeredAt = time.Now()
-	} else {
-		log.Info(ctx, "Using optimistic payload", "height", height, "payload", payloadID.String())
-	}
 
-	// Wait the minimum build_delay for the payload to be available.
-	waitTo := triggeredAt.Add(k.buildDelay)
-	select {
-	case <-ctx.Done():
-		return nil, errors.Wrap(ctx.Err(), "context done")
-	case <-time.After(time.Until(waitTo)):
-	}
+		// Fetch the payload (retrying on network errors).
+		err = retryForever(buildCtx, func(ctx context.Context) (bool, error) {
+			var err error
+			payloadResp, executionRequests, err = k.getPayload(ctx, version, payloadID)
+			if err != nil && !ethclient.IsRetryable(err) {
+				return false, errors.Wrap(err, "get evm payload rejected") // Don't retry, e.g. unknown payload
+			} else if err != nil {
+				log.Warn(ctx, "Preparing proposal failed: get evm payload (will retry)", err)
+				return false, nil
+			}
 
-	// Fetch the payload (retrying on network errors).
-	var payloadResp *engine.ExecutionPayloadEnvelope
-	err = retryForever(ctx, func(ctx context.Context) (bool, error) {
-		var err error
-		payloadResp, err = k.engineCl.GetPayloadV3(ctx, payloadID)
-		if isUnknownPayload(err) {
-			return false, err
+			return true, nil
+		})
+		if useOptimistic && errors.Is(err, ethclient.ErrUnknownPayload) {
+			// The EL drops pending payloads when it restarts, e.g. the optimistic payload restored on startup.
+			log.Warn(ctx, "Optimistic payload unknown to the EVM, building a new one", err,
+				"height", optimistic.Height,
+				"payload", payloadID.String(),
+			)
+			useOptimistic = false
+
+			continue
+		} else if proposalDeadlineExceeded(ctx, buildCtx) {
+			return k.emptyProposal(ctx, req.Height, voteMsgs, "evm payload proposal deadline exceeded", err)
 		} else if err != nil {
-			log.Warn(ctx, "Preparing proposal failed: get evm payload (will retry)", err)
-			return false, nil
+			return nil, err
 		}
 
-		return true, nil
-	})
-	if err != nil {
-		return nil, err
+		break
 	}
 
 	// Create execution payload message
@@ -127,15 +182,11 @@ func (k *Keeper) PrepareProposal(ctx sdk.Context, req *abci.RequestPreparePropos
 		return nil, errors.Wrap(err, "encode")
 	}
 
-	// First, collect all vote extension msgs from the vote provider.
-	// voteMsgs, err := k.voteProvider.PrepareVotes(ctx, req.LocalLastCommit)
-	// if err != nil {
-	//	return nil, errors.Wrap(err, "prepare votes")
-	//}
-
 	// Next, collect all prev payload evm event logs.
-	evmEvents, err := k.evmEvents(ctx, payloadResp.ExecutionPayload.ParentHash)
-	if err != nil {
+	evmEvents, err := k.evmEvents(buildCtx, payloadResp.ExecutionPayload.ParentHash)
+	if proposalDeadlineExceeded(ctx, buildCtx) {
+		return k.emptyProposal(ctx, req.Height, voteMsgs, "evm payload proposal deadline exceeded", err)
+	} else if err != nil {
 		return nil, errors.Wrap(err, "prepare evm event logs")
 	}
 
@@ -144,30 +195,69 @@ func (k *Keeper) PrepareProposal(ctx sdk.Context, req *abci.RequestPreparePropos
 		Authority:         authtypes.NewModuleAddress(types.ModuleName).String(),
 		ExecutionPayload:  payloadData,
 		PrevPayloadEvents: evmEvents,
+		ExecutionRequests: executionRequests,
 	}
 
 	// Combine all the votes messages and the payload message into a single transaction.
-	b := k.txConfig.NewTxBuilder()
-	if err := b.SetMsgs(payloadMsg); err != nil { // b.SetMsgs(append(voteMsgs, payloadMsg)...)
-		return nil, errors.Wrap(err, "set tx builder msgs")
-	}
-
-	// Note this transaction is not signed. We need to ensure bypass verification somehow.
-	tx, err := k.txConfig.TxEncoder()(b.GetTx())
+	tx, err := k.proposalTx(append(voteMsgs, payloadMsg)...)
 	if err != nil {
-		return nil, errors.Wrap(err, "encode tx builder")
+		return nil, err
 	}
 
 	log.Info(ctx, "Proposing new block",
 		"height", req.Height,
 		log.Hex7("execution_block_hash", payloadResp.ExecutionPayload.BlockHash[:]),
-		// "vote_msgs", len(voteMsgs),
+		"vote_msgs", len(voteMsgs),
 		"evm_events", len(evmEvents),
 	)
+	incProposalPayload(true)
+
+	return &abci.ResponsePrepareProposal{Txs: [][]byte{tx}}, nil
+}
+
+// proposalDeadlineExceeded returns true if the build context's proposal deadline was exceeded
+// while the parent context is still active.
+func proposalDeadlineExceeded(ctx, buildCtx context.Context) bool {
+	return ctx.Err() == nil && errors.Is(buildCtx.Err(), context.DeadlineExceeded)
+}
+
+// emptyProposal returns a proposal without an EVM payload, only including the votes messages if any.
+// It is the fallback when the EVM fails to provide a valid payload before the proposal deadline.
+// ProcessProposal accepts it, the EVM chain is just not extended by this block.
+func (k *Keeper) emptyProposal(ctx context.Context, height int64, voteMsgs []sdk.Msg, reason string, err error) (
+	*abci.ResponsePrepareProposal, error,
+) {
+	log.Warn(ctx, "Proposing empty block: "+reason, err, "height", height)
+	incProposalPayload(false)
+
+	if len(voteMsgs) == 0 {
+		return &abci.ResponsePrepareProposal{}, nil
+	}
+
+	tx, err := k.proposalTx(voteMsgs...)
+	if err != nil {
+		return nil, err
+	}
 
 	return &abci.ResponsePrepareProposal{Txs: [][]byte{tx}}, nil
 }
 
+// proposalTx returns the encoded proposal transaction including the provided messages.
+func (k *Keeper) proposalTx(msgs ...sdk.Msg) ([]byte, error) {
+	b := k.txConfig.NewTxBuilder()
+	if err := b.SetMsgs(msgs...); err != nil {
+		return nil, errors.Wrap(err, "set tx builder msgs")
+	}
+
+	// Note this transaction is not signed. We need to ensure bypass verification somehow.
+	tx, err := k.txConfig.TxEncoder()(b.GetTx())
+	if err != nil {
+		return nil, errors.Wrap(err, "encode tx builder")
+	}
+
+	return tx, nil
+}
+
 // PostFinalize is called by our custom ABCI wrapper after a block is finalized.
 // It starts an optimistic build if enabled and if we are the next proposer.
 //
@@ -212,14 +302,26 @@ func (k *Keeper) PostFinalize(ctx sdk.Context) error {
 		return nil
 	}
 	maxRewardWithdrawals := maxWithdrawals - uint32(len(withdrawals))
-	rewardWithdrawals, err := k.evmstakingKeeper.PeekEligibleRewardWithdrawals(ctx, maxRewardWithdrawals)
+	rewardWithdrawals, err := k.evmstakingKeeper.PeekEligibleRewardWithdrawals(ctx, maxRewardWithdrawals, uint64(len(withdrawals)))
 	if err != nil {
 		log.Error(ctx, "Starting optimistic build failed; reward withdrawals peek", err, logAttr)
 		return errors.Wrap(err, "error on reward withdrawals dequeue")
 	}
 	withdrawals = append(withdrawals, rewardWithdrawals...)
 
-	fcr, err := k.startBuild(ctx, k.validatorAddr, withdrawals, appHash, timestamp)
+	head, err := k.getExecutionHead(ctx)
+	if err != nil {
+		log.Error(ctx, "Starting optimistic build failed; get execution head", err, logAttr)
+		return errors.Wrap(err, "latest execution block")
+	}
+
+	feeRecipient, err := k.feeRecipient(ctx, k.validatorAddr)
+	if err != nil {
+		log.Error(ctx, "Starting optimistic build failed; get fee recipient", err, logAttr)
+		return errors.Wrap(err, "fee recipient")
+	}
+
+	fcr, version, err := k.startBuild(ctx, nextHeight, feeRecipient, withdrawals, appHash, timestamp)
 	if err != nil || isUnknown(fcr.PayloadStatus) {
 		log.Warn(ctx, "Starting optimistic build failed", err, logAttr)
 		return nil
@@ -234,17 +336,25 @@ func (k *Keeper) PostFinalize(ctx sdk.Context) error {
 		return nil
 	}
 
-	k.setOptimisticPayload(*fcr.PayloadID, uint64(nextHeight))
+	if err := k.setOptimisticPayload(*fcr.PayloadID, uint64(nextHeight), version, head.Hash()); err != nil {
+		log.Warn(ctx, "Persisting optimistic payload failed", err, logAttr)
+	}
 
 	return nil
 }
 
-// startBuild triggers the building of a new execution payload on top of the current execution head.
-// It returns the EngineAPI response which contains a status and payload ID.
-func (k *Keeper) startBuild(ctx context.Context, feeRecipient common.Address, withdrawals []*etypes.Withdrawal, appHash common.Hash, timestamp time.Time) (engine.ForkChoiceResponse, error) {
+// startBuild triggers the building of a new execution payload, to be proposed at the given consensus height,
+// on top of the current execution head. It returns the EngineAPI response which contains a status and
+// payload ID, and the Engine API version of the payload's fork to fetch it with.
+func (k *Keeper) startBuild(ctx context.Context, height int64, feeRecipient common.Address, withdrawals []*etypes.Withdrawal, appHash common.Hash, timestamp time.Time) (engine.ForkChoiceResponse, engineVersion, error) {
 	head, err := k.getExecutionHead(ctx)
 	if err != nil {
-		return engine.ForkChoiceResponse{}, errors.Wrap(err, "latest execution block")
+		return engine.ForkChoiceResponse{}, 0, errors.Wrap(err, "latest execution block")
+	}
+
+	random, err := k.prevRandao(ctx, height, head)
+	if err != nil {
+		return engine.ForkChoiceResponse{}, 0, errors.Wrap(err, "prev randao")
 	}
 
 	// Use provided time as timestamp for the next block.
@@ -254,6 +364,7 @@ func (k *Keeper) startBuild(ctx context.Context, feeRecipient common.Address, wi
 	if ts <= head.GetBlockTime() {
 		ts = head.GetBlockTime() + 1 // Subsequent blocks must have a higher timestamp.
 	}
+	version := k.engineVersionAt(ts)
 
 	// CometBFT has instant finality, so head/safe/finalized is latest height.
 	fcs := engine.ForkchoiceStateV1{
@@ -266,6 +377,7 @@ func (k *Keeper) startBuild(ctx context.Context, feeRecipient common.Address, wi
 		"timestamp", timestamp,
 		"withdrawals", len(withdrawals),
 		"app_hash", appHash.String(),
+		"engine_version", version,
 	)
 
 	// if withdrawals is empty, replace with empty object
@@ -275,7 +387,7 @@ func (k *Keeper) startBuild(ctx context.Context, feeRecipient common.Address, wi
 
 	attrs := &engine.PayloadAttributes{
 		Timestamp:             ts,
-		Random:                head.Hash(), // We use head block hash as randao.
+		Random:                random,
 		SuggestedFeeRecipient: feeRecipient,
 		Withdrawals:           withdrawals,
 		BeaconRoot:            &appHash,
@@ -283,25 +395,8 @@ func (k *Keeper) startBuild(ctx context.Context, feeRecipient common.Address, wi
 
 	resp, err := k.engineCl.ForkchoiceUpdatedV3(ctx, fcs, attrs)
 	if err != nil {
-		return engine.ForkChoiceResponse{}, errors.Wrap(err, "forkchoice update")
-	}
-
-	return resp, nil
-}
-
-// isUnknownPayload returns true if the error is due to an unknown payload.
-func isUnknownPayload(err error) bool {
-	if err == nil {
-		return false
-	}
-
-	// TODO: Add support for typed errors.
-	if strings.Contains(
-		strings.ToLower(err.Error()),
-		strings.ToLower(engine.UnknownPayload.Error()),
-	) {
-		return true
+		return engine.ForkChoiceResponse{}, 0, errors.Wrap(err, "forkchoice update")
 	}
 
-	return false
+	return resp, version, nil
 }
@@ -31,8 +31,10 @@ import (
 	stypes "github.com/cosmos/cosmos-sdk/x/staking/types"
 	"github.com/cosmos/gogoproto/proto"
 	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/accounts/abi"
 	eengine "github.com/ethereum/go-ethereum/beacon/engine"
 	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
 	"github.com/ethereum/go-ethereum/core/types"
 	"github.com/ethereum/go-ethereum/trie"
 	fuzz "github.com/google/gofuzz"
@@ -51,6 +53,18 @@ import (
 
 var zeroAddr common.Address
 
+// mockEvent is the EVM log event of the mockLogProvider, with a single non-indexed uint256 input.
+var mockEvent = abi.NewEvent("Mock", "Mock", false, abi.Arguments{{Name: "value", Type: mustNewType("uint256")}})
+
+func mustNewType(t string) abi.Type {
+	typ, err := abi.NewType(t, "", nil)
+	if err != nil {
+		panic(err)
+	}
+
+	return typ
+}
+
 func TestKeeper_PrepareProposal(t *testing.T) {
 	t.Parallel()
 
@@ -149,7 +163,8 @@ func TestKeeper_PrepareProposal(t *testing.T) {
 				setupMocks: func(esk *moduletestutil.MockEvmStakingKeeper) {
 					esk.EXPECT().MaxWithdrawalPerBlock(gomock.Any()).Return(uint32(0), nil)
 					esk.EXPECT().PeekEligibleWithdrawals(gomock.Any(), gomock.Any()).Return(nil, nil)
-					esk.EXPECT().PeekEligibleRewardWithdrawals(gomock.Any(), uint32(0)).Return(nil, nil)
+					esk.EXPECT().PeekEligibleRewardWithdrawals(gomock.Any(), uint32(0), uint64(0)).Return(nil, nil)
+					esk.EXPECT().GetValidatorFeeRecipient(gomock.Any(), gomock.Any()).Return(common.Address{}, false, nil).AnyTimes()
 				},
 			},
 			{
@@ -167,7 +182,7 @@ func TestKeeper_PrepareProposal(t *testing.T) {
 						}, nil
 					},
 					getPayloadV3Func: func(ctx context.Context, id eengine.PayloadID) (*eengine.ExecutionPayloadEnvelope, error) {
-						return &eengine.ExecutionPayloadEnvelope{}, errors.New("Unknown payload")
+						return &eengine.ExecutionPayloadEnvelope{}, ethclient.ErrUnknownPayload
 					},
 				},
 				mockClient: mock.MockClient{},
@@ -181,7 +196,31 @@ func TestKeeper_PrepareProposal(t *testing.T) {
 				setupMocks: func(esk *moduletestutil.MockEvmStakingKeeper) {
 					esk.EXPECT().MaxWithdrawalPerBlock(gomock.Any()).Return(uint32(0), nil)
 					esk.EXPECT().PeekEligibleWithdrawals(gomock.Any(), gomock.Any()).Return(nil, nil)
-					esk.EXPECT().PeekEligibleRewardWithdrawals(gomock.Any(), uint32(0)).Return(nil, nil)
+					esk.EXPECT().PeekEligibleRewardWithdrawals(gomock.Any(), uint32(0), uint64(0)).Return(nil, nil)
+					esk.EXPECT().GetValidatorFeeRecipient(gomock.Any(), gomock.Any()).Return(common.Address{}, false, nil).AnyTimes()
+				},
+			},
+			{
+				name: "invalid payload attributes",
+				mockEngine: mockEngineAPI{
+					forkchoiceUpdatedV3Func: func(ctx context.Context, update eengine.ForkchoiceStateV1,
+						payloadAttributes *eengine.PayloadAttributes) (eengine.ForkChoiceResponse, error) {
+						return eengine.ForkChoiceResponse{}, errors.Wrap(ethclient.ErrInvalidPayloadAttributes, "rpc forkchoice updated v3")
+					},
+				},
+				mockClient: mock.MockClient{},
+				req: &abci.RequestPrepareProposal{
+					Txs:        nil,
+					Height:     2,
+					Time:       time.Now(),
+					MaxTxBytes: cmttypes.MaxBlockSizeBytes,
+				},
+				wantErr: true,
+				setupMocks: func(esk *moduletestutil.MockEvmStakingKeeper) {
+					esk.EXPECT().MaxWithdrawalPerBlock(gomock.Any()).Return(uint32(0), nil)
+					esk.EXPECT().PeekEligibleWithdrawals(gomock.Any(), gomock.Any()).Return(nil, nil)
+					esk.EXPECT().PeekEligibleRewardWithdrawals(gomock.Any(), uint32(0), uint64(0)).Return(nil, nil)
+					esk.EXPECT().GetValidatorFeeRecipient(gomock.Any(), gomock.Any()).Return(common.Address{}, false, nil).AnyTimes()
 				},
 			},
 			{
@@ -199,7 +238,7 @@ func TestKeeper_PrepareProposal(t *testing.T) {
 						}, nil
 					},
 					getPayloadV3Func: func(ctx context.Context, id eengine.PayloadID) (*eengine.ExecutionPayloadEnvelope, error) {
-						return &eengine.ExecutionPayloadEnvelope{}, errors.New("Unknown payload")
+						return &eengine.ExecutionPayloadEnvelope{}, ethclient.ErrUnknownPayload
 					},
 				},
 				mockClient: mock.MockClient{},
@@ -213,7 +252,8 @@ func TestKeeper_PrepareProposal(t *testing.T) {
 				setupMocks: func(esk *moduletestutil.MockEvmStakingKeeper) {
 					esk.EXPECT().MaxWithdrawalPerBlock(gomock.Any()).Return(uint32(0), nil)
 					esk.EXPECT().PeekEligibleWithdrawals(gomock.Any(), gomock.Any()).Return(nil, nil)
-					esk.EXPECT().PeekEligibleRewardWithdrawals(gomock.Any(), gomock.Any()).Return(nil, nil)
+					esk.EXPECT().PeekEligibleRewardWithdrawals(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil, nil)
+					esk.EXPECT().GetValidatorFeeRecipient(gomock.Any(), gomock.Any()).Return(common.Address{}, false, nil).AnyTimes()
 				},
 			},
 		}
@@ -229,6 +269,7 @@ func TestKeeper_PrepareProposal(t *testing.T) {
 				esk := moduletestutil.NewMockEvmStakingKeeper(ctrl)
 				uk := moduletestutil.NewMockUpgradeKeeper(ctrl)
 				dk := moduletestutil.NewMockDistrKeeper(ctrl)
+				sk := moduletestutil.NewMockStakingKeeper(ctrl)
 
 				if tt.setupMocks != nil {
 					tt.setupMocks(esk)
@@ -238,12 +279,14 @@ func TestKeeper_PrepareProposal(t *testing.T) {
 				tt.mockEngine.EngineClient, err = ethclient.NewEngineMock(storeKey)
 				require.NoError(t, err)
 
-				k, err := NewKeeper(cdc, storeService, &tt.mockEngine, &tt.mockClient, txConfig, ak, esk, uk, dk)
+				k, err := NewKeeper(cdc, storeService, &tt.mockEngine, &tt.mockClient, txConfig, ak, esk, uk, dk, sk, authority)
 				require.NoError(t, err)
 				k.SetValidatorAddress(common.BytesToAddress([]byte("test")))
 				populateGenesisHead(ctx, t, k)
+				head, err := k.getExecutionHead(ctx)
+				require.NoError(t, err)
 				// Set an optimistic payload
-				k.setOptimisticPayload(eengine.PayloadID{}, optimisticPayloadHeight)
+				require.NoError(t, k.setOptimisticPayload(eengine.PayloadID{}, optimisticPayloadHeight, engineV3, head.Hash()))
 
 				_, err = k.PrepareProposal(withRandomErrs(t, ctx), tt.req)
 				if (err != nil) != tt.wantErr {
@@ -270,13 +313,15 @@ func TestKeeper_PrepareProposal(t *testing.T) {
 		esk := moduletestutil.NewMockEvmStakingKeeper(ctrl)
 		uk := moduletestutil.NewMockUpgradeKeeper(ctrl)
 		dk := moduletestutil.NewMockDistrKeeper(ctrl)
+		sk := moduletestutil.NewMockStakingKeeper(ctrl)
 		// Expected call for PeekEligibleWithdrawals
 
 		esk.EXPECT().MaxWithdrawalPerBlock(gomock.Any()).Return(uint32(0), nil)
 		esk.EXPECT().PeekEligibleWithdrawals(gomock.Any(), gomock.Any()).Return(nil, nil).AnyTimes()
-		esk.EXPECT().PeekEligibleRewardWithdrawals(gomock.Any(), gomock.Any()).Return(nil, nil).AnyTimes()
+		esk.EXPECT().PeekEligibleRewardWithdrawals(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil, nil).AnyTimes()
+		esk.EXPECT().GetValidatorFeeRecipient(gomock.Any(), gomock.Any()).Return(common.Address{}, false, nil).AnyTimes()
 
-		keeper, err := NewKeeper(cdc, storeService, &mockEngine, mockClient, txConfig, ak, esk, uk, dk)
+		keeper, err := NewKeeper(cdc, storeService, &mockEngine, mockClient, txConfig, ak, esk, uk, dk, sk, authority)
 		require.NoError(t, err)
 		keeper.SetValidatorAddress(common.BytesToAddress([]byte("test")))
 		populateGenesisHead(ctx, t, keeper)
@@ -314,17 +359,123 @@ func TestKeeper_PrepareProposal(t *testing.T) {
 			}
 		}
 	})
+
+	t.Run("TestUnknownOptimisticPayload", func(t *testing.T) {
+		t.Parallel()
+		// setup dependencies
+		ctx, storeKey, storeService := setupCtxStore(t, nil)
+		cdc := getCodec(t)
+		txConfig := authtx.NewTxConfig(cdc, nil)
+
+		mockEngine, err := newMockEngineAPI(storeKey, 0)
+		require.NoError(t, err)
+
+		ctrl := gomock.NewController(t)
+		mockClient := mock.NewMockClient(ctrl)
+		ak := moduletestutil.NewMockAccountKeeper(ctrl)
+		esk := moduletestutil.NewMockEvmStakingKeeper(ctrl)
+		uk := moduletestutil.NewMockUpgradeKeeper(ctrl)
+		dk := moduletestutil.NewMockDistrKeeper(ctrl)
+		sk := moduletestutil.NewMockStakingKeeper(ctrl)
+
+		esk.EXPECT().MaxWithdrawalPerBlock(gomock.Any()).Return(uint32(0), nil)
+		esk.EXPECT().PeekEligibleWithdrawals(gomock.Any(), gomock.Any()).Return(nil, nil)
+		esk.EXPECT().PeekEligibleRewardWithdrawals(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil, nil)
+		esk.EXPECT().GetValidatorFeeRecipient(gomock.Any(), gomock.Any()).Return(common.Address{}, false, nil).AnyTimes()
+
+		keeper, err := NewKeeper(cdc, storeService, &mockEngine, mockClient, txConfig, ak, esk, uk, dk, sk, authority)
+		require.NoError(t, err)
+		keeper.SetValidatorAddress(common.BytesToAddress([]byte("test")))
+		populateGenesisHead(ctx, t, keeper)
+
+		head, err := keeper.getExecutionHead(ctx)
+		require.NoError(t, err)
+
+		// The optimistic payload was dropped by the EL, e.g. it was restored after a restart of both.
+		require.NoError(t, keeper.setOptimisticPayload(eengine.PayloadID{0xff}, 2, engineV3, head.Hash()))
+
+		req := &abci.RequestPrepareProposal{
+			Txs:        nil,
+			Height:     int64(2),
+			Time:       time.Now(),
+			MaxTxBytes: cmttypes.MaxBlockSizeBytes,
+		}
+
+		// A new payload is built instead.
+		resp, err := keeper.PrepareProposal(ctx, req)
+		require.NoError(t, err)
+		require.NotNil(t, resp)
+		require.Len(t, resp.Txs, 1)
+
+		tx, err := txConfig.TxDecoder()(resp.Txs[0])
+		require.NoError(t, err)
+		for _, msg := range tx.GetMsgs() {
+			if _, ok := msg.(*etypes.MsgExecutionPayload); ok {
+				assertExecutablePayload(t, msg, req.Time.Unix(), head.Hash(), keeper.validatorAddr, head.GetBlockHeight()+1)
+			}
+		}
+	})
+
+	t.Run("TestProposalDeadlineExceeded", func(t *testing.T) {
+		t.Parallel()
+		// setup dependencies
+		ctx, storeKey, storeService := setupCtxStore(t, nil)
+		cdc := getCodec(t)
+		txConfig := authtx.NewTxConfig(cdc, nil)
+
+		// The EVM is unavailable, so building the payload is retried until the deadline.
+		mockEngine := mockEngineAPI{
+			forkchoiceUpdatedV3Func: func(context.Context, eengine.ForkchoiceStateV1, *eengine.PayloadAttributes,
+			) (eengine.ForkChoiceResponse, error) {
+				return eengine.ForkChoiceResponse{}, errors.New("connection refused")
+			},
+		}
+		var err error
+		mockEngine.EngineClient, err = ethclient.NewEngineMock(storeKey)
+		require.NoError(t, err)
+
+		ctrl := gomock.NewController(t)
+		ak := moduletestutil.NewMockAccountKeeper(ctrl)
+		esk := moduletestutil.NewMockEvmStakingKeeper(ctrl)
+		uk := moduletestutil.NewMockUpgradeKeeper(ctrl)
+		dk := moduletestutil.NewMockDistrKeeper(ctrl)
+		sk := moduletestutil.NewMockStakingKeeper(ctrl)
+
+		esk.EXPECT().MaxWithdrawalPerBlock(gomock.Any()).Return(uint32(0), nil)
+		esk.EXPECT().PeekEligibleWithdrawals(gomock.Any(), gomock.Any()).Return(nil, nil)
+		esk.EXPECT().PeekEligibleRewardWithdrawals(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil, nil)
+		esk.EXPECT().GetValidatorFeeRecipient(gomock.Any(), gomock.Any()).Return(common.Address{}, false, nil).AnyTimes()
+
+		keeper, err := NewKeeper(cdc, storeService, &mockEngine, &mock.MockClient{}, txConfig, ak, esk, uk, dk, sk, authority)
+		require.NoError(t, err)
+		keeper.SetValidatorAddress(common.BytesToAddress([]byte("test")))
+		keeper.SetProposalDeadline(100 * time.Millisecond)
+		populateGenesisHead(ctx, t, keeper)
+
+		req := &abci.RequestPrepareProposal{
+			Txs:        nil,
+			Height:     int64(2),
+			Time:       time.Now(),
+			MaxTxBytes: cmttypes.MaxBlockSizeBytes,
+		}
+
+		// An empty block is proposed instead of blocking until the EVM recovers.
+		resp, err := keeper.PrepareProposal(ctx, req)
+		require.NoError(t, err)
+		require.NotNil(t, resp)
+		require.Empty(t, resp.Txs)
+	})
 }
 
 //nolint:paralleltest // no parallel test for now
 func TestKeeper_PostFinalize(t *testing.T) {
 	payloadID := eengine.PayloadID{0x1}
 	payloadFailedToSet := func(k *Keeper) {
-		id, _, _ := k.getOptimisticPayload()
+		id := k.getOptimisticPayload().ID
 		require.Equal(t, eengine.PayloadID{}, id)
 	}
 	payloadWellSet := func(k *Keeper) {
-		id, _, _ := k.getOptimisticPayload()
+		id := k.getOptimisticPayload().ID
 		require.NotNil(t, id)
 		require.Equal(t, payloadID, id)
 	}
@@ -378,7 +529,8 @@ func TestKeeper_PostFinalize(t *testing.T) {
 			setupMocks: func(esk *moduletestutil.MockEvmStakingKeeper) {
 				esk.EXPECT().MaxWithdrawalPerBlock(gomock.Any()).Return(uint32(0), nil)
 				esk.EXPECT().PeekEligibleWithdrawals(gomock.Any(), gomock.Any()).Return(nil, nil)
-				esk.EXPECT().PeekEligibleRewardWithdrawals(gomock.Any(), uint32(0)).Return(nil, nil)
+				esk.EXPECT().PeekEligibleRewardWithdrawals(gomock.Any(), uint32(0), uint64(0)).Return(nil, nil)
+				esk.EXPECT().GetValidatorFeeRecipient(gomock.Any(), gomock.Any()).Return(common.Address{}, false, nil).AnyTimes()
 			},
 			postStateCheck: payloadFailedToSet,
 		},
@@ -403,7 +555,8 @@ func TestKeeper_PostFinalize(t *testing.T) {
 			setupMocks: func(esk *moduletestutil.MockEvmStakingKeeper) {
 				esk.EXPECT().MaxWithdrawalPerBlock(gomock.Any()).Return(uint32(0), nil)
 				esk.EXPECT().PeekEligibleWithdrawals(gomock.Any(), gomock.Any()).Return(nil, nil)
-				esk.EXPECT().PeekEligibleRewardWithdrawals(gomock.Any(), uint32(0)).Return(nil, nil)
+				esk.EXPECT().PeekEligibleRewardWithdrawals(gomock.Any(), uint32(0), uint64(0)).Return(nil, nil)
+				esk.EXPECT().GetValidatorFeeRecipient(gomock.Any(), gomock.Any()).Return(common.Address{}, false, nil).AnyTimes()
 			},
 			postStateCheck: payloadFailedToSet,
 		},
@@ -428,7 +581,8 @@ func TestKeeper_PostFinalize(t *testing.T) {
 			setupMocks: func(esk *moduletestutil.MockEvmStakingKeeper) {
 				esk.EXPECT().MaxWithdrawalPerBlock(gomock.Any()).Return(uint32(0), nil)
 				esk.EXPECT().PeekEligibleWithdrawals(gomock.Any(), gomock.Any()).Return(nil, nil)
-				esk.EXPECT().PeekEligibleRewardWithdrawals(gomock.Any(), gomock.Any()).Return(nil, nil)
+				esk.EXPECT().PeekEligibleRewardWithdrawals(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil, nil)
+				esk.EXPECT().GetValidatorFeeRecipient(gomock.Any(), gomock.Any()).Return(common.Address{}, false, nil).AnyTimes()
 			},
 			postStateCheck: payloadFailedToSet,
 		},
@@ -453,7 +607,8 @@ func TestKeeper_PostFinalize(t *testing.T) {
 			setupMocks: func(esk *moduletestutil.MockEvmStakingKeeper) {
 				esk.EXPECT().MaxWithdrawalPerBlock(gomock.Any()).Return(uint32(0), nil)
 				esk.EXPECT().PeekEligibleWithdrawals(gomock.Any(), gomock.Any()).Return(nil, nil)
-				esk.EXPECT().PeekEligibleRewardWithdrawals(gomock.Any(), uint32(0)).Return(nil, nil)
+				esk.EXPECT().PeekEligibleRewardWithdrawals(gomock.Any(), uint32(0), uint64(0)).Return(nil, nil)
+				esk.EXPECT().GetValidatorFeeRecipient(gomock.Any(), gomock.Any()).Return(common.Address{}, false, nil).AnyTimes()
 			},
 			postStateCheck: payloadWellSet,
 		},
@@ -470,6 +625,7 @@ func TestKeeper_PostFinalize(t *testing.T) {
 			esk := moduletestutil.NewMockEvmStakingKeeper(ctrl)
 			uk := moduletestutil.NewMockUpgradeKeeper(ctrl)
 			dk := moduletestutil.NewMockDistrKeeper(ctrl)
+			sk := moduletestutil.NewMockStakingKeeper(ctrl)
 
 			if tt.setupMocks != nil {
 				tt.setupMocks(esk)
@@ -488,7 +644,7 @@ func TestKeeper_PostFinalize(t *testing.T) {
 			tt.mockEngine.EngineClient, err = ethclient.NewEngineMock(storeKey)
 			require.NoError(t, err)
 
-			k, err := NewKeeper(cdc, storeService, &tt.mockEngine, &tt.mockClient, txConfig, ak, esk, uk, dk)
+			k, err := NewKeeper(cdc, storeService, &tt.mockEngine, &tt.mockClient, txConfig, ak, esk, uk, dk, sk, authority)
 			require.NoError(t, err)
 			k.SetCometAPI(cmtAPI)
 			k.SetValidatorAddress(nxtAddr)
@@ -647,22 +803,33 @@ func (m mockLogProvider) Name() string {
 func (m mockLogProvider) Prepare(_ context.Context, blockHash common.Hash) ([]*etypes.EVMEvent, error) {
 	f := fuzz.NewWithSeed(int64(blockHash[0]))
 
-	var topic common.Hash
-	f.Fuzz(&topic)
+	var value common.Hash
+	f.Fuzz(&value)
 
 	return []*etypes.EVMEvent{{
 		Address: zeroAddr.Bytes(),
-		Topics:  [][]byte{topic[:]},
+		Topics:  [][]byte{mockEvent.ID.Bytes()},
+		Data:    value.Bytes(),
 	}}, nil
 }
 
+func (m mockLogProvider) Order() uint32 {
+	return 100
+}
+
 func (m mockLogProvider) Addresses() []common.Address {
 	return []common.Address{zeroAddr}
 }
 
-func (m mockLogProvider) Deliver(_ context.Context, _ common.Hash, log *etypes.EVMEvent) error {
-	if !bytes.Equal(log.Address, zeroAddr.Bytes()) {
-		panic("unexpected evm log address")
+func (m mockLogProvider) Events() []abi.Event {
+	return []abi.Event{mockEvent}
+}
+
+func (m mockLogProvider) Deliver(_ context.Context, _ uint64, events []*etypes.EVMEvent) error {
+	for _, log := range events {
+		if !bytes.Equal(log.Address, zeroAddr.Bytes()) {
+			panic("unexpected evm log address")
+		}
 	}
 
 	return m.deliverErr
@@ -748,6 +915,14 @@ func (m *mockEngineAPI) GetPayloadV3(ctx context.Context, payloadID eengine.Payl
 	return m.mock.GetPayloadV3(ctx, payloadID)
 }
 
+func (m *mockEngineAPI) NewPayloadV4(ctx context.Context, params eengine.ExecutableData, versionedHashes []common.Hash, beaconRoot *common.Hash, executionRequests []hexutil.Bytes) (eengine.PayloadStatusV1, error) {
+	return m.mock.NewPayloadV4(ctx, params, versionedHashes, beaconRoot, executionRequests)
+}
+
+func (m *mockEngineAPI) GetPayloadV4(ctx context.Context, payloadID eengine.PayloadID) (*ethclient.ExecutionPayloadEnvelopeV4, error) {
+	return m.mock.GetPayloadV4(ctx, payloadID)
+}
+
 // nextBlock creates a new block with the given height, timestamp, parentHash, and feeRecipient. It also returns the
 // payload for the block. It's a utility function for testing.
 func (m *mockEngineAPI) nextBlock(
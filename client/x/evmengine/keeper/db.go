@@ -19,19 +19,28 @@ const executionHeadID = 1
 
 // InsertGenesisHead inserts the genesis execution head into the database.
 func (k *Keeper) InsertGenesisHead(ctx context.Context, executionBlockHash []byte) error {
-	id, err := k.headTable.InsertReturningId(ctx, &ExecutionHead{
+	return k.insertGenesisHead(ctx, 0, executionBlockHash)
+}
+
+// insertGenesisHead inserts the genesis execution head with the given block number into the database.
+// The block number is only non-zero when the chain is restarted from an exported genesis.
+func (k *Keeper) insertGenesisHead(ctx context.Context, executionBlockNumber uint64, executionBlockHash []byte) error {
+	head := &ExecutionHead{
 		CreatedHeight: 0, // genesis
-		BlockHeight:   0, // genesis
+		BlockHeight:   executionBlockNumber,
 		BlockHash:     executionBlockHash,
 		BlockTime:     0, // Timestamp isn't critical, skip it in genesis.
-	})
+	}
+	k.eventsCache.Purge() // The chain is (re)initialized, drop the events of any previous chain.
+
+	id, err := k.headTable.InsertReturningId(ctx, head)
 	if err != nil {
 		return errors.Wrap(err, "insert genesis head")
 	} else if id != executionHeadID {
 		return errors.New("unexpected genesis head id", "id", id)
 	}
 
-	return nil
+	return k.saveHeadHistory(ctx, head)
 }
 
 // getExecutionHead returns the current execution head.
@@ -46,12 +55,15 @@ func (k *Keeper) getExecutionHead(ctx context.Context) (*ExecutionHead, error) {
 
 // updateExecutionHead updates the execution head with the given payload.
 func (k *Keeper) updateExecutionHead(ctx context.Context, payload engine.ExecutableData) error {
+	k.invalidateEventsCache(ctx, payload)
+
 	head := &ExecutionHead{
 		Id:            executionHeadID,
 		CreatedHeight: uint64(sdk.UnwrapSDKContext(ctx).BlockHeight()),
 		BlockHeight:   payload.Number,
 		BlockHash:     payload.BlockHash.Bytes(),
 		BlockTime:     payload.Timestamp,
+		GasLimit:      payload.GasLimit,
 	}
 
 	err := k.headTable.Update(ctx, head)
@@ -59,5 +71,75 @@ func (k *Keeper) updateExecutionHead(ctx context.Context, payload engine.Executa
 		return errors.Wrap(err, "update execution head")
 	}
 
+	if err := k.saveHeadHistory(ctx, head); err != nil {
+		return err
+	}
+
+	return k.pruneHeadHistory(ctx, head.CreatedHeight)
+}
+
+// invalidateEventsCache removes the cached EVM log events that are no longer needed once the given
+// payload is finalized, i.e., the events of its parent that were included with it. All events are removed
+// if the payload isn't built on top of the current execution head, e.g., after a reorg or rollback.
+func (k *Keeper) invalidateEventsCache(ctx context.Context, payload engine.ExecutableData) {
+	head, err := k.getExecutionHead(ctx)
+	if err != nil || head.Hash() != payload.ParentHash {
+		k.eventsCache.Purge()
+		return
+	}
+
+	k.eventsCache.Remove(payload.ParentHash)
+}
+
+// getHeadHistory returns the execution head finalized at the given consensus chain height.
+func (k *Keeper) getHeadHistory(ctx context.Context, height uint64) (*ExecutionHeadHistory, error) {
+	history, err := k.headHistoryTable.Get(ctx, height)
+	if err != nil {
+		return nil, errors.Wrap(err, "get execution head history")
+	}
+
+	return history, nil
+}
+
+// saveHeadHistory records the execution head in the execution head history, keyed by its consensus chain height.
+func (k *Keeper) saveHeadHistory(ctx context.Context, head *ExecutionHead) error {
+	err := k.headHistoryTable.Save(ctx, &ExecutionHeadHistory{
+		CreatedHeight: head.GetCreatedHeight(),
+		BlockHeight:   head.GetBlockHeight(),
+		BlockHash:     head.GetBlockHash(),
+		BlockTime:     head.GetBlockTime(),
+	})
+	if err != nil {
+		return errors.Wrap(err, "save execution head history")
+	}
+
+	return nil
+}
+
+// pruneHeadHistory deletes the execution head history older than the head history retention param,
+// i.e. it only keeps the execution heads of the last `retention` consensus chain heights.
+func (k *Keeper) pruneHeadHistory(ctx context.Context, height uint64) error {
+	params, err := k.GetParams(ctx)
+	if err != nil {
+		return err
+	}
+
+	retention := params.GetHeadHistoryRetention()
+	if retention == 0 || height < retention {
+		return nil // Keep all history.
+	}
+
+	// Range iteration requires distinct keys, so the genesis head is deleted by key.
+	from := ExecutionHeadHistoryPrimaryKey{}.WithCreatedHeight(0)
+	to := ExecutionHeadHistoryPrimaryKey{}.WithCreatedHeight(height - retention)
+	if height == retention {
+		err = k.headHistoryTable.DeleteBy(ctx, from)
+	} else {
+		err = k.headHistoryTable.DeleteRange(ctx, from, to)
+	}
+	if err != nil {
+		return errors.Wrap(err, "prune execution head history")
+	}
+
 	return nil
 }
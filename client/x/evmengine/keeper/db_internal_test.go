@@ -3,9 +3,14 @@ package keeper
 import (
 	"testing"
 
+	"cosmossdk.io/orm/types/ormerrors"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/ethereum/go-ethereum/beacon/engine"
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/stretchr/testify/require"
+
+	"github.com/piplabs/story/client/x/evmengine/types"
 )
 
 func TestKeeper_InsertGenesisHead(t *testing.T) {
@@ -58,6 +63,7 @@ func TestKeeper_updateExecutionHead(t *testing.T) {
 		Number:    100,
 		BlockHash: newBlockHash,
 		Timestamp: 0,
+		GasLimit:  30_000_000,
 	})
 	require.NoError(t, err)
 
@@ -67,4 +73,49 @@ func TestKeeper_updateExecutionHead(t *testing.T) {
 	require.NotNil(t, head, "execution head should exist")
 	require.Equal(t, newBlockHash.Bytes(), head.GetBlockHash(), "block hash should match")
 	require.Equal(t, uint64(100), head.GetBlockHeight(), "block height should match")
+	require.Equal(t, uint64(30_000_000), head.GetGasLimit(), "gas limit should match")
+}
+
+func TestKeeper_headHistory(t *testing.T) {
+	t.Parallel()
+
+	ctx, keeper := createTestKeeper(t)
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	require.NoError(t, keeper.SetParams(ctx, types.NewParams(nil, 3, 0, 0, 0)))
+
+	// the genesis head is recorded at height 0
+	genesisHash := common.BytesToHash([]byte("genesis"))
+	require.NoError(t, keeper.InsertGenesisHead(ctx, genesisHash.Bytes()))
+	history, err := keeper.getHeadHistory(ctx, 0)
+	require.NoError(t, err)
+	require.Equal(t, genesisHash.Bytes(), history.GetBlockHash())
+
+	// finalize execution blocks at heights 1 to 5
+	for height := uint64(1); height <= 5; height++ {
+		err := keeper.updateExecutionHead(sdkCtx.WithBlockHeight(int64(height)), engine.ExecutableData{
+			Number:    height + 10,
+			BlockHash: common.BytesToHash([]byte{byte(height)}),
+			Timestamp: height * 2,
+		})
+		require.NoError(t, err)
+	}
+
+	// only the last 3 heights are retained
+	for height := uint64(0); height <= 2; height++ {
+		_, err := keeper.getHeadHistory(ctx, height)
+		require.True(t, ormerrors.IsNotFound(err), "height %d should be pruned", height)
+	}
+	for height := uint64(3); height <= 5; height++ {
+		history, err := keeper.getHeadHistory(ctx, height)
+		require.NoError(t, err)
+		require.Equal(t, height+10, history.GetBlockHeight())
+		require.Equal(t, common.BytesToHash([]byte{byte(height)}).Bytes(), history.GetBlockHash())
+		require.Equal(t, height*2, history.GetBlockTime())
+	}
+
+	// a retention of 0 keeps all history
+	require.NoError(t, keeper.SetParams(ctx, types.NewParams(nil, 0, 0, 0, 0)))
+	require.NoError(t, keeper.updateExecutionHead(sdkCtx.WithBlockHeight(6), engine.ExecutableData{Number: 16}))
+	_, err = keeper.getHeadHistory(ctx, 3)
+	require.NoError(t, err)
 }
@@ -0,0 +1,62 @@
+package keeper
+
+import (
+	"context"
+
+	"github.com/ethereum/go-ethereum/beacon/engine"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+)
+
+// engineVersion is the Engine API version of the payload methods, i.e. engine_newPayload and engine_getPayload.
+// Note that engine_forkchoiceUpdatedV3 is used for both versions.
+type engineVersion int
+
+const (
+	engineV3 engineVersion = 3 // Cancun
+	engineV4 engineVersion = 4 // Prague, adds the EIP-7685 execution requests.
+)
+
+// engineVersionAt returns the Engine API version of the execution payload with the given timestamp.
+func (k *Keeper) engineVersionAt(timestamp uint64) engineVersion {
+	if k.pragueTime != nil && timestamp >= *k.pragueTime {
+		return engineV4
+	}
+
+	return engineV3
+}
+
+// getPayload returns the built execution payload and its execution requests using the given Engine API version.
+func (k *Keeper) getPayload(ctx context.Context, version engineVersion, payloadID engine.PayloadID) (
+	*engine.ExecutionPayloadEnvelope, [][]byte, error,
+) {
+	if version == engineV3 {
+		resp, err := k.engineCl.GetPayloadV3(ctx, payloadID)
+		if err != nil {
+			return nil, nil, err
+		}
+
+		return resp, nil, nil
+	}
+
+	resp, err := k.engineCl.GetPayloadV4(ctx, payloadID)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	executionRequests := make([][]byte, 0, len(resp.ExecutionRequests))
+	for _, request := range resp.ExecutionRequests {
+		executionRequests = append(executionRequests, request)
+	}
+
+	return &resp.ExecutionPayloadEnvelope, executionRequests, nil
+}
+
+// toHexBytes converts the execution requests of a MsgExecutionPayload to the Engine API type.
+func toHexBytes(executionRequests [][]byte) []hexutil.Bytes {
+	resp := make([]hexutil.Bytes, 0, len(executionRequests)) // Cannot use nil.
+	for _, request := range executionRequests {
+		resp = append(resp, request)
+	}
+
+	return resp
+}
@@ -0,0 +1,117 @@
+package keeper
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+
+	cmtproto "github.com/cometbft/cometbft/proto/tendermint/types"
+	authtx "github.com/cosmos/cosmos-sdk/x/auth/tx"
+	"github.com/ethereum/go-ethereum/beacon/engine"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/stretchr/testify/require"
+
+	moduletestutil "github.com/piplabs/story/client/x/evmengine/testutil"
+	"github.com/piplabs/story/client/x/evmengine/types"
+	"github.com/piplabs/story/lib/ethclient"
+	"github.com/piplabs/story/lib/ethclient/mock"
+	"github.com/piplabs/story/lib/tutil"
+
+	"go.uber.org/mock/gomock"
+)
+
+func TestKeeper_engineVersions(t *testing.T) {
+	t.Parallel()
+
+	genesisBlock, err := ethclient.MockGenesisBlock()
+	require.NoError(t, err)
+	pragueTime := genesisBlock.Time() + 10
+	request := hexutil.Bytes{0x00, 0x01, 0x02}
+
+	tests := []struct {
+		name         string
+		timestamp    uint64
+		wantVersion  engineVersion
+		wantRequests [][]byte
+	}{
+		{
+			name:         "cancun payload",
+			timestamp:    pragueTime - 1,
+			wantVersion:  engineV3,
+			wantRequests: nil,
+		},
+		{
+			name:         "prague payload",
+			timestamp:    pragueTime,
+			wantVersion:  engineV4,
+			wantRequests: [][]byte{request},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			cdc := getCodec(t)
+			txConfig := authtx.NewTxConfig(cdc, nil)
+			ctrl := gomock.NewController(t)
+			ak := moduletestutil.NewMockAccountKeeper(ctrl)
+			esk := moduletestutil.NewMockEvmStakingKeeper(ctrl)
+			uk := moduletestutil.NewMockUpgradeKeeper(ctrl)
+			dk := moduletestutil.NewMockDistrKeeper(ctrl)
+			sk := moduletestutil.NewMockStakingKeeper(ctrl)
+
+			appHash := tutil.RandomHash()
+			blockTime := time.Unix(int64(tt.timestamp), 0)
+			ctx, storeKey, storeService := setupCtxStore(t, &cmtproto.Header{AppHash: appHash.Bytes(), Time: blockTime})
+			engineCl, err := ethclient.NewEngineMock(storeKey,
+				ethclient.WithMockPragueTime(pragueTime),
+				ethclient.WithMockExecutionRequests(request),
+			)
+			require.NoError(t, err)
+
+			keeper, err := NewKeeper(cdc, storeService, engineCl, mock.NewMockClient(ctrl), txConfig, ak, esk, uk, dk, sk, authority)
+			require.NoError(t, err)
+			keeper.SetPragueTime(&pragueTime)
+			populateGenesisHead(ctx, t, keeper)
+
+			// Build the payload, the version depends on the payload timestamp.
+			fcr, version, err := keeper.startBuild(ctx, 2, common.Address{}, nil, appHash, blockTime)
+			require.NoError(t, err)
+			require.Equal(t, tt.wantVersion, version)
+			require.NotNil(t, fcr.PayloadID)
+
+			// The payload can't be fetched with the other fork's version.
+			otherVersion := engineV4
+			if version == engineV4 {
+				otherVersion = engineV3
+			}
+			_, _, err = keeper.getPayload(ctx, otherVersion, *fcr.PayloadID)
+			require.ErrorIs(t, err, ethclient.ErrUnsupportedFork)
+
+			envelope, executionRequests, err := keeper.getPayload(ctx, version, *fcr.PayloadID)
+			require.NoError(t, err)
+			require.Equal(t, tt.wantRequests, executionRequests)
+
+			// The proposed payload must only include execution requests after the fork.
+			payloadData, err := json.Marshal(envelope.ExecutionPayload)
+			require.NoError(t, err)
+			msg := &types.MsgExecutionPayload{
+				ExecutionPayload:  payloadData,
+				ExecutionRequests: [][]byte{request},
+			}
+			_, err = keeper.parseAndVerifyProposedPayload(ctx, msg)
+			if version == engineV4 {
+				require.NoError(t, err)
+			} else {
+				require.ErrorContains(t, err, "execution requests before prague fork")
+			}
+
+			// The payload is pushed with the version of its fork.
+			status, err := keeper.pushPayload(ctx, *envelope.ExecutionPayload, executionRequests)
+			require.NoError(t, err)
+			require.Equal(t, engine.VALID, status.Status)
+		})
+	}
+}
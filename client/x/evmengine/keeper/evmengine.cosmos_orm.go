@@ -133,20 +133,260 @@ func NewExecutionHeadTable(db ormtable.Schema) (ExecutionHeadTable, error) {
 	return executionHeadTable{table.(ormtable.AutoIncrementTable)}, nil
 }
 
+type ExecutionHeadHistoryTable interface {
+	Insert(ctx context.Context, executionHeadHistory *ExecutionHeadHistory) error
+	Update(ctx context.Context, executionHeadHistory *ExecutionHeadHistory) error
+	Save(ctx context.Context, executionHeadHistory *ExecutionHeadHistory) error
+	Delete(ctx context.Context, executionHeadHistory *ExecutionHeadHistory) error
+	Has(ctx context.Context, created_height uint64) (found bool, err error)
+	// Get returns nil and an error which responds true to ormerrors.IsNotFound() if the record was not found.
+	Get(ctx context.Context, created_height uint64) (*ExecutionHeadHistory, error)
+	List(ctx context.Context, prefixKey ExecutionHeadHistoryIndexKey, opts ...ormlist.Option) (ExecutionHeadHistoryIterator, error)
+	ListRange(ctx context.Context, from, to ExecutionHeadHistoryIndexKey, opts ...ormlist.Option) (ExecutionHeadHistoryIterator, error)
+	DeleteBy(ctx context.Context, prefixKey ExecutionHeadHistoryIndexKey) error
+	DeleteRange(ctx context.Context, from, to ExecutionHeadHistoryIndexKey) error
+
+	doNotImplement()
+}
+
+type ExecutionHeadHistoryIterator struct {
+	ormtable.Iterator
+}
+
+func (i ExecutionHeadHistoryIterator) Value() (*ExecutionHeadHistory, error) {
+	var executionHeadHistory ExecutionHeadHistory
+	err := i.UnmarshalMessage(&executionHeadHistory)
+	return &executionHeadHistory, err
+}
+
+type ExecutionHeadHistoryIndexKey interface {
+	id() uint32
+	values() []interface{}
+	executionHeadHistoryIndexKey()
+}
+
+// primary key starting index..
+type ExecutionHeadHistoryPrimaryKey = ExecutionHeadHistoryCreatedHeightIndexKey
+
+type ExecutionHeadHistoryCreatedHeightIndexKey struct {
+	vs []interface{}
+}
+
+func (x ExecutionHeadHistoryCreatedHeightIndexKey) id() uint32                    { return 0 }
+func (x ExecutionHeadHistoryCreatedHeightIndexKey) values() []interface{}         { return x.vs }
+func (x ExecutionHeadHistoryCreatedHeightIndexKey) executionHeadHistoryIndexKey() {}
+
+func (this ExecutionHeadHistoryCreatedHeightIndexKey) WithCreatedHeight(created_height uint64) ExecutionHeadHistoryCreatedHeightIndexKey {
+	this.vs = []interface{}{created_height}
+	return this
+}
+
+type executionHeadHistoryTable struct {
+	table ormtable.Table
+}
+
+func (this executionHeadHistoryTable) Insert(ctx context.Context, executionHeadHistory *ExecutionHeadHistory) error {
+	return this.table.Insert(ctx, executionHeadHistory)
+}
+
+func (this executionHeadHistoryTable) Update(ctx context.Context, executionHeadHistory *ExecutionHeadHistory) error {
+	return this.table.Update(ctx, executionHeadHistory)
+}
+
+func (this executionHeadHistoryTable) Save(ctx context.Context, executionHeadHistory *ExecutionHeadHistory) error {
+	return this.table.Save(ctx, executionHeadHistory)
+}
+
+func (this executionHeadHistoryTable) Delete(ctx context.Context, executionHeadHistory *ExecutionHeadHistory) error {
+	return this.table.Delete(ctx, executionHeadHistory)
+}
+
+func (this executionHeadHistoryTable) Has(ctx context.Context, created_height uint64) (found bool, err error) {
+	return this.table.PrimaryKey().Has(ctx, created_height)
+}
+
+func (this executionHeadHistoryTable) Get(ctx context.Context, created_height uint64) (*ExecutionHeadHistory, error) {
+	var executionHeadHistory ExecutionHeadHistory
+	found, err := this.table.PrimaryKey().Get(ctx, &executionHeadHistory, created_height)
+	if err != nil {
+		return nil, err
+	}
+	if !found {
+		return nil, ormerrors.NotFound
+	}
+	return &executionHeadHistory, nil
+}
+
+func (this executionHeadHistoryTable) List(ctx context.Context, prefixKey ExecutionHeadHistoryIndexKey, opts ...ormlist.Option) (ExecutionHeadHistoryIterator, error) {
+	it, err := this.table.GetIndexByID(prefixKey.id()).List(ctx, prefixKey.values(), opts...)
+	return ExecutionHeadHistoryIterator{it}, err
+}
+
+func (this executionHeadHistoryTable) ListRange(ctx context.Context, from, to ExecutionHeadHistoryIndexKey, opts ...ormlist.Option) (ExecutionHeadHistoryIterator, error) {
+	it, err := this.table.GetIndexByID(from.id()).ListRange(ctx, from.values(), to.values(), opts...)
+	return ExecutionHeadHistoryIterator{it}, err
+}
+
+func (this executionHeadHistoryTable) DeleteBy(ctx context.Context, prefixKey ExecutionHeadHistoryIndexKey) error {
+	return this.table.GetIndexByID(prefixKey.id()).DeleteBy(ctx, prefixKey.values()...)
+}
+
+func (this executionHeadHistoryTable) DeleteRange(ctx context.Context, from, to ExecutionHeadHistoryIndexKey) error {
+	return this.table.GetIndexByID(from.id()).DeleteRange(ctx, from.values(), to.values())
+}
+
+func (this executionHeadHistoryTable) doNotImplement() {}
+
+var _ ExecutionHeadHistoryTable = executionHeadHistoryTable{}
+
+func NewExecutionHeadHistoryTable(db ormtable.Schema) (ExecutionHeadHistoryTable, error) {
+	table := db.GetTable(&ExecutionHeadHistory{})
+	if table == nil {
+		return nil, ormerrors.TableNotFound.Wrap(string((&ExecutionHeadHistory{}).ProtoReflect().Descriptor().FullName()))
+	}
+	return executionHeadHistoryTable{table}, nil
+}
+
+type ValidatorUBITable interface {
+	Insert(ctx context.Context, validatorUbi *ValidatorUBI) error
+	Update(ctx context.Context, validatorUbi *ValidatorUBI) error
+	Save(ctx context.Context, validatorUbi *ValidatorUBI) error
+	Delete(ctx context.Context, validatorUbi *ValidatorUBI) error
+	Has(ctx context.Context, validator_pubkey []byte) (found bool, err error)
+	// Get returns nil and an error which responds true to ormerrors.IsNotFound() if the record was not found.
+	Get(ctx context.Context, validator_pubkey []byte) (*ValidatorUBI, error)
+	List(ctx context.Context, prefixKey ValidatorUBIIndexKey, opts ...ormlist.Option) (ValidatorUBIIterator, error)
+	ListRange(ctx context.Context, from, to ValidatorUBIIndexKey, opts ...ormlist.Option) (ValidatorUBIIterator, error)
+	DeleteBy(ctx context.Context, prefixKey ValidatorUBIIndexKey) error
+	DeleteRange(ctx context.Context, from, to ValidatorUBIIndexKey) error
+
+	doNotImplement()
+}
+
+type ValidatorUBIIterator struct {
+	ormtable.Iterator
+}
+
+func (i ValidatorUBIIterator) Value() (*ValidatorUBI, error) {
+	var validatorUbi ValidatorUBI
+	err := i.UnmarshalMessage(&validatorUbi)
+	return &validatorUbi, err
+}
+
+type ValidatorUBIIndexKey interface {
+	id() uint32
+	values() []interface{}
+	validatorUbiindexKey()
+}
+
+// primary key starting index..
+type ValidatorUBIPrimaryKey = ValidatorUBIValidatorPubkeyIndexKey
+
+type ValidatorUBIValidatorPubkeyIndexKey struct {
+	vs []interface{}
+}
+
+func (x ValidatorUBIValidatorPubkeyIndexKey) id() uint32            { return 0 }
+func (x ValidatorUBIValidatorPubkeyIndexKey) values() []interface{} { return x.vs }
+func (x ValidatorUBIValidatorPubkeyIndexKey) validatorUbiindexKey() {}
+
+func (this ValidatorUBIValidatorPubkeyIndexKey) WithValidatorPubkey(validator_pubkey []byte) ValidatorUBIValidatorPubkeyIndexKey {
+	this.vs = []interface{}{validator_pubkey}
+	return this
+}
+
+type validatorUbitable struct {
+	table ormtable.Table
+}
+
+func (this validatorUbitable) Insert(ctx context.Context, validatorUbi *ValidatorUBI) error {
+	return this.table.Insert(ctx, validatorUbi)
+}
+
+func (this validatorUbitable) Update(ctx context.Context, validatorUbi *ValidatorUBI) error {
+	return this.table.Update(ctx, validatorUbi)
+}
+
+func (this validatorUbitable) Save(ctx context.Context, validatorUbi *ValidatorUBI) error {
+	return this.table.Save(ctx, validatorUbi)
+}
+
+func (this validatorUbitable) Delete(ctx context.Context, validatorUbi *ValidatorUBI) error {
+	return this.table.Delete(ctx, validatorUbi)
+}
+
+func (this validatorUbitable) Has(ctx context.Context, validator_pubkey []byte) (found bool, err error) {
+	return this.table.PrimaryKey().Has(ctx, validator_pubkey)
+}
+
+func (this validatorUbitable) Get(ctx context.Context, validator_pubkey []byte) (*ValidatorUBI, error) {
+	var validatorUbi ValidatorUBI
+	found, err := this.table.PrimaryKey().Get(ctx, &validatorUbi, validator_pubkey)
+	if err != nil {
+		return nil, err
+	}
+	if !found {
+		return nil, ormerrors.NotFound
+	}
+	return &validatorUbi, nil
+}
+
+func (this validatorUbitable) List(ctx context.Context, prefixKey ValidatorUBIIndexKey, opts ...ormlist.Option) (ValidatorUBIIterator, error) {
+	it, err := this.table.GetIndexByID(prefixKey.id()).List(ctx, prefixKey.values(), opts...)
+	return ValidatorUBIIterator{it}, err
+}
+
+func (this validatorUbitable) ListRange(ctx context.Context, from, to ValidatorUBIIndexKey, opts ...ormlist.Option) (ValidatorUBIIterator, error) {
+	it, err := this.table.GetIndexByID(from.id()).ListRange(ctx, from.values(), to.values(), opts...)
+	return ValidatorUBIIterator{it}, err
+}
+
+func (this validatorUbitable) DeleteBy(ctx context.Context, prefixKey ValidatorUBIIndexKey) error {
+	return this.table.GetIndexByID(prefixKey.id()).DeleteBy(ctx, prefixKey.values()...)
+}
+
+func (this validatorUbitable) DeleteRange(ctx context.Context, from, to ValidatorUBIIndexKey) error {
+	return this.table.GetIndexByID(from.id()).DeleteRange(ctx, from.values(), to.values())
+}
+
+func (this validatorUbitable) doNotImplement() {}
+
+var _ ValidatorUBITable = validatorUbitable{}
+
+func NewValidatorUBITable(db ormtable.Schema) (ValidatorUBITable, error) {
+	table := db.GetTable(&ValidatorUBI{})
+	if table == nil {
+		return nil, ormerrors.TableNotFound.Wrap(string((&ValidatorUBI{}).ProtoReflect().Descriptor().FullName()))
+	}
+	return validatorUbitable{table}, nil
+}
+
 type EvmengineStore interface {
 	ExecutionHeadTable() ExecutionHeadTable
+	ExecutionHeadHistoryTable() ExecutionHeadHistoryTable
+	ValidatorUBITable() ValidatorUBITable
 
 	doNotImplement()
 }
 
 type evmengineStore struct {
-	executionHead ExecutionHeadTable
+	executionHead        ExecutionHeadTable
+	executionHeadHistory ExecutionHeadHistoryTable
+	validatorUbi         ValidatorUBITable
 }
 
 func (x evmengineStore) ExecutionHeadTable() ExecutionHeadTable {
 	return x.executionHead
 }
 
+func (x evmengineStore) ExecutionHeadHistoryTable() ExecutionHeadHistoryTable {
+	return x.executionHeadHistory
+}
+
+func (x evmengineStore) ValidatorUBITable() ValidatorUBITable {
+	return x.validatorUbi
+}
+
 func (evmengineStore) doNotImplement() {}
 
 var _ EvmengineStore = evmengineStore{}
@@ -157,7 +397,19 @@ func NewEvmengineStore(db ormtable.Schema) (EvmengineStore, error) {
 		return nil, err
 	}
 
+	executionHeadHistoryTable, err := NewExecutionHeadHistoryTable(db)
+	if err != nil {
+		return nil, err
+	}
+
+	validatorUbitable, err := NewValidatorUBITable(db)
+	if err != nil {
+		return nil, err
+	}
+
 	return evmengineStore{
 		executionHeadTable,
+		executionHeadHistoryTable,
+		validatorUbitable,
 	}, nil
 }
@@ -1,30 +1,96 @@
 package keeper
 
 import (
+	"cmp"
 	"context"
+	"encoding/binary"
+	"slices"
 
+	"github.com/cosmos/gogoproto/proto"
 	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/accounts/abi"
 	"github.com/ethereum/go-ethereum/common"
 	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
 
-	"github.com/piplabs/story/client/genutil/evm/predeploys"
 	"github.com/piplabs/story/client/x/evmengine/types"
 	"github.com/piplabs/story/lib/errors"
 	clog "github.com/piplabs/story/lib/log"
 )
 
+// evmEventsCacheSize is the number of execution blocks whose EVM log events are cached.
+const evmEventsCacheSize = 16
+
+// Names and delivery orders of the evmengine contract EVM event processors, see types.EvmEventProcessor.
+const (
+	upgradeEventProcessor      = "upgrade"
+	upgradeEventProcessorOrder = 20
+	ubiEventProcessor          = "ubi"
+	ubiEventProcessorOrder     = 30
+)
+
+// evmEventsEntry is the cached EVM log events of an execution block, with their hash.
+type evmEventsEntry struct {
+	events []*types.EVMEvent
+	hash   common.Hash // See evmEventsHash.
+	opts   evmEventsOpts
+}
+
+// evmEventsOpts are the options of the selected EVM log events, enabled by the params.
+type evmEventsOpts struct {
+	positions bool // Include the positions of the events, see Params.EvmEventPositions.
+	knownOnly bool // Only select the events of the event processors, see Params.VerifyEventLogs.
+}
+
 // evmEvents returns selected EVM log events from the provided block hash.
+// The returned events are a copy of the cached events, so callers can't modify the cache.
 func (k *Keeper) evmEvents(ctx context.Context, blockHash common.Hash) ([]*types.EVMEvent, error) {
+	entry, err := k.cachedEVMEvents(ctx, blockHash)
+	if err != nil {
+		return nil, err
+	}
+
+	events := make([]*types.EVMEvent, 0, len(entry.events))
+	for _, event := range entry.events {
+		events = append(events, proto.Clone(event).(*types.EVMEvent)) //nolint:forcetypeassert // Type known.
+	}
+
+	return events, nil
+}
+
+// cachedEVMEvents returns the selected EVM log events from the provided block hash with their hash.
+// The events are cached by block hash, since the logs of a block are immutable.
+// The returned entry is shared, it must not be modified.
+//
+// The block hash, tx hash and log index of the events are only included once enabled by the
+// EvmEventPositions param, and the events are only filtered by their IDs once enabled by the
+// VerifyEventLogs param, since both change the events of proposals.
+func (k *Keeper) cachedEVMEvents(ctx context.Context, blockHash common.Hash) (evmEventsEntry, error) {
+	params, err := k.GetParams(ctx)
+	if err != nil {
+		return evmEventsEntry{}, errors.Wrap(err, "get params")
+	}
+	opts := evmEventsOpts{
+		positions: params.GetEvmEventPositions(),
+		knownOnly: params.GetVerifyEventLogs(),
+	}
+
+	if entry, ok := k.eventsCache.Get(blockHash); ok && entry.opts == opts {
+		incEVMEventsCache(true)
+		return entry, nil
+	}
+	incEVMEventsCache(false)
+
 	var logs []ethtypes.Log
-	err := retryForever(ctx, func(ctx context.Context) (fetched bool, err error) {
-		logs, err = k.engineCl.FilterLogs(ctx, ethereum.FilterQuery{
+	err = retryForever(ctx, func(ctx context.Context) (fetched bool, err error) {
+		query := ethereum.FilterQuery{
 			BlockHash: &blockHash,
-			Addresses: []common.Address{
-				common.HexToAddress(predeploys.IPTokenStaking),
-				common.HexToAddress(predeploys.UBIPool),
-				common.HexToAddress(predeploys.UpgradeEntrypoint),
-			},
-		})
+			Addresses: k.eventAddresses(),
+		}
+		if opts.knownOnly {
+			query.Topics = [][]common.Hash{k.eventIDs()}
+		}
+		logs, err = k.engineCl.FilterLogs(ctx, query)
 		if err != nil {
 			clog.Warn(ctx, "Failed fetching evm events (will retry)", err)
 
@@ -34,7 +100,7 @@ func (k *Keeper) evmEvents(ctx context.Context, blockHash common.Hash) ([]*types
 		return true, nil
 	})
 	if err != nil {
-		return nil, errors.Wrap(err, "filter logs")
+		return evmEventsEntry{}, errors.Wrap(err, "filter logs")
 	}
 
 	events := make([]*types.EVMEvent, 0, len(logs))
@@ -43,18 +109,227 @@ func (k *Keeper) evmEvents(ctx context.Context, blockHash common.Hash) ([]*types
 		for _, t := range l.Topics {
 			topics = append(topics, t.Bytes())
 		}
-		events = append(events, &types.EVMEvent{
+		event := &types.EVMEvent{
 			Address: l.Address.Bytes(),
 			Topics:  topics,
 			Data:    l.Data,
-		})
+		}
+		if opts.positions {
+			event.BlockHash = l.BlockHash.Bytes()
+			event.TxHash = l.TxHash.Bytes()
+			event.LogIndex = uint64(l.Index)
+		}
+		events = append(events, event)
 	}
 
 	for _, event := range events {
 		if err := event.Verify(); err != nil {
-			return nil, errors.Wrap(err, "verify event")
+			return evmEventsEntry{}, errors.Wrap(err, "verify event")
 		}
 	}
 
-	return events, nil
+	hash, err := evmEventsHash(events)
+	if err != nil {
+		return evmEventsEntry{}, err
+	}
+
+	entry := evmEventsEntry{events: events, hash: hash, opts: opts}
+	k.eventsCache.Add(blockHash, entry)
+
+	return entry, nil
+}
+
+// evmEventsHash returns the hash of the EVM log events, committing to their order and all their fields.
+func evmEventsHash(events []*types.EVMEvent) (common.Hash, error) {
+	h := crypto.NewKeccakState()
+	for _, event := range events {
+		bz, err := proto.Marshal(event)
+		if err != nil {
+			return common.Hash{}, errors.Wrap(err, "marshal event")
+		}
+
+		// Length-prefix each event, so different event lists can't have the same preimage.
+		_, _ = h.Write(binary.BigEndian.AppendUint64(nil, uint64(len(bz))))
+		_, _ = h.Write(bz)
+	}
+
+	var hash common.Hash
+	_, _ = h.Read(hash[:])
+
+	return hash, nil
+}
+
+// AddEventProcessors registers the EVM event processors, keeping them in their delivery order.
+// Each processor must have a positive order of its own, and each contract address can only be
+// registered by a single processor.
+func (k *Keeper) AddEventProcessors(procs ...types.EvmEventProcessor) error {
+	for _, proc := range procs {
+		if proc.Order() == 0 {
+			return errors.New("event processor order missing", "name", proc.Name())
+		}
+
+		for _, existing := range k.eventProcs {
+			if existing.Name() == proc.Name() {
+				return errors.New("duplicate event processor", "name", proc.Name())
+			} else if existing.Order() == proc.Order() {
+				return errors.New("duplicate event processor order",
+					"name", proc.Name(), "order", proc.Order(), "registered_by", existing.Name())
+			}
+		}
+
+		for _, addr := range proc.Addresses() {
+			if existing, ok := k.eventProcessor(addr); ok {
+				return errors.New("event processor address already registered",
+					"name", proc.Name(), "address", addr, "registered_by", existing.Name())
+			}
+		}
+
+		k.eventProcs = append(k.eventProcs, proc)
+	}
+
+	slices.SortFunc(k.eventProcs, func(a, b types.EvmEventProcessor) int {
+		return cmp.Compare(a.Order(), b.Order())
+	})
+
+	return nil
+}
+
+// eventAddresses returns the contract addresses of all registered EVM event processors.
+func (k *Keeper) eventAddresses() []common.Address {
+	var addrs []common.Address
+	for _, proc := range k.eventProcs {
+		addrs = append(addrs, proc.Addresses()...)
+	}
+
+	return addrs
+}
+
+// eventIDs returns the IDs of the EVM log events of all registered EVM event processors,
+// i.e., the first topic of their logs.
+func (k *Keeper) eventIDs() []common.Hash {
+	var ids []common.Hash
+	for _, proc := range k.eventProcs {
+		for _, event := range proc.Events() {
+			ids = append(ids, event.ID)
+		}
+	}
+
+	return ids
+}
+
+// eventProcessor returns the EVM event processor registered for the contract address.
+func (k *Keeper) eventProcessor(addr common.Address) (types.EvmEventProcessor, bool) {
+	for _, proc := range k.eventProcs {
+		for _, procAddr := range proc.Addresses() {
+			if procAddr == addr {
+				return proc, true
+			}
+		}
+	}
+
+	return nil, false
+}
+
+// verifyEvents returns an error if any EVM log event isn't an event of the processor of its address,
+// or can't be decoded as such. It ensures that delivering the events to their processors succeeds.
+func (k *Keeper) verifyEvents(events []*types.EVMEvent) error {
+	for i, event := range events {
+		if err := event.Verify(); err != nil {
+			return errors.Wrap(err, "verify event", "index", i)
+		}
+
+		if _, err := k.knownEventProcessor(event); err != nil {
+			return errors.Wrap(err, "unknown event", "index", i)
+		}
+	}
+
+	return nil
+}
+
+// knownEventProcessor returns the EVM event processor of the address of the EVM log event,
+// or an error if the event isn't an event of the processor or can't be decoded as such.
+func (k *Keeper) knownEventProcessor(event *types.EVMEvent) (types.EvmEventProcessor, error) {
+	addr := common.BytesToAddress(event.Address)
+	proc, ok := k.eventProcessor(addr)
+	if !ok {
+		return nil, errors.New("no event processor for address", "address", addr)
+	}
+
+	id := common.BytesToHash(event.Topics[0])
+	idx := slices.IndexFunc(proc.Events(), func(e abi.Event) bool { return e.ID == id })
+	if idx < 0 {
+		return nil, errors.New("unknown event id", "processor", proc.Name(), "id", id)
+	}
+
+	if err := event.VerifyDecodable(proc.Events()[idx]); err != nil {
+		return nil, errors.Wrap(err, "undecodable event", "processor", proc.Name())
+	}
+
+	return proc, nil
+}
+
+// deliverEvents delivers the EVM log events emitted in the execution block of the provided height
+// to the processors of their addresses. Each processor only receives the events of its own addresses.
+// Events that aren't known and decodable by their processor fail the delivery, unless the VerifyEventLogs
+// param isn't enabled yet. Blocks from before include them, e.g., the proxy and ownership logs of the contracts.
+func (k *Keeper) deliverEvents(ctx context.Context, height uint64, events []*types.EVMEvent) error {
+	params, err := k.GetParams(ctx)
+	if err != nil {
+		return errors.Wrap(err, "get params")
+	}
+
+	procEvents := make(map[string][]*types.EVMEvent)
+	for i, event := range events {
+		if err := event.Verify(); err != nil {
+			return errors.Wrap(err, "verify event", "index", i)
+		}
+
+		proc, err := k.knownEventProcessor(event)
+		if err != nil && params.GetVerifyEventLogs() {
+			return errors.Wrap(err, "unknown event", "index", i)
+		} else if err != nil {
+			clog.Warn(ctx, "Skipping unknown evm event", err, "height", height, "index", i)
+			continue
+		}
+		procEvents[proc.Name()] = append(procEvents[proc.Name()], event)
+	}
+
+	for _, proc := range k.eventProcs {
+		if err := proc.Deliver(ctx, height, procEvents[proc.Name()]); err != nil {
+			return errors.Wrap(err, "deliver event logs", "processor", proc.Name())
+		}
+	}
+
+	return nil
+}
+
+// keeperEventProcessor is an EVM event processor of a evmengine keeper contract.
+type keeperEventProcessor struct {
+	name      string
+	order     uint32
+	addresses []common.Address
+	events    []abi.Event
+	deliver   func(ctx context.Context, height uint64, events []*types.EVMEvent) error
+}
+
+var _ types.EvmEventProcessor = keeperEventProcessor{}
+
+func (p keeperEventProcessor) Name() string {
+	return p.name
+}
+
+func (p keeperEventProcessor) Order() uint32 {
+	return p.order
+}
+
+func (p keeperEventProcessor) Addresses() []common.Address {
+	return p.addresses
+}
+
+func (p keeperEventProcessor) Events() []abi.Event {
+	return p.events
+}
+
+func (p keeperEventProcessor) Deliver(ctx context.Context, height uint64, events []*types.EVMEvent) error {
+	return p.deliver(ctx, height, events)
 }
@@ -0,0 +1,451 @@
+package keeper
+
+import (
+	"context"
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/beacon/engine"
+	"github.com/ethereum/go-ethereum/common"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/piplabs/story/client/genutil/evm/predeploys"
+	"github.com/piplabs/story/client/x/evmengine/types"
+	"github.com/piplabs/story/contracts/bindings"
+	"github.com/piplabs/story/lib/ethclient"
+	"github.com/piplabs/story/lib/tutil"
+)
+
+func TestKeeper_AddEventProcessors(t *testing.T) {
+	t.Parallel()
+	_, keeper := createTestKeeper(t)
+
+	// The evmengine contract processors are registered by default.
+	require.ElementsMatch(t, []common.Address{
+		common.HexToAddress(predeploys.UpgradeEntrypoint),
+		common.HexToAddress(predeploys.UBIPool),
+	}, keeper.eventAddresses())
+
+	require.NoError(t, keeper.AddEventProcessors(mockLogProvider{}))
+	require.Contains(t, keeper.eventAddresses(), zeroAddr)
+
+	err := keeper.AddEventProcessors(mockLogProvider{})
+	require.ErrorContains(t, err, "duplicate event processor")
+
+	err = keeper.AddEventProcessors(keeperEventProcessor{
+		name:      "other",
+		order:     200,
+		addresses: []common.Address{common.HexToAddress(predeploys.UBIPool)},
+	})
+	require.ErrorContains(t, err, "event processor address already registered")
+
+	err = keeper.AddEventProcessors(keeperEventProcessor{
+		name:      "other",
+		addresses: []common.Address{common.BytesToAddress(tutil.RandomBytes(20))},
+	})
+	require.ErrorContains(t, err, "event processor order missing")
+
+	err = keeper.AddEventProcessors(keeperEventProcessor{
+		name:      "other",
+		order:     ubiEventProcessorOrder,
+		addresses: []common.Address{common.BytesToAddress(tutil.RandomBytes(20))},
+	})
+	require.ErrorContains(t, err, "duplicate event processor order")
+}
+
+func TestKeeper_eventProcessorOrder(t *testing.T) {
+	t.Parallel()
+	ctx, keeper := createTestKeeper(t)
+
+	var delivered []string
+	newProc := func(name string, order uint32) keeperEventProcessor {
+		return keeperEventProcessor{
+			name:      name,
+			order:     order,
+			addresses: []common.Address{common.BytesToAddress(tutil.RandomBytes(20))},
+			deliver: func(context.Context, uint64, []*types.EVMEvent) error {
+				delivered = append(delivered, name)
+				return nil
+			},
+		}
+	}
+
+	// Processors injected after the evmengine processors are registered by the constructor
+	// are still delivered in their declared order.
+	require.NoError(t, keeper.AddEventProcessors(newProc("last", 100)))
+	require.NoError(t, keeper.AddEventProcessors(newProc("first", 1)))
+
+	var names []string
+	for _, proc := range keeper.eventProcs {
+		names = append(names, proc.Name())
+	}
+	require.Equal(t, []string{"first", upgradeEventProcessor, ubiEventProcessor, "last"}, names)
+
+	// Replace the evmengine processors to record the delivery order.
+	keeper.eventProcs = nil
+	require.NoError(t, keeper.AddEventProcessors(newProc("c", 3), newProc("b", 2), newProc("d", 4), newProc("a", 1)))
+	require.NoError(t, keeper.deliverEvents(ctx, 1, nil))
+	require.Equal(t, []string{"a", "b", "c", "d"}, delivered)
+}
+
+func TestKeeper_deliverEvents(t *testing.T) {
+	t.Parallel()
+	ctx, keeper := createTestKeeper(t)
+
+	addr1, addr2 := common.BytesToAddress(tutil.RandomBytes(20)), common.BytesToAddress(tutil.RandomBytes(20))
+	delivered := make(map[string][]*types.EVMEvent)
+	newProc := func(name string, order uint32, addr common.Address) keeperEventProcessor {
+		return keeperEventProcessor{
+			name:      name,
+			order:     order,
+			addresses: []common.Address{addr},
+			events:    []abi.Event{mockEvent},
+			deliver: func(_ context.Context, height uint64, events []*types.EVMEvent) error {
+				require.Equal(t, uint64(1), height)
+				delivered[name] = events
+
+				return nil
+			},
+		}
+	}
+	require.NoError(t, keeper.AddEventProcessors(newProc("proc1", 101, addr1), newProc("proc2", 102, addr2)))
+
+	newEvent := func(addr common.Address) *types.EVMEvent {
+		return &types.EVMEvent{
+			Address: addr.Bytes(),
+			Topics:  [][]byte{mockEvent.ID.Bytes()},
+			Data:    tutil.RandomHash().Bytes(),
+		}
+	}
+	events := []*types.EVMEvent{newEvent(addr1), newEvent(addr2), newEvent(addr1)}
+
+	// Each processor only receives the events of its own address, in order.
+	require.NoError(t, keeper.deliverEvents(ctx, 1, events))
+	require.Equal(t, []*types.EVMEvent{events[0], events[2]}, delivered["proc1"])
+	require.Equal(t, []*types.EVMEvent{events[1]}, delivered["proc2"])
+
+	// Events that aren't known and decodable by a processor are skipped before VerifyEventLogs is enabled,
+	// since old blocks include them.
+	unknownID := newEvent(addr1)
+	unknownID.Topics = [][]byte{tutil.RandomHash().Bytes()}
+	undecodable := newEvent(addr2)
+	undecodable.Data = nil
+	events = []*types.EVMEvent{newEvent(common.BytesToAddress(tutil.RandomBytes(20))), unknownID, undecodable, newEvent(addr2)}
+	require.NoError(t, keeper.deliverEvents(ctx, 1, events))
+	require.Empty(t, delivered["proc1"])
+	require.Equal(t, []*types.EVMEvent{events[3]}, delivered["proc2"])
+
+	// They fail the delivery once it is enabled.
+	params, err := keeper.GetParams(ctx)
+	require.NoError(t, err)
+	params.VerifyEventLogs = true
+	require.NoError(t, keeper.SetParams(ctx, params))
+	for _, event := range events[:3] {
+		err := keeper.deliverEvents(ctx, 1, []*types.EVMEvent{event})
+		require.ErrorContains(t, err, "unknown event")
+	}
+
+	// Malformed events always fail the delivery.
+	err = keeper.deliverEvents(ctx, 1, []*types.EVMEvent{{Address: addr1.Bytes()}})
+	require.ErrorContains(t, err, "verify event")
+}
+
+// eventCase is an event type of the evmengine contracts, with valid data.
+type eventCase struct {
+	name  string
+	addr  common.Address
+	event abi.Event
+	data  []byte
+}
+
+// eventCases returns one case per event type of the evmengine contracts.
+func eventCases(t *testing.T) []eventCase {
+	t.Helper()
+
+	upgradeAbi, err := bindings.UpgradeEntrypointMetaData.GetAbi()
+	require.NoError(t, err)
+	ubiAbi, err := bindings.UBIPoolMetaData.GetAbi()
+	require.NoError(t, err)
+	upgradeAddr, ubiAddr := common.HexToAddress(predeploys.UpgradeEntrypoint), common.HexToAddress(predeploys.UBIPool)
+
+	pack := func(event abi.Event, args ...any) []byte {
+		data, err := event.Inputs.NonIndexed().Pack(args...)
+		require.NoError(t, err)
+
+		return data
+	}
+
+	return []eventCase{
+		{
+			name:  "SoftwareUpgrade",
+			addr:  upgradeAddr,
+			event: types.SoftwareUpgradeEvent,
+			data:  pack(upgradeAbi.Events["SoftwareUpgrade"], "test-upgrade", int64(1), "test-info"),
+		},
+		{
+			name:  "CancelUpgrade",
+			addr:  upgradeAddr,
+			event: types.CancelUpgradeEvent,
+		},
+		{
+			name:  "UBIPercentageSet",
+			addr:  ubiAddr,
+			event: types.UBIPercentageSetEvent,
+			data:  pack(ubiAbi.Events["UBIPercentageSet"], uint32(10)),
+		},
+		{
+			name:  "UBIDistributionSet",
+			addr:  ubiAddr,
+			event: types.UBIDistributionSetEvent,
+			data:  pack(ubiAbi.Events["UBIDistributionSet"], big.NewInt(1), big.NewInt(100), [][]byte{{0x04}}, []*big.Int{big.NewInt(100)}),
+		},
+		{
+			name:  "UBIClaimed",
+			addr:  ubiAddr,
+			event: types.UBIClaimedEvent,
+			data:  pack(ubiAbi.Events["UBIClaimed"], big.NewInt(1), []byte{0x04}, common.HexToAddress("0x1234"), big.NewInt(100)),
+		},
+	}
+}
+
+// otherContract returns the address of the evmengine contract other than the provided one.
+func otherContract(addr common.Address) common.Address {
+	if addr == common.HexToAddress(predeploys.UpgradeEntrypoint) {
+		return common.HexToAddress(predeploys.UBIPool)
+	}
+
+	return common.HexToAddress(predeploys.UpgradeEntrypoint)
+}
+
+func TestKeeper_verifyEvents(t *testing.T) {
+	t.Parallel()
+	_, keeper := createTestKeeper(t)
+
+	tcs := eventCases(t)
+	for _, tc := range tcs {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			newEvent := func(topics [][]byte, data []byte) []*types.EVMEvent {
+				return []*types.EVMEvent{{Address: tc.addr.Bytes(), Topics: topics, Data: data}}
+			}
+			id := tc.event.ID.Bytes()
+
+			require.NoError(t, keeper.verifyEvents(newEvent([][]byte{id}, tc.data)))
+
+			err := keeper.verifyEvents(newEvent([][]byte{id, tutil.RandomHash().Bytes()}, tc.data))
+			require.ErrorContains(t, err, "invalid indexed topics count")
+
+			if len(tc.data) > 0 {
+				err = keeper.verifyEvents(newEvent([][]byte{id}, nil))
+				require.ErrorContains(t, err, "decode data")
+			}
+
+			err = keeper.verifyEvents(newEvent([][]byte{tutil.RandomHash().Bytes()}, tc.data))
+			require.ErrorContains(t, err, "unknown event id")
+
+			// The event is unknown to the processor of the other contract.
+			err = keeper.verifyEvents([]*types.EVMEvent{{Address: otherContract(tc.addr).Bytes(), Topics: [][]byte{id}, Data: tc.data}})
+			require.ErrorContains(t, err, "unknown event")
+		})
+	}
+}
+
+func TestKeeper_deliverEventsVerified(t *testing.T) {
+	t.Parallel()
+
+	// Finalizing a block fails if it includes an event its processor can't decode.
+	for _, tc := range eventCases(t) {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			ctx, keeper := createTestKeeper(t)
+			params, err := keeper.GetParams(ctx)
+			require.NoError(t, err)
+			params.VerifyEventLogs = true
+			require.NoError(t, keeper.SetParams(ctx, params))
+
+			id := tc.event.ID.Bytes()
+
+			err = keeper.deliverEvents(ctx, 1, []*types.EVMEvent{{Address: tc.addr.Bytes(), Topics: [][]byte{id, tutil.RandomHash().Bytes()}, Data: tc.data}})
+			require.ErrorContains(t, err, "invalid indexed topics count")
+
+			if len(tc.data) > 0 {
+				err = keeper.deliverEvents(ctx, 1, []*types.EVMEvent{{Address: tc.addr.Bytes(), Topics: [][]byte{id}}})
+				require.ErrorContains(t, err, "decode data")
+			}
+
+			err = keeper.deliverEvents(ctx, 1, []*types.EVMEvent{{Address: tc.addr.Bytes(), Topics: [][]byte{tutil.RandomHash().Bytes()}, Data: tc.data}})
+			require.ErrorContains(t, err, "unknown event id")
+
+			err = keeper.deliverEvents(ctx, 1, []*types.EVMEvent{{Address: otherContract(tc.addr).Bytes(), Topics: [][]byte{id}, Data: tc.data}})
+			require.ErrorContains(t, err, "unknown event")
+		})
+	}
+}
+
+// countingLogsEngine is an engine client that counts the FilterLogs calls.
+type countingLogsEngine struct {
+	ethclient.EngineClient
+	calls  int
+	topics [][]common.Hash
+}
+
+func (e *countingLogsEngine) FilterLogs(_ context.Context, q ethereum.FilterQuery) ([]ethtypes.Log, error) {
+	e.calls++
+	e.topics = q.Topics
+
+	return []ethtypes.Log{{
+		Address:   common.HexToAddress(predeploys.UBIPool),
+		Topics:    []common.Hash{*q.BlockHash},
+		BlockHash: *q.BlockHash,
+		TxHash:    common.HexToHash("0x01"),
+		Index:     2,
+	}}, nil
+}
+
+func TestKeeper_evmEventsCache(t *testing.T) {
+	t.Parallel()
+	ctx, keeper := createTestKeeper(t)
+	engineCl := &countingLogsEngine{EngineClient: keeper.engineCl}
+	keeper.engineCl = engineCl
+	populateGenesisHead(ctx, t, keeper)
+
+	head, err := keeper.getExecutionHead(ctx)
+	require.NoError(t, err)
+	other := tutil.RandomHash()
+
+	// The logs are only filtered by the addresses of the registered processors before enabled by the params.
+	_, err = keeper.evmEvents(ctx, other)
+	require.NoError(t, err)
+	require.Empty(t, engineCl.topics)
+
+	params, err := keeper.GetParams(ctx)
+	require.NoError(t, err)
+	params.VerifyEventLogs = true
+	require.NoError(t, keeper.SetParams(ctx, params))
+	engineCl.calls = 0
+
+	// The events are only fetched once per block hash.
+	events, err := keeper.evmEvents(ctx, head.Hash())
+	require.NoError(t, err)
+	require.Len(t, events, 1)
+
+	// The logs are filtered by the events of the registered processors.
+	require.Len(t, engineCl.topics, 1)
+	require.ElementsMatch(t, []common.Hash{
+		types.SoftwareUpgradeEvent.ID, types.CancelUpgradeEvent.ID,
+		types.UBIPercentageSetEvent.ID, types.UBIDistributionSetEvent.ID, types.UBIClaimedEvent.ID,
+	}, engineCl.topics[0])
+
+	cached, err := keeper.evmEvents(ctx, head.Hash())
+	require.NoError(t, err)
+	require.Equal(t, events, cached)
+
+	// The cached events can't be modified by callers.
+	cached[0].Data = tutil.RandomBytes(32)
+	entry, err := keeper.cachedEVMEvents(ctx, head.Hash())
+	require.NoError(t, err)
+	require.Equal(t, events, entry.events)
+	hash, err := evmEventsHash(events)
+	require.NoError(t, err)
+	require.Equal(t, hash, entry.hash)
+	_, err = keeper.evmEvents(ctx, other)
+	require.NoError(t, err)
+	require.Equal(t, 2, engineCl.calls)
+
+	// The events of the parent of a finalized payload are removed, the others are kept.
+	next := tutil.RandomHash()
+	require.NoError(t, keeper.updateExecutionHead(ctx, engine.ExecutableData{
+		Number:     head.GetBlockHeight() + 1,
+		ParentHash: head.Hash(),
+		BlockHash:  next,
+	}))
+	require.False(t, keeper.eventsCache.Contains(head.Hash()))
+	require.True(t, keeper.eventsCache.Contains(other))
+
+	// All events are removed if a payload isn't built on top of the execution head.
+	_, err = keeper.evmEvents(ctx, next)
+	require.NoError(t, err)
+	require.NoError(t, keeper.updateExecutionHead(ctx, engine.ExecutableData{
+		Number:     head.GetBlockHeight() + 1,
+		ParentHash: head.Hash(),
+		BlockHash:  tutil.RandomHash(),
+	}))
+	require.Zero(t, keeper.eventsCache.Len())
+	require.Equal(t, 3, engineCl.calls)
+}
+
+func TestKeeper_evmEventsPositions(t *testing.T) {
+	t.Parallel()
+	ctx, keeper := createTestKeeper(t)
+	engineCl := &countingLogsEngine{EngineClient: keeper.engineCl}
+	keeper.engineCl = engineCl
+	populateGenesisHead(ctx, t, keeper)
+
+	head, err := keeper.getExecutionHead(ctx)
+	require.NoError(t, err)
+
+	// The events don't include their positions before enabled by the params.
+	events, err := keeper.evmEvents(ctx, head.Hash())
+	require.NoError(t, err)
+	require.Len(t, events, 1)
+	require.Empty(t, events[0].GetBlockHash())
+	require.Empty(t, events[0].GetTxHash())
+	require.Zero(t, events[0].GetLogIndex())
+
+	// Cached events without positions aren't used once enabled.
+	params, err := keeper.GetParams(ctx)
+	require.NoError(t, err)
+	params.EvmEventPositions = true
+	require.NoError(t, keeper.SetParams(ctx, params))
+
+	events, err = keeper.evmEvents(ctx, head.Hash())
+	require.NoError(t, err)
+	require.Len(t, events, 1)
+	require.Equal(t, head.GetBlockHash(), events[0].GetBlockHash())
+	require.Equal(t, common.HexToHash("0x01").Bytes(), events[0].GetTxHash())
+	require.Equal(t, uint64(2), events[0].GetLogIndex())
+	require.Equal(t, 2, engineCl.calls)
+}
+
+func Test_evmEventsEqual(t *testing.T) {
+	t.Parallel()
+
+	newEvent := func() *types.EVMEvent {
+		return &types.EVMEvent{
+			Address: tutil.RandomBytes(20),
+			Topics:  [][]byte{tutil.RandomHash().Bytes()},
+			Data:    tutil.RandomBytes(32),
+		}
+	}
+	events := []*types.EVMEvent{newEvent(), newEvent()}
+	clone := func() []*types.EVMEvent {
+		var res []*types.EVMEvent
+		for _, event := range events {
+			res = append(res, &types.EVMEvent{Address: event.Address, Topics: event.Topics, Data: event.Data})
+		}
+
+		return res
+	}
+
+	entry := func(events []*types.EVMEvent) evmEventsEntry {
+		hash, err := evmEventsHash(events)
+		require.NoError(t, err)
+
+		return evmEventsEntry{events: events, hash: hash}
+	}
+	local := entry(events)
+
+	require.NoError(t, evmEventsEqual(local, clone()))
+	require.NoError(t, evmEventsEqual(entry(nil), []*types.EVMEvent{}))
+	require.ErrorContains(t, evmEventsEqual(local, events[:1]), "count mismatch")
+
+	reordered := []*types.EVMEvent{events[1], events[0]}
+	require.ErrorContains(t, evmEventsEqual(local, reordered), "log mismatch")
+
+	modified := clone()
+	modified[1].Data = tutil.RandomBytes(32)
+	require.ErrorContains(t, evmEventsEqual(local, modified), "log mismatch")
+}
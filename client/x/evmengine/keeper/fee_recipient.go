@@ -0,0 +1,51 @@
+package keeper
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/common"
+
+	"github.com/piplabs/story/lib/errors"
+	"github.com/piplabs/story/lib/k1util"
+)
+
+// feeRecipient returns the fee recipient of the execution blocks proposed by the validator with the given
+// execution address, derived from its consensus key. This is the fee recipient registered in x/evmstaking,
+// or the derived address itself if the validator didn't register one.
+func (k *Keeper) feeRecipient(ctx context.Context, validatorAddr common.Address) (common.Address, error) {
+	feeRecipient, ok, err := k.evmstakingKeeper.GetValidatorFeeRecipient(ctx, validatorAddr)
+	if err != nil {
+		return common.Address{}, errors.Wrap(err, "get validator fee recipient")
+	} else if !ok {
+		return validatorAddr, nil
+	}
+
+	return feeRecipient, nil
+}
+
+// verifyFeeRecipient returns an error if the fee recipient of a proposed payload
+// isn't the fee recipient of the proposer of the current block.
+func (k *Keeper) verifyFeeRecipient(ctx context.Context, actual common.Address) error {
+	proposer := sdk.ConsAddress(sdk.UnwrapSDKContext(ctx).BlockHeader().ProposerAddress)
+	pubkey, err := k.stakingKeeper.GetPubKeyByConsAddr(ctx, proposer)
+	if err != nil {
+		return errors.Wrap(err, "get proposer pubkey")
+	}
+
+	proposerAddr, err := k1util.PubKeyPBToAddress(pubkey)
+	if err != nil {
+		return errors.Wrap(err, "proposer pubkey to address")
+	}
+
+	expected, err := k.feeRecipient(ctx, proposerAddr)
+	if err != nil {
+		return err
+	}
+
+	if actual != expected {
+		return errors.New("invalid fee recipient", "proposed", actual, "expected", expected)
+	}
+
+	return nil
+}
@@ -0,0 +1,108 @@
+package keeper
+
+import (
+	"testing"
+
+	"github.com/cometbft/cometbft/crypto/secp256k1"
+	cmtproto "github.com/cometbft/cometbft/proto/tendermint/types"
+	authtx "github.com/cosmos/cosmos-sdk/x/auth/tx"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/require"
+
+	moduletestutil "github.com/piplabs/story/client/x/evmengine/testutil"
+	"github.com/piplabs/story/lib/errors"
+	"github.com/piplabs/story/lib/ethclient/mock"
+	"github.com/piplabs/story/lib/k1util"
+
+	"go.uber.org/mock/gomock"
+)
+
+func TestKeeper_verifyFeeRecipient(t *testing.T) {
+	t.Parallel()
+
+	proposerKey := secp256k1.GenPrivKey()
+	proposerPubKey, err := k1util.PBPubKeyFromBytes(proposerKey.PubKey().Bytes())
+	require.NoError(t, err)
+	proposerAddr, err := k1util.PubKeyToAddress(proposerKey.PubKey())
+	require.NoError(t, err)
+	registered := common.HexToAddress("0x1234")
+
+	tcs := []struct {
+		name        string
+		setup       func(esk *moduletestutil.MockEvmStakingKeeper, sk *moduletestutil.MockStakingKeeper)
+		actual      common.Address
+		expectedErr string
+	}{
+		{
+			name: "pass: derived address when none is registered",
+			setup: func(esk *moduletestutil.MockEvmStakingKeeper, sk *moduletestutil.MockStakingKeeper) {
+				sk.EXPECT().GetPubKeyByConsAddr(gomock.Any(), gomock.Any()).Return(proposerPubKey, nil)
+				esk.EXPECT().GetValidatorFeeRecipient(gomock.Any(), proposerAddr).Return(common.Address{}, false, nil)
+			},
+			actual: proposerAddr,
+		},
+		{
+			name: "pass: registered fee recipient",
+			setup: func(esk *moduletestutil.MockEvmStakingKeeper, sk *moduletestutil.MockStakingKeeper) {
+				sk.EXPECT().GetPubKeyByConsAddr(gomock.Any(), gomock.Any()).Return(proposerPubKey, nil)
+				esk.EXPECT().GetValidatorFeeRecipient(gomock.Any(), proposerAddr).Return(registered, true, nil)
+			},
+			actual: registered,
+		},
+		{
+			name: "fail: derived address when one is registered",
+			setup: func(esk *moduletestutil.MockEvmStakingKeeper, sk *moduletestutil.MockStakingKeeper) {
+				sk.EXPECT().GetPubKeyByConsAddr(gomock.Any(), gomock.Any()).Return(proposerPubKey, nil)
+				esk.EXPECT().GetValidatorFeeRecipient(gomock.Any(), proposerAddr).Return(registered, true, nil)
+			},
+			actual:      proposerAddr,
+			expectedErr: "invalid fee recipient",
+		},
+		{
+			name: "fail: unknown proposer",
+			setup: func(_ *moduletestutil.MockEvmStakingKeeper, sk *moduletestutil.MockStakingKeeper) {
+				sk.EXPECT().GetPubKeyByConsAddr(gomock.Any(), gomock.Any()).Return(proposerPubKey, errors.New("not found"))
+			},
+			actual:      proposerAddr,
+			expectedErr: "get proposer pubkey",
+		},
+		{
+			name: "fail: get validator fee recipient",
+			setup: func(esk *moduletestutil.MockEvmStakingKeeper, sk *moduletestutil.MockStakingKeeper) {
+				sk.EXPECT().GetPubKeyByConsAddr(gomock.Any(), gomock.Any()).Return(proposerPubKey, nil)
+				esk.EXPECT().GetValidatorFeeRecipient(gomock.Any(), proposerAddr).Return(common.Address{}, false, errors.New("store error"))
+			},
+			actual:      proposerAddr,
+			expectedErr: "get validator fee recipient",
+		},
+	}
+
+	for _, tc := range tcs {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			cdc := getCodec(t)
+			txConfig := authtx.NewTxConfig(cdc, nil)
+
+			ctrl := gomock.NewController(t)
+			ak := moduletestutil.NewMockAccountKeeper(ctrl)
+			esk := moduletestutil.NewMockEvmStakingKeeper(ctrl)
+			uk := moduletestutil.NewMockUpgradeKeeper(ctrl)
+			dk := moduletestutil.NewMockDistrKeeper(ctrl)
+			sk := moduletestutil.NewMockStakingKeeper(ctrl)
+			tc.setup(esk, sk)
+
+			ctx, storeKey, storeService := setupCtxStore(t, &cmtproto.Header{ProposerAddress: proposerKey.PubKey().Address()})
+			mockEngine, err := newMockEngineAPI(storeKey, 0)
+			require.NoError(t, err)
+			keeper, err := NewKeeper(cdc, storeService, &mockEngine, mock.NewMockClient(ctrl), txConfig, ak, esk, uk, dk, sk, authority)
+			require.NoError(t, err)
+
+			err = keeper.verifyFeeRecipient(ctx, tc.actual)
+			if tc.expectedErr != "" {
+				require.ErrorContains(t, err, tc.expectedErr)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
@@ -3,7 +3,10 @@ package keeper
 import (
 	"context"
 
+	"cosmossdk.io/math"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/crypto"
 
 	"github.com/piplabs/story/client/x/evmengine/types"
 	"github.com/piplabs/story/lib/errors"
@@ -17,26 +20,196 @@ func (k *Keeper) InitGenesis(ctx context.Context, gs *types.GenesisState) error
 		return err
 	}
 
-	if err := k.InsertGenesisHead(ctx, gs.Params.ExecutionBlockHash); err != nil {
+	if err := k.insertGenesisHead(ctx, gs.ExecutionBlockNumber, gs.Params.ExecutionBlockHash); err != nil {
 		panic(errors.Wrap(err, "insert genesis head"))
 	}
 
+	// The history is imported after the genesis head, so a retained history at height zero replaces it.
+	for _, head := range gs.HeadHistory {
+		err := k.headHistoryTable.Save(ctx, &ExecutionHeadHistory{
+			CreatedHeight: head.GetCreatedHeight(),
+			BlockHeight:   head.GetBlockHeight(),
+			BlockHash:     head.GetBlockHash(),
+			BlockTime:     head.GetBlockTime(),
+		})
+		if err != nil {
+			return errors.Wrap(err, "save execution head history")
+		}
+	}
+
+	for _, ubi := range gs.ValidatorUbis {
+		err := k.ubiTable.Save(ctx, &ValidatorUBI{
+			ValidatorPubkey: ubi.GetValidatorPubkey(),
+			Accrued:         ubi.Accrued.String(),
+			Claimed:         ubi.Claimed.String(),
+		})
+		if err != nil {
+			return errors.Wrap(err, "save validator UBI")
+		}
+	}
+
+	if gs.RandaoMix != nil {
+		if err := k.setRandaoMix(ctx, gs.RandaoMix); err != nil {
+			return err
+		}
+	}
+	if gs.ExecutionAttestation != nil {
+		if err := k.saveExecutionAttestation(ctx, gs.ExecutionAttestation); err != nil {
+			return err
+		}
+	}
+
 	return nil
 }
 
 // ExportGenesis returns a GenesisState for a given context and keeper.
+// The execution block of the exported params is the current execution head,
+// so the restarted chain continues building on top of it.
 func (k *Keeper) ExportGenesis(ctx sdk.Context) *types.GenesisState {
 	params, err := k.GetParams(ctx)
 	if err != nil {
 		panic(err)
 	}
 
-	return &types.GenesisState{
-		Params: params,
+	headHistory, err := k.exportHeadHistory(ctx)
+	if err != nil {
+		panic(err)
+	}
+
+	validatorUBIs, err := k.exportValidatorUBIs(ctx)
+	if err != nil {
+		panic(err)
+	}
+
+	gs := &types.GenesisState{
+		Params:        params,
+		HeadHistory:   headHistory,
+		ValidatorUbis: validatorUBIs,
+	}
+
+	// The execution head only exists once the chain is initialized.
+	if head, err := k.getExecutionHead(ctx); err == nil {
+		gs.Params.ExecutionBlockHash = head.GetBlockHash()
+		gs.ExecutionBlockNumber = head.GetBlockHeight()
+	}
+
+	if mix, ok, err := k.loadRandaoMix(ctx); err != nil {
+		panic(err)
+	} else if ok {
+		gs.RandaoMix = mix
+	}
+
+	if attestation, ok, err := k.GetExecutionAttestation(ctx); err != nil {
+		panic(err)
+	} else if ok {
+		gs.ExecutionAttestation = attestation
+	}
+
+	return gs
+}
+
+// exportHeadHistory returns the retained execution head history, ordered by consensus chain height.
+func (k *Keeper) exportHeadHistory(ctx context.Context) ([]types.ExecutionHeadInfo, error) {
+	it, err := k.headHistoryTable.List(ctx, ExecutionHeadHistoryPrimaryKey{})
+	if err != nil {
+		return nil, errors.Wrap(err, "list execution head history")
+	}
+	defer it.Close()
+
+	var heads []types.ExecutionHeadInfo
+	for it.Next() {
+		history, err := it.Value()
+		if err != nil {
+			return nil, errors.Wrap(err, "get execution head history")
+		}
+		heads = append(heads, toExecutionHeadInfo(history))
+	}
+
+	return heads, nil
+}
+
+// exportValidatorUBIs returns the UBI of all validators, ordered by validator public key.
+func (k *Keeper) exportValidatorUBIs(ctx context.Context) ([]types.ValidatorUBIInfo, error) {
+	it, err := k.ubiTable.List(ctx, ValidatorUBIPrimaryKey{})
+	if err != nil {
+		return nil, errors.Wrap(err, "list validator UBI")
+	}
+	defer it.Close()
+
+	var ubis []types.ValidatorUBIInfo
+	for it.Next() {
+		ubi, err := it.Value()
+		if err != nil {
+			return nil, errors.Wrap(err, "get validator UBI")
+		}
+
+		accrued, ok := math.NewIntFromString(ubi.GetAccrued())
+		if !ok {
+			return nil, errors.New("invalid accrued UBI", "accrued", ubi.GetAccrued())
+		}
+		claimed, ok := math.NewIntFromString(ubi.GetClaimed())
+		if !ok {
+			return nil, errors.New("invalid claimed UBI", "claimed", ubi.GetClaimed())
+		}
+
+		ubis = append(ubis, types.ValidatorUBIInfo{
+			ValidatorPubkey: ubi.GetValidatorPubkey(),
+			Accrued:         accrued,
+			Claimed:         claimed,
+		})
+	}
+
+	return ubis, nil
+}
+
+// PrepForZeroHeightGenesis resets the state referencing consensus chain heights, so it can be
+// exported for a chain restarting at height zero. The execution head history is dropped since it
+// is keyed by height, and the randao mix and execution attestation are kept at height zero.
+func (k *Keeper) PrepForZeroHeightGenesis(ctx context.Context) error {
+	if err := k.headHistoryTable.DeleteBy(ctx, ExecutionHeadHistoryPrimaryKey{}); err != nil {
+		return errors.Wrap(err, "delete execution head history")
+	}
+
+	if mix, ok, err := k.loadRandaoMix(ctx); err != nil {
+		return err
+	} else if ok {
+		mix.Height = 0
+		if err := k.setRandaoMix(ctx, mix); err != nil {
+			return err
+		}
+	}
+
+	if attestation, ok, err := k.GetExecutionAttestation(ctx); err != nil {
+		return err
+	} else if ok {
+		attestation.Height = 0
+		if err := k.saveExecutionAttestation(ctx, attestation); err != nil {
+			return err
+		}
 	}
+
+	return nil
 }
 
-//nolint:revive // TODO: validate genesis
-func (k *Keeper) ValidateGenesis(gs *types.GenesisState) error {
-	return types.ValidateExecutionBlockHash(gs.Params.ExecutionBlockHash)
+// ValidateGenesis returns an error if the genesis state is invalid.
+func (*Keeper) ValidateGenesis(gs *types.GenesisState) error {
+	if err := types.ValidateExecutionBlockHash(gs.Params.ExecutionBlockHash); err != nil {
+		return err
+	}
+
+	for _, head := range gs.HeadHistory {
+		if err := types.ValidateExecutionBlockHash(head.GetBlockHash()); err != nil {
+			return errors.Wrap(err, "validate execution head history", "created_height", head.GetCreatedHeight())
+		}
+	}
+
+	for _, ubi := range gs.ValidatorUbis {
+		if _, err := crypto.DecompressPubkey(ubi.GetValidatorPubkey()); err != nil {
+			return errors.Wrap(err, "validate validator UBI pubkey")
+		} else if ubi.Accrued.IsNil() || ubi.Accrued.IsNegative() || ubi.Claimed.IsNil() || ubi.Claimed.IsNegative() {
+			return errors.New("invalid validator UBI amounts", "accrued", ubi.Accrued, "claimed", ubi.Claimed)
+		}
+	}
+
+	return nil
 }
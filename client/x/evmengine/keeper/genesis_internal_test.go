@@ -4,8 +4,12 @@ import (
 	"context"
 	"testing"
 
+	"cosmossdk.io/math"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/beacon/engine"
 	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
 	"github.com/stretchr/testify/require"
 
 	"github.com/piplabs/story/client/x/evmengine/types"
@@ -14,7 +18,7 @@ import (
 func TestKeeper_InitGenesis(t *testing.T) {
 	t.Parallel()
 	dummyExecutionHead := common.HexToHash("0x047e24c3455107d87c68dffa307b3b7fa1877f3e9d7f30c7ee359f2eff3a75d9")
-	validParams := types.NewParams(dummyExecutionHead.Bytes())
+	validParams := types.NewParams(dummyExecutionHead.Bytes(), 100, 0, 0, 0)
 
 	tcs := []struct {
 		name           string
@@ -92,7 +96,7 @@ func TestKeeper_InitGenesis(t *testing.T) {
 func TestKeeper_ExportGenesis(t *testing.T) {
 	t.Parallel()
 	dummyExecutionHead := common.HexToHash("0x047e24c3455107d87c68dffa307b3b7fa1877f3e9d7f30c7ee359f2eff3a75d9")
-	validParams := types.NewParams(dummyExecutionHead.Bytes())
+	validParams := types.NewParams(dummyExecutionHead.Bytes(), 100, 0, 0, 0)
 
 	tcs := []struct {
 		name           string
@@ -110,10 +114,33 @@ func TestKeeper_ExportGenesis(t *testing.T) {
 			},
 		},
 		{
-			name: "pass: default params",
+			name: "pass: no params",
 			postStateCheck: func(c context.Context, k *Keeper) {
 				gs := k.ExportGenesis(sdk.UnwrapSDKContext(c))
-				require.Equal(t, types.DefaultParams(), gs.Params)
+				require.Equal(t, types.Params{}, gs.Params)
+			},
+		},
+		{
+			name: "pass: execution head",
+			setup: func(c context.Context, k *Keeper) {
+				require.NoError(t, k.InitGenesis(c, &types.GenesisState{Params: validParams}))
+				require.NoError(t, k.updateExecutionHead(c, engine.ExecutableData{
+					Number:    10,
+					BlockHash: common.HexToHash("0x0a"),
+				}))
+			},
+			postStateCheck: func(c context.Context, k *Keeper) {
+				gs := k.ExportGenesis(sdk.UnwrapSDKContext(c))
+				require.Equal(t, common.HexToHash("0x0a").Bytes(), gs.Params.ExecutionBlockHash)
+				require.Equal(t, uint64(10), gs.ExecutionBlockNumber)
+
+				// The exported genesis restarts the chain on the execution head.
+				ctx, keeper := createTestKeeper(t)
+				require.NoError(t, keeper.InitGenesis(ctx, gs))
+				head, err := keeper.getExecutionHead(ctx)
+				require.NoError(t, err)
+				require.Equal(t, uint64(10), head.GetBlockHeight())
+				require.Equal(t, common.HexToHash("0x0a"), head.Hash())
 			},
 		},
 	}
@@ -129,3 +156,94 @@ func TestKeeper_ExportGenesis(t *testing.T) {
 		})
 	}
 }
+
+func TestKeeper_GenesisRoundTrip(t *testing.T) {
+	t.Parallel()
+	ctx, keeper := createTestKeeper(t)
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+
+	params := types.DefaultParams()
+	params.ExecutionBlockHash = common.HexToHash("0x01").Bytes()
+	require.NoError(t, keeper.InitGenesis(ctx, types.NewGenesisState(params)))
+
+	for height := int64(1); height <= 3; height++ {
+		require.NoError(t, keeper.updateExecutionHead(sdkCtx.WithBlockHeight(height), engine.ExecutableData{
+			Number:    uint64(height * 10),
+			BlockHash: common.BigToHash(math.NewInt(height).BigInt()),
+			Timestamp: uint64(height * 100),
+		}))
+	}
+
+	key, err := crypto.GenerateKey()
+	require.NoError(t, err)
+	cmpPubKey := crypto.CompressPubkey(&key.PublicKey)
+	require.NoError(t, keeper.addValidatorUBI(ctx, cmpPubKey, math.NewInt(300), math.NewInt(100)))
+
+	require.NoError(t, keeper.setRandaoMix(ctx, &types.RandaoMix{
+		Height:  3,
+		Mix:     common.HexToHash("0x03").Bytes(),
+		PrevMix: common.HexToHash("0x02").Bytes(),
+	}))
+	require.NoError(t, keeper.setExecutionAttestation(ctx, &types.ExecutionAttestation{
+		Height:        3,
+		BlockNumber:   30,
+		BlockHash:     common.BigToHash(math.NewInt(3).BigInt()).Bytes(),
+		AttestedPower: 2,
+		TotalPower:    3,
+	}))
+
+	gs := keeper.ExportGenesis(sdkCtx)
+	require.Len(t, gs.HeadHistory, 4) // Including the genesis head.
+	require.Equal(t, uint64(3), gs.HeadHistory[3].CreatedHeight)
+	require.Len(t, gs.ValidatorUbis, 1)
+	require.NotNil(t, gs.RandaoMix)
+	require.NotNil(t, gs.ExecutionAttestation)
+
+	// The genesis exported by the restarted chain is identical.
+	ctx2, keeper2 := createTestKeeper(t)
+	require.NoError(t, keeper2.InitGenesis(ctx2, gs))
+	require.Equal(t, gs, keeper2.ExportGenesis(sdk.UnwrapSDKContext(ctx2)))
+
+	accrued, claimed, err := keeper2.getValidatorUBI(ctx2, cmpPubKey)
+	require.NoError(t, err)
+	require.Equal(t, math.NewInt(300), accrued)
+	require.Equal(t, math.NewInt(100), claimed)
+
+	mix, err := keeper2.getRandaoMix(ctx2, 4)
+	require.NoError(t, err)
+	require.Equal(t, common.HexToHash("0x03"), mix)
+}
+
+func TestKeeper_PrepForZeroHeightGenesis(t *testing.T) {
+	t.Parallel()
+	ctx, keeper := createTestKeeper(t)
+
+	params := types.DefaultParams()
+	params.ExecutionBlockHash = common.HexToHash("0x01").Bytes()
+	require.NoError(t, keeper.InitGenesis(ctx, types.NewGenesisState(params)))
+	require.NoError(t, keeper.updateExecutionHead(ctx, engine.ExecutableData{
+		Number:    10,
+		BlockHash: common.HexToHash("0x0a"),
+	}))
+	require.NoError(t, keeper.setRandaoMix(ctx, &types.RandaoMix{
+		Height:  5,
+		Mix:     common.HexToHash("0x05").Bytes(),
+		PrevMix: common.HexToHash("0x04").Bytes(),
+	}))
+	require.NoError(t, keeper.setExecutionAttestation(ctx, &types.ExecutionAttestation{Height: 5, BlockNumber: 10}))
+
+	require.NoError(t, keeper.PrepForZeroHeightGenesis(ctx))
+
+	gs := keeper.ExportGenesis(sdk.UnwrapSDKContext(ctx))
+	require.Empty(t, gs.HeadHistory)
+	require.Equal(t, uint64(10), gs.ExecutionBlockNumber)
+	require.Equal(t, uint64(0), gs.RandaoMix.Height)
+	require.Equal(t, uint64(0), gs.ExecutionAttestation.Height)
+
+	// The restarted chain mixes into the latest randao mix from its first height.
+	ctx2, keeper2 := createTestKeeper(t)
+	require.NoError(t, keeper2.InitGenesis(ctx2, gs))
+	mix, err := keeper2.getRandaoMix(ctx2, 1)
+	require.NoError(t, err)
+	require.Equal(t, common.HexToHash("0x05"), mix)
+}
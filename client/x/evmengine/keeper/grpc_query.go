@@ -0,0 +1,172 @@
+package keeper
+
+import (
+	"context"
+	"encoding/hex"
+	"strings"
+
+	queryv1beta1 "cosmossdk.io/api/cosmos/base/query/v1beta1"
+	"cosmossdk.io/orm/model/ormlist"
+	"cosmossdk.io/orm/types/ormerrors"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"github.com/decred/dcrd/dcrec/secp256k1"
+
+	"github.com/piplabs/story/client/x/evmengine/types"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+var _ types.QueryServer = (*Keeper)(nil)
+
+// Params returns the parameters of the module.
+func (k *Keeper) Params(ctx context.Context, request *types.QueryParamsRequest) (*types.QueryParamsResponse, error) {
+	if request == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	params, err := k.GetParams(ctx)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryParamsResponse{Params: params}, nil
+}
+
+// ExecutionHead returns the execution block finalized at the requested consensus chain height,
+// or the latest execution head if the height is 0.
+func (k *Keeper) ExecutionHead(ctx context.Context, request *types.QueryExecutionHeadRequest) (*types.QueryExecutionHeadResponse, error) {
+	if request == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	if request.Height == 0 {
+		head, err := k.getExecutionHead(ctx)
+		if err != nil {
+			return nil, status.Error(codes.Internal, err.Error())
+		}
+
+		return &types.QueryExecutionHeadResponse{Head: types.ExecutionHeadInfo{
+			CreatedHeight: head.GetCreatedHeight(),
+			BlockHeight:   head.GetBlockHeight(),
+			BlockHash:     head.GetBlockHash(),
+			BlockTime:     head.GetBlockTime(),
+		}}, nil
+	}
+
+	history, err := k.getHeadHistory(ctx, request.Height)
+	if ormerrors.IsNotFound(err) {
+		return nil, status.Errorf(codes.NotFound, "no execution head at height %d", request.Height)
+	} else if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryExecutionHeadResponse{Head: toExecutionHeadInfo(history)}, nil
+}
+
+// ExecutionHeads returns the retained execution head history in pagination, ordered by consensus chain height.
+func (k *Keeper) ExecutionHeads(ctx context.Context, request *types.QueryExecutionHeadsRequest) (*types.QueryExecutionHeadsResponse, error) {
+	if request == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	var opts []ormlist.Option
+	if request.Pagination != nil {
+		opts = append(opts, ormlist.Paginate(&queryv1beta1.PageRequest{
+			Key:        request.Pagination.Key,
+			Offset:     request.Pagination.Offset,
+			Limit:      request.Pagination.Limit,
+			CountTotal: request.Pagination.CountTotal,
+			Reverse:    request.Pagination.Reverse,
+		}))
+	}
+
+	it, err := k.headHistoryTable.List(ctx, ExecutionHeadHistoryPrimaryKey{}, opts...)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	defer it.Close()
+
+	var heads []types.ExecutionHeadInfo
+	for it.Next() {
+		history, err := it.Value()
+		if err != nil {
+			return nil, status.Error(codes.Internal, err.Error())
+		}
+		heads = append(heads, toExecutionHeadInfo(history))
+	}
+
+	var pageResp *query.PageResponse
+	if resp := it.PageResponse(); resp != nil {
+		pageResp = &query.PageResponse{
+			NextKey: resp.NextKey,
+			Total:   resp.Total,
+		}
+	}
+
+	return &types.QueryExecutionHeadsResponse{Heads: heads, Pagination: pageResp}, nil
+}
+
+// UbiPercentage returns the current UBI percentage of the block rewards.
+func (k *Keeper) UbiPercentage(ctx context.Context, request *types.QueryUbiPercentageRequest) (*types.QueryUbiPercentageResponse, error) {
+	if request == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	ubi, err := k.distrKeeper.GetUbi(ctx)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryUbiPercentageResponse{Percentage: ubi}, nil
+}
+
+// UbiBalance returns the UBI accrued in the distribution module that isn't withdrawn to the UBIPool yet.
+func (k *Keeper) UbiBalance(ctx context.Context, request *types.QueryUbiBalanceRequest) (*types.QueryUbiBalanceResponse, error) {
+	if request == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	balance, err := k.distrKeeper.GetUbiBalanceByDenom(ctx, sdk.DefaultBondDenom)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryUbiBalanceResponse{Balance: balance}, nil
+}
+
+// ValidatorUbi returns the UBI distributed to and claimed by the validator on the UBIPool.
+func (k *Keeper) ValidatorUbi(ctx context.Context, request *types.QueryValidatorUbiRequest) (*types.QueryValidatorUbiResponse, error) {
+	if request == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	pubKey, err := hex.DecodeString(strings.TrimPrefix(request.ValidatorPubkey, "0x"))
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid validator pubkey hex")
+	}
+
+	if len(pubKey) != secp256k1.PubKeyBytesLenCompressed {
+		if pubKey, err = uncmpToCmpPubKey(pubKey); err != nil {
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		}
+	}
+
+	accrued, claimed, err := k.getValidatorUBI(ctx, pubKey)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryValidatorUbiResponse{Accrued: accrued, Claimed: claimed}, nil
+}
+
+func toExecutionHeadInfo(history *ExecutionHeadHistory) types.ExecutionHeadInfo {
+	return types.ExecutionHeadInfo{
+		CreatedHeight: history.GetCreatedHeight(),
+		BlockHeight:   history.GetBlockHeight(),
+		BlockHash:     history.GetBlockHash(),
+		BlockTime:     history.GetBlockTime(),
+	}
+}
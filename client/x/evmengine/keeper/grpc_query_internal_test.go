@@ -0,0 +1,125 @@
+package keeper
+
+import (
+	"testing"
+
+	"cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"github.com/ethereum/go-ethereum/beacon/engine"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/require"
+
+	moduletestutil "github.com/piplabs/story/client/x/evmengine/testutil"
+	"github.com/piplabs/story/client/x/evmengine/types"
+	"github.com/piplabs/story/lib/errors"
+
+	"go.uber.org/mock/gomock"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestKeeper_Params(t *testing.T) {
+	t.Parallel()
+	ctx, keeper := createTestKeeper(t)
+
+	params := types.NewParams(common.HexToHash("0x01").Bytes(), 100, 0, 0, 0)
+	require.NoError(t, keeper.SetParams(ctx, params))
+
+	resp, err := keeper.Params(ctx, &types.QueryParamsRequest{})
+	require.NoError(t, err)
+	require.Equal(t, params, resp.Params)
+
+	_, err = keeper.Params(ctx, nil)
+	require.Equal(t, codes.InvalidArgument, status.Code(err))
+}
+
+func TestKeeper_UbiQueries(t *testing.T) {
+	t.Parallel()
+	ctx, keeper := createTestKeeper(t)
+	dk := moduletestutil.NewMockDistrKeeper(gomock.NewController(t))
+	keeper.distrKeeper = dk
+
+	dk.EXPECT().GetUbi(gomock.Any()).Return(math.LegacyNewDecWithPrec(5, 2), nil)
+	percentage, err := keeper.UbiPercentage(ctx, &types.QueryUbiPercentageRequest{})
+	require.NoError(t, err)
+	require.Equal(t, math.LegacyNewDecWithPrec(5, 2), percentage.Percentage)
+
+	dk.EXPECT().GetUbiBalanceByDenom(gomock.Any(), sdk.DefaultBondDenom).Return(math.NewInt(1000), nil)
+	balance, err := keeper.UbiBalance(ctx, &types.QueryUbiBalanceRequest{})
+	require.NoError(t, err)
+	require.Equal(t, math.NewInt(1000), balance.Balance)
+
+	dk.EXPECT().GetUbiBalanceByDenom(gomock.Any(), sdk.DefaultBondDenom).Return(math.Int{}, errors.New("store error"))
+	_, err = keeper.UbiBalance(ctx, &types.QueryUbiBalanceRequest{})
+	require.Equal(t, codes.Internal, status.Code(err))
+
+	_, err = keeper.UbiPercentage(ctx, nil)
+	require.Equal(t, codes.InvalidArgument, status.Code(err))
+	_, err = keeper.UbiBalance(ctx, nil)
+	require.Equal(t, codes.InvalidArgument, status.Code(err))
+}
+
+func TestKeeper_ExecutionHeadQueries(t *testing.T) {
+	t.Parallel()
+	ctx, keeper := createTestKeeper(t)
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	require.NoError(t, keeper.SetParams(ctx, types.NewParams(nil, 10, 0, 0, 0)))
+	require.NoError(t, keeper.InsertGenesisHead(ctx, common.BytesToHash([]byte("genesis")).Bytes()))
+
+	for height := uint64(1); height <= 4; height++ {
+		err := keeper.updateExecutionHead(sdkCtx.WithBlockHeight(int64(height)), engine.ExecutableData{
+			Number:    height,
+			BlockHash: common.BytesToHash([]byte{byte(height)}),
+			Timestamp: height,
+		})
+		require.NoError(t, err)
+	}
+
+	t.Run("latest", func(t *testing.T) {
+		t.Parallel()
+		resp, err := keeper.ExecutionHead(ctx, &types.QueryExecutionHeadRequest{})
+		require.NoError(t, err)
+		require.Equal(t, uint64(4), resp.Head.CreatedHeight)
+		require.Equal(t, uint64(4), resp.Head.BlockHeight)
+	})
+
+	t.Run("by height", func(t *testing.T) {
+		t.Parallel()
+		resp, err := keeper.ExecutionHead(ctx, &types.QueryExecutionHeadRequest{Height: 2})
+		require.NoError(t, err)
+		require.Equal(t, types.ExecutionHeadInfo{
+			CreatedHeight: 2,
+			BlockHeight:   2,
+			BlockHash:     common.BytesToHash([]byte{2}).Bytes(),
+			BlockTime:     2,
+		}, resp.Head)
+	})
+
+	t.Run("not found", func(t *testing.T) {
+		t.Parallel()
+		_, err := keeper.ExecutionHead(ctx, &types.QueryExecutionHeadRequest{Height: 5})
+		require.Equal(t, codes.NotFound, status.Code(err))
+	})
+
+	t.Run("paginated", func(t *testing.T) {
+		t.Parallel()
+		resp, err := keeper.ExecutionHeads(ctx, &types.QueryExecutionHeadsRequest{
+			Pagination: &query.PageRequest{Limit: 3, CountTotal: true},
+		})
+		require.NoError(t, err)
+		require.Len(t, resp.Heads, 3)
+		require.Equal(t, uint64(0), resp.Heads[0].CreatedHeight) // genesis
+		require.Equal(t, uint64(5), resp.Pagination.Total)
+		require.NotEmpty(t, resp.Pagination.NextKey)
+
+		resp, err = keeper.ExecutionHeads(ctx, &types.QueryExecutionHeadsRequest{
+			Pagination: &query.PageRequest{Key: resp.Pagination.NextKey},
+		})
+		require.NoError(t, err)
+		require.Len(t, resp.Heads, 2)
+		require.Equal(t, uint64(4), resp.Heads[1].CreatedHeight)
+	})
+}
@@ -15,8 +15,10 @@ import (
 	"github.com/cosmos/cosmos-sdk/codec"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	grpc1 "github.com/cosmos/gogoproto/grpc"
+	"github.com/ethereum/go-ethereum/accounts/abi"
 	"github.com/ethereum/go-ethereum/beacon/engine"
 	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/lru"
 
 	"github.com/piplabs/story/client/comet"
 	"github.com/piplabs/story/client/genutil/evm/predeploys"
@@ -28,33 +30,51 @@ import (
 )
 
 type Keeper struct {
-	cdc             codec.BinaryCodec
-	storeService    store.KVStoreService
-	headTable       ExecutionHeadTable
-	engineCl        ethclient.EngineClient
-	txConfig        client.TxConfig
-	cmtAPI          comet.API
-	buildDelay      time.Duration
-	buildOptimistic bool
-	validatorAddr   common.Address
+	cdc              codec.BinaryCodec
+	storeService     store.KVStoreService
+	headTable        ExecutionHeadTable
+	headHistoryTable ExecutionHeadHistoryTable
+	ubiTable         ValidatorUBITable
+	engineCl         ethclient.EngineClient
+	txConfig         client.TxConfig
+	cmtAPI           comet.API
+	buildDelay       time.Duration
+	buildOptimistic  bool
+	proposalDeadline time.Duration
+	validatorAddr    common.Address
+	pragueTime       *uint64
+	randaoForkHeight *int64
 
 	accountKeeper    types.AccountKeeper
 	evmstakingKeeper types.EvmStakingKeeper
 	upgradeKeeper    types.UpgradeKeeper
 	distrKeeper      types.DistrKeeper
+	stakingKeeper    types.StakingKeeper
+
+	// authority is the address allowed to update the module params, usually the x/gov module account.
+	authority string
 
 	upgradeContract *bindings.UpgradeEntrypoint
 	ubiContract     *bindings.UBIPool
 
+	// eventProcs are the registered EVM event processors.
+	eventProcs []types.EvmEventProcessor
+
+	// eventsCache contains the EVM log events of the recently fetched execution blocks by block hash,
+	// so they are only fetched once from the EL when preparing, processing and finalizing proposals.
+	eventsCache *lru.Cache[common.Hash, evmEventsEntry]
+
 	// mutablePayload contains the previous optimistically triggered payload.
 	// It is optimistic because the validator set can change,
 	// so we might not actually be the next proposer.
 	mutablePayload struct {
 		sync.Mutex
-		ID        engine.PayloadID
-		Height    uint64
-		UpdatedAt time.Time
+		optimisticPayload
 	}
+
+	// payloadFile is the local file the optimistic payload is persisted to, so it survives restarts.
+	// Persisting is disabled if empty.
+	payloadFile string
 }
 
 func NewKeeper(
@@ -67,6 +87,8 @@ func NewKeeper(
 	esk types.EvmStakingKeeper,
 	uk types.UpgradeKeeper,
 	dk types.DistrKeeper,
+	sk types.StakingKeeper,
+	authority string,
 ) (*Keeper, error) {
 	schema := &ormv1alpha1.ModuleSchemaDescriptor{SchemaFile: []*ormv1alpha1.ModuleSchemaDescriptor_FileEntry{
 		{Id: 1, ProtoFileName: File_client_x_evmengine_keeper_evmengine_proto.Path()},
@@ -92,10 +114,12 @@ func NewKeeper(
 		panic(fmt.Sprintf("failed to bind to the UBIPool contract: %s", err))
 	}
 
-	return &Keeper{
+	k := &Keeper{
 		cdc:              cdc,
 		storeService:     storeService,
 		headTable:        dbStore.ExecutionHeadTable(),
+		headHistoryTable: dbStore.ExecutionHeadHistoryTable(),
+		ubiTable:         dbStore.ValidatorUBITable(),
 		engineCl:         engineCl,
 		txConfig:         txConfig,
 		accountKeeper:    ak,
@@ -104,7 +128,37 @@ func NewKeeper(
 		upgradeContract:  upgradeContract,
 		ubiContract:      ubiContract,
 		distrKeeper:      dk,
-	}, nil
+		stakingKeeper:    sk,
+		authority:        authority,
+		eventsCache:      lru.NewCache[common.Hash, evmEventsEntry](evmEventsCacheSize),
+	}
+
+	// Register the event processors of the evmengine contracts, other modules provide theirs via depinject.
+	if err := k.AddEventProcessors(
+		keeperEventProcessor{
+			name:      upgradeEventProcessor,
+			order:     upgradeEventProcessorOrder,
+			addresses: []common.Address{common.HexToAddress(predeploys.UpgradeEntrypoint)},
+			events:    []abi.Event{types.SoftwareUpgradeEvent, types.CancelUpgradeEvent},
+			deliver:   k.ProcessUpgradeEvents,
+		},
+		keeperEventProcessor{
+			name:      ubiEventProcessor,
+			order:     ubiEventProcessorOrder,
+			addresses: []common.Address{common.HexToAddress(predeploys.UBIPool)},
+			events:    []abi.Event{types.UBIPercentageSetEvent, types.UBIDistributionSetEvent, types.UBIClaimedEvent},
+			deliver:   k.ProcessUbiEvents,
+		},
+	); err != nil {
+		return nil, errors.Wrap(err, "add event processors")
+	}
+
+	return k, nil
+}
+
+// GetAuthority returns the x/evmengine module's authority.
+func (k *Keeper) GetAuthority() string {
+	return k.authority
 }
 
 // SetCometAPI sets the comet API client.
@@ -122,11 +176,31 @@ func (k *Keeper) SetBuildOptimistic(b bool) {
 	k.buildOptimistic = b
 }
 
+// SetProposalDeadline sets the proposal deadline parameter.
+func (k *Keeper) SetProposalDeadline(d time.Duration) {
+	k.proposalDeadline = d
+}
+
 // SetValidatorAddress sets the validator address.
 func (k *Keeper) SetValidatorAddress(addr common.Address) {
 	k.validatorAddr = addr
 }
 
+// SetPragueTime sets the execution chain Prague fork timestamp, from which the Engine API V4 is used.
+func (k *Keeper) SetPragueTime(pragueTime *uint64) {
+	k.pragueTime = pragueTime
+}
+
+// SetRandaoForkHeight sets the consensus chain height of the vote extension randao fork, see prevRandao.
+func (k *Keeper) SetRandaoForkHeight(height *int64) {
+	k.randaoForkHeight = height
+}
+
+// SetOptimisticPayloadFile sets the local file the optimistic payload is persisted to.
+func (k *Keeper) SetOptimisticPayloadFile(path string) {
+	k.payloadFile = path
+}
+
 // RegisterProposalService registers the proposal service on the provided router.
 // This implements abci.ProcessProposal verification of new proposals.
 func (k *Keeper) RegisterProposalService(server grpc1.Server) {
@@ -169,16 +243,33 @@ func (k *Keeper) parseAndVerifyProposedPayload(ctx context.Context, msg *types.M
 		)
 	}
 
-	// Ensure the Randao Digest is equaled to parent hash as this is our workaround at this point.
-	if payload.Random != head.Hash() {
-		return engine.ExecutableData{}, errors.New("invalid payload random", "proposed", payload.Random, "latest", head.Hash())
+	// Ensure the Randao Digest is equal to the expected PrevRandao of this height.
+	random, err := k.prevRandao(ctx, sdk.UnwrapSDKContext(ctx).BlockHeight(), head)
+	if err != nil {
+		return engine.ExecutableData{}, errors.Wrap(err, "prev randao")
+	} else if payload.Random != random {
+		return engine.ExecutableData{}, errors.New("invalid payload random", "proposed", payload.Random, "expected", random)
+	}
+
+	// Ensure the gas limit and extra data are within the limits of the params.
+	if err := k.verifyPayloadLimits(ctx, head, payload); err != nil {
+		return engine.ExecutableData{}, err
+	}
+
+	// Ensure execution requests are only included from the Prague fork.
+	if len(msg.ExecutionRequests) > 0 && k.engineVersionAt(payload.Timestamp) != engineV4 {
+		return engine.ExecutableData{}, errors.New("execution requests before prague fork", "timestamp", payload.Timestamp)
 	}
 
 	return payload, nil
 }
 
 // isNextProposer returns true if the local node is the proposer
-// for the next block. It also returns the next block height.
+// for the next block.
+//
+// The validator set of the next height returned by CometBFT retains its proposer priorities,
+// i.e., the validator updates and the proposer-priority increment of the next height are
+// already applied, so its proposer is the proposer of the next height.
 //
 // Note that the validator set can change, so this is an optimistic check.
 func (k *Keeper) isNextProposer(ctx context.Context, currentProposer []byte, currentHeight int64) (bool, error) {
@@ -200,9 +291,14 @@ func (k *Keeper) isNextProposer(ctx context.Context, currentProposer []byte, cur
 		return false, errors.New("proposer not in validator set")
 	}
 
-	nextIdx := int(idx+1) % len(valset.Validators)
-	nextProposer := valset.Validators[nextIdx]
-	nextAddr, err := k1util.PubKeyToAddress(nextProposer.PubKey)
+	nextValset, ok, err := k.cmtAPI.Validators(ctx, currentHeight+1)
+	if err != nil {
+		return false, err
+	} else if !ok || len(nextValset.Validators) == 0 {
+		return false, errors.New("next validators not available")
+	}
+
+	nextAddr, err := k1util.PubKeyToAddress(nextValset.GetProposer().PubKey)
 	if err != nil {
 		return false, err
 	}
@@ -212,18 +308,26 @@ func (k *Keeper) isNextProposer(ctx context.Context, currentProposer []byte, cur
 	return isNextProposer, nil
 }
 
-func (k *Keeper) setOptimisticPayload(id engine.PayloadID, height uint64) {
+// setOptimisticPayload sets the optimistically triggered payload built on top of the provided parent hash,
+// and persists it to the local payload file.
+func (k *Keeper) setOptimisticPayload(id engine.PayloadID, height uint64, version engineVersion, parentHash common.Hash) error {
 	k.mutablePayload.Lock()
 	defer k.mutablePayload.Unlock()
 
-	k.mutablePayload.ID = id
-	k.mutablePayload.Height = height
-	k.mutablePayload.UpdatedAt = time.Now()
+	k.mutablePayload.optimisticPayload = optimisticPayload{
+		ID:         id,
+		Height:     height,
+		Version:    version,
+		ParentHash: parentHash,
+		UpdatedAt:  time.Now(),
+	}
+
+	return k.persistOptimisticPayload(k.mutablePayload.optimisticPayload)
 }
 
-func (k *Keeper) getOptimisticPayload() (engine.PayloadID, uint64, time.Time) {
+func (k *Keeper) getOptimisticPayload() optimisticPayload {
 	k.mutablePayload.Lock()
 	defer k.mutablePayload.Unlock()
 
-	return k.mutablePayload.ID, k.mutablePayload.Height, k.mutablePayload.UpdatedAt
+	return k.mutablePayload.optimisticPayload
 }
@@ -11,6 +11,8 @@ import (
 	cmttypes "github.com/cometbft/cometbft/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	authtx "github.com/cosmos/cosmos-sdk/x/auth/tx"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
 	"github.com/ethereum/go-ethereum/beacon/engine"
 	"github.com/ethereum/go-ethereum/common"
 	fuzz "github.com/google/gofuzz"
@@ -27,6 +29,9 @@ import (
 	"go.uber.org/mock/gomock"
 )
 
+// authority is the module authority of the test keepers.
+var authority = authtypes.NewModuleAddress(govtypes.ModuleName).String()
+
 type args struct {
 	height         int64
 	validatorsFunc func(context.Context, int64) (*cmttypes.ValidatorSet, bool, error)
@@ -50,12 +55,13 @@ func createTestKeeper(t *testing.T) (context.Context, *Keeper) {
 	esk := moduletestutil.NewMockEvmStakingKeeper(ctrl)
 	uk := moduletestutil.NewMockUpgradeKeeper(ctrl)
 	dk := moduletestutil.NewMockDistrKeeper(ctrl)
+	sk := moduletestutil.NewMockStakingKeeper(ctrl)
 
 	ctx, storeKey, storeService := setupCtxStore(t, &header)
 	mockEngine, err := newMockEngineAPI(storeKey, 0)
 	require.NoError(t, err)
 
-	keeper, err := NewKeeper(cdc, storeService, &mockEngine, mockClient, txConfig, ak, esk, uk, dk)
+	keeper, err := NewKeeper(cdc, storeService, &mockEngine, mockClient, txConfig, ak, esk, uk, dk, sk, authority)
 	require.NoError(t, err)
 	keeper.SetCometAPI(cmtAPI)
 
@@ -80,11 +86,12 @@ func createKeeper(t *testing.T, args args) (sdk.Context, *mockCometAPI, *Keeper)
 	esk := moduletestutil.NewMockEvmStakingKeeper(ctrl)
 	uk := moduletestutil.NewMockUpgradeKeeper(ctrl)
 	dk := moduletestutil.NewMockDistrKeeper(ctrl)
+	sk := moduletestutil.NewMockStakingKeeper(ctrl)
 
 	ctx, storeKey, storeService := setupCtxStore(t, &header)
 	mockEngine, err := newMockEngineAPI(storeKey, 0)
 	require.NoError(t, err)
-	keeper, err := NewKeeper(cdc, storeService, &mockEngine, mockClient, txConfig, ak, esk, uk, dk)
+	keeper, err := NewKeeper(cdc, storeService, &mockEngine, mockClient, txConfig, ak, esk, uk, dk, sk, authority)
 	require.NoError(t, err)
 	keeper.SetCometAPI(cmtAPI)
 	keeper.SetValidatorAddress(nxtAddr)
@@ -283,9 +290,11 @@ func TestKeeper_setOptimisticPayload(t *testing.T) {
 	require.Zero(t, keeper.mutablePayload.Height)
 
 	// set new values
-	keeper.setOptimisticPayload(engine.PayloadID{1}, 1)
+	require.NoError(t, keeper.setOptimisticPayload(engine.PayloadID{1}, 1, engineV4, common.Hash{2}))
 	require.Equal(t, uint64(1), keeper.mutablePayload.Height)
 	require.Equal(t, engine.PayloadID{1}, keeper.mutablePayload.ID)
+	require.Equal(t, engineV4, keeper.mutablePayload.Version)
+	require.Equal(t, common.Hash{2}, keeper.mutablePayload.ParentHash)
 }
 
 func TestKeeper_isNextProposer(t *testing.T) {
@@ -387,8 +396,12 @@ func TestKeeper_isNextProposer(t *testing.T) {
 			if got != tt.want {
 				t.Errorf("isNextProposer() got = %v, want %v", got, tt.want)
 			}
-			// make sure that height passed into Validators is correct
-			require.Equal(t, tt.args.height, cmtAPI.height)
+			// make sure that the last height passed into Validators is correct
+			if tt.wantErr {
+				require.Equal(t, tt.args.height, cmtAPI.height)
+			} else {
+				require.Equal(t, int64(tt.wantHeight), cmtAPI.height)
+			}
 		})
 	}
 }
@@ -434,7 +447,12 @@ func (m *mockCometAPI) Validators(ctx context.Context, height int64) (*cmttypes.
 		return m.validatorsFunc(ctx, height)
 	}
 
-	return m.validatorSet, true, nil
+	if height < 1 {
+		return m.validatorSet, true, nil
+	}
+
+	// Simulate CometBFT's proposer rotation, incrementing the priorities once per height.
+	return m.validatorSet.CopyIncrementProposerPriority(int32(height)), true, nil
 }
 
 // newFuzzer - create a new custom cmttypes.Validator fuzzer.
@@ -0,0 +1,68 @@
+package keeper
+
+import (
+	"context"
+
+	"github.com/ethereum/go-ethereum/beacon/engine"
+	"github.com/ethereum/go-ethereum/params"
+
+	"github.com/piplabs/story/lib/errors"
+)
+
+// verifyPayloadLimits returns an error if the gas limit, gas used or extra data of the proposed payload
+// built on top of the given execution head exceed the limits of the module params.
+//
+// The gas limit can move from the parent gas limit by at most the max gas limit delta per block, and only
+// by a single EL adjustment step away from the target gas limit, so a proposer can't ratchet the execution gas limit.
+func (k *Keeper) verifyPayloadLimits(ctx context.Context, head *ExecutionHead, payload engine.ExecutableData) error {
+	params, err := k.GetParams(ctx)
+	if err != nil {
+		return errors.Wrap(err, "get params")
+	}
+
+	if payload.GasUsed > payload.GasLimit {
+		return errors.New("invalid payload gas used", "gas_used", payload.GasUsed, "gas_limit", payload.GasLimit)
+	}
+
+	if params.MaxExtraDataLength > 0 && uint64(len(payload.ExtraData)) > params.MaxExtraDataLength {
+		return errors.New("invalid payload extra data length",
+			"length", len(payload.ExtraData), "max", params.MaxExtraDataLength,
+		)
+	}
+
+	// The gas limit of heads finalized before the gas limit was tracked is unknown.
+	if params.TargetGasLimit == 0 || head.GetGasLimit() == 0 {
+		return nil
+	}
+
+	return verifyGasLimit(head.GetGasLimit(), payload.GasLimit, params.TargetGasLimit, params.MaxGasLimitDelta)
+}
+
+// verifyGasLimit returns an error if the gas limit moves from the parent gas limit by more than the max delta,
+// or if it moves away from the target gas limit by more than the EL's own adjustment bound (parent/1024).
+// Convergence on the target isn't required, since the gas limit is decided by each proposer's EL.
+func verifyGasLimit(parent, proposed, target, maxDelta uint64) error {
+	if absDiff(proposed, parent) > maxDelta {
+		return errors.New("invalid payload gas limit; exceeds max delta",
+			"proposed", proposed, "parent", parent, "max_delta", maxDelta,
+		)
+	}
+
+	fromParent, fromProposed := absDiff(parent, target), absDiff(proposed, target)
+	if fromProposed > fromParent && fromProposed-fromParent > parent/params.GasLimitBoundDivisor {
+		return errors.New("invalid payload gas limit; moves away from target",
+			"proposed", proposed, "parent", parent, "target", target,
+		)
+	}
+
+	return nil
+}
+
+// absDiff returns the absolute difference of a and b.
+func absDiff(a, b uint64) uint64 {
+	if a > b {
+		return a - b
+	}
+
+	return b - a
+}
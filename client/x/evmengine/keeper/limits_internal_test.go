@@ -0,0 +1,130 @@
+package keeper
+
+import (
+	"testing"
+
+	"github.com/ethereum/go-ethereum/beacon/engine"
+	"github.com/stretchr/testify/require"
+
+	"github.com/piplabs/story/client/x/evmengine/types"
+)
+
+func TestKeeper_verifyPayloadLimits(t *testing.T) {
+	t.Parallel()
+
+	head := &ExecutionHead{GasLimit: 30_000_000}
+	tcs := []struct {
+		name        string
+		params      types.Params
+		head        *ExecutionHead
+		payload     engine.ExecutableData
+		expectedErr string
+	}{
+		{
+			name:    "pass: limits disabled",
+			params:  types.NewParams(nil, 0, 0, 0, 0),
+			head:    head,
+			payload: engine.ExecutableData{GasLimit: 60_000_000, ExtraData: make([]byte, 64)},
+		},
+		{
+			name:    "pass: default params",
+			params:  types.DefaultParams(),
+			head:    head,
+			payload: engine.ExecutableData{GasLimit: 30_000_000, ExtraData: make([]byte, 32)},
+		},
+		{
+			name:        "fail: gas used exceeds gas limit",
+			params:      types.DefaultParams(),
+			head:        head,
+			payload:     engine.ExecutableData{GasLimit: 100, GasUsed: 101},
+			expectedErr: "invalid payload gas used",
+		},
+		{
+			name:    "pass: extra data within max length",
+			params:  types.NewParams(nil, 0, 0, 0, 8),
+			head:    head,
+			payload: engine.ExecutableData{GasLimit: 30_000_000, ExtraData: make([]byte, 8)},
+		},
+		{
+			name:        "fail: extra data exceeds max length",
+			params:      types.NewParams(nil, 0, 0, 0, 8),
+			head:        head,
+			payload:     engine.ExecutableData{GasLimit: 30_000_000, ExtraData: make([]byte, 9)},
+			expectedErr: "invalid payload extra data length",
+		},
+		{
+			name:    "pass: gas limit towards target",
+			params:  types.NewParams(nil, 0, 36_000_000, 30_000, 0),
+			head:    head,
+			payload: engine.ExecutableData{GasLimit: 30_030_000},
+		},
+		{
+			name:        "fail: gas limit exceeds max delta",
+			params:      types.NewParams(nil, 0, 36_000_000, 30_000, 0),
+			head:        head,
+			payload:     engine.ExecutableData{GasLimit: 30_030_001},
+			expectedErr: "invalid payload gas limit; exceeds max delta",
+		},
+		{
+			name:    "pass: unknown parent gas limit",
+			params:  types.NewParams(nil, 0, 36_000_000, 30_000, 0),
+			head:    &ExecutionHead{},
+			payload: engine.ExecutableData{GasLimit: 60_000_000},
+		},
+	}
+
+	for _, tc := range tcs {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			ctx, keeper := createTestKeeper(t)
+			require.NoError(t, keeper.SetParams(ctx, tc.params))
+
+			err := keeper.verifyPayloadLimits(ctx, tc.head, tc.payload)
+			if tc.expectedErr != "" {
+				require.ErrorContains(t, err, tc.expectedErr)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+func Test_verifyGasLimit(t *testing.T) {
+	t.Parallel()
+
+	tcs := []struct {
+		name     string
+		parent   uint64
+		proposed uint64
+		target   uint64
+		maxDelta uint64
+		wantErr  bool
+	}{
+		// The EL adjusts the gas limit by less than parent/1024 = 29_296 per block.
+		{name: "pass: at target", parent: 30_000_000, proposed: 30_000_000, target: 30_000_000, maxDelta: 100_000},
+		{name: "pass: unchanged away from target", parent: 30_000_000, proposed: 30_000_000, target: 60_000_000, maxDelta: 100_000},
+		{name: "pass: increase towards target by max delta", parent: 30_000_000, proposed: 30_100_000, target: 60_000_000, maxDelta: 100_000},
+		{name: "fail: increase towards target above max delta", parent: 30_000_000, proposed: 30_100_001, target: 60_000_000, maxDelta: 100_000, wantErr: true},
+		{name: "pass: decrease towards target by max delta", parent: 30_000_000, proposed: 29_900_000, target: 10_000_000, maxDelta: 100_000},
+		{name: "fail: decrease towards target above max delta", parent: 30_000_000, proposed: 29_899_999, target: 10_000_000, maxDelta: 100_000, wantErr: true},
+		{name: "pass: increase away from target by an EL step", parent: 30_000_000, proposed: 30_029_296, target: 30_000_000, maxDelta: 100_000},
+		{name: "fail: increase away from target above an EL step", parent: 30_000_000, proposed: 30_029_297, target: 30_000_000, maxDelta: 100_000, wantErr: true},
+		{name: "pass: decrease away from target by an EL step", parent: 30_000_000, proposed: 29_970_704, target: 60_000_000, maxDelta: 100_000},
+		{name: "fail: decrease away from target above an EL step", parent: 30_000_000, proposed: 29_970_703, target: 60_000_000, maxDelta: 100_000, wantErr: true},
+		{name: "pass: overshoot target by an EL step", parent: 30_000_000, proposed: 30_049_296, target: 30_010_000, maxDelta: 100_000},
+		{name: "fail: overshoot target above an EL step", parent: 30_000_000, proposed: 30_049_297, target: 30_010_000, maxDelta: 100_000, wantErr: true},
+		{name: "fail: away from target above max delta", parent: 30_000_000, proposed: 30_029_296, target: 30_000_000, maxDelta: 10_000, wantErr: true},
+	}
+
+	for _, tc := range tcs {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			err := verifyGasLimit(tc.parent, tc.proposed, tc.target, tc.maxDelta)
+			if tc.wantErr {
+				require.ErrorContains(t, err, "invalid payload gas limit")
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
@@ -0,0 +1,59 @@
+package keeper
+
+import (
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+)
+
+var (
+	optimisticBuildTotal = promauto.NewCounterVec(prometheus.CounterOpts{
+		Namespace: "evmengine",
+		Subsystem: "optimistic_build",
+		Name:      "total",
+		Help:      "Total number of prepared proposals by optimistic payload build result (hit: payload reused, miss: payload built on demand).",
+	}, []string{"result"})
+
+	proposalPayloadTotal = promauto.NewCounterVec(prometheus.CounterOpts{
+		Namespace: "evmengine",
+		Subsystem: "proposal",
+		Name:      "payload_total",
+		Help:      "Total number of prepared proposals by EVM payload result (included: payload proposed, empty: proposal deadline exceeded).",
+	}, []string{"result"})
+
+	evmEventsCacheTotal = promauto.NewCounterVec(prometheus.CounterOpts{
+		Namespace: "evmengine",
+		Subsystem: "evm_events_cache",
+		Name:      "total",
+		Help:      "Total number of EVM log event lookups by cache result (hit: cached events reused, miss: events fetched from the EL).",
+	}, []string{"result"})
+)
+
+// incOptimisticBuild increments the optimistic build hit or miss count.
+func incOptimisticBuild(hit bool) {
+	result := "miss"
+	if hit {
+		result = "hit"
+	}
+
+	optimisticBuildTotal.WithLabelValues(result).Inc()
+}
+
+// incProposalPayload increments the count of proposals with or without an EVM payload.
+func incProposalPayload(included bool) {
+	result := "empty"
+	if included {
+		result = "included"
+	}
+
+	proposalPayloadTotal.WithLabelValues(result).Inc()
+}
+
+// incEVMEventsCache increments the EVM log events cache hit or miss count.
+func incEVMEventsCache(hit bool) {
+	result := "miss"
+	if hit {
+		result = "hit"
+	}
+
+	evmEventsCacheTotal.WithLabelValues(result).Inc()
+}
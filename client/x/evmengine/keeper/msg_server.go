@@ -37,15 +37,8 @@ func (s msgServer) ExecutionPayload(ctx context.Context, msg *types.MsgExecution
 		return nil, err
 	}
 
-	// TODO: should we compare and reject in a finalized block?
-	//// Ensure that the withdrawals in the payload are from the front indices of the queue.
-	// if err := s.compareWithdrawals(ctx, payload.Withdrawals); err != nil {
-	//	return nil, errors.Wrap(err, "compare local and received withdrawals")
-	//}
-
-	// TODO: We dequeue with assumption that the top items of the queue are the ones that are processed in the block.
-	// TODO: We might need to check that the withdrawals in the finalized block are the same as the ones dequeued.
-	// Since we already checked the withdrawals in the proposal server, we simply check the length here.
+	// Dequeue the withdrawals from the front of the queues and ensure that they are exactly
+	// the ones included in the finalized payload (see proposalServer.compareWithdrawals).
 	log.Debug(
 		ctx, "Dequeueing eligible withdrawals [BEFORE]",
 		"total_len", len(payload.Withdrawals),
@@ -88,18 +81,25 @@ func (s msgServer) ExecutionPayload(ctx context.Context, msg *types.MsgExecution
 		"reward_withdrawals_len", len(rws),
 	)
 
-	if totalWithdrawals := len(ws) + len(rws); totalWithdrawals != len(payload.Withdrawals) {
-		return nil, fmt.Errorf(
-			"dequeued total withdrawals %v should equal to proposed withdrawals %v",
-			totalWithdrawals, len(payload.Withdrawals),
-		)
+	if err := withdrawalsEqual(append(ws, rws...), payload.Withdrawals); err != nil {
+		return nil, errors.Wrap(err, "compare dequeued and finalized withdrawals")
 	}
 
 	err = retryForever(ctx, func(ctx context.Context) (bool, error) {
-		status, err := pushPayload(ctx, s.engineCl, payload)
-		if err != nil || isUnknown(status) {
-			// We need to retry forever on networking errors, but can't easily identify them, so retry all errors.
-			log.Warn(ctx, "Processing finalized payload failed: push new payload to evm (will retry)", err,
+		status, err := s.pushPayload(ctx, payload, msg.ExecutionRequests)
+		if err != nil {
+			// The payload is already finalized, so even a rejection depends on the local EL state and must not
+			// change the result of finalizing the block. All errors are retried, the non-retryable ones are
+			// logged as errors since they are only resolved once the EL is fixed by the operator.
+			if ethclient.IsRetryable(err) {
+				log.Warn(ctx, "Processing finalized payload failed: push new payload to evm (will retry)", err)
+			} else {
+				log.Error(ctx, "Processing finalized payload failed: push new payload rejected by evm (will retry)", err)
+			}
+
+			return false, nil // Retry
+		} else if isUnknown(status) {
+			log.Warn(ctx, "Processing finalized payload failed: push new payload to evm (will retry)", nil,
 				"status", status.Status)
 
 			return false, nil // Retry
@@ -127,9 +127,19 @@ func (s msgServer) ExecutionPayload(ctx context.Context, msg *types.MsgExecution
 
 	err = retryForever(ctx, func(ctx context.Context) (bool, error) {
 		fcr, err := s.engineCl.ForkchoiceUpdatedV3(ctx, fcs, nil)
-		if err != nil || isUnknown(fcr.PayloadStatus) {
-			// We need to retry forever on networking errors, but can't easily identify them, so retry all errors.
-			log.Warn(ctx, "Processing finalized payload failed: evm fork choice update (will retry)", err,
+		if err != nil {
+			// Same as above, all errors are retried since a rejection depends on the local EL state.
+			if ethclient.IsRetryable(err) {
+				log.Warn(ctx, "Processing finalized payload failed: evm fork choice update (will retry)", err,
+					"payload_height", payload.Number)
+			} else {
+				log.Error(ctx, "Processing finalized payload failed: fork choice update rejected by evm (will retry)", err,
+					"payload_height", payload.Number)
+			}
+
+			return false, nil // Retry
+		} else if isUnknown(fcr.PayloadStatus) {
+			log.Warn(ctx, "Processing finalized payload failed: evm fork choice update (will retry)", nil,
 				"status", fcr.PayloadStatus.Status)
 
 			return false, nil // Retry
@@ -152,14 +162,8 @@ func (s msgServer) ExecutionPayload(ctx context.Context, msg *types.MsgExecution
 	}
 
 	// Deliver all the previous payload log events
-	if err := s.evmstakingKeeper.ProcessStakingEvents(ctx, payload.Number-1, msg.PrevPayloadEvents); err != nil {
-		return nil, errors.Wrap(err, "deliver staking-related event logs")
-	}
-	if err := s.ProcessUpgradeEvents(ctx, payload.Number-1, msg.PrevPayloadEvents); err != nil {
-		return nil, errors.Wrap(err, "deliver upgrade-related event logs")
-	}
-	if err := s.ProcessUbiEvents(ctx, payload.Number-1, msg.PrevPayloadEvents); err != nil {
-		return nil, errors.Wrap(err, "deliver ubi-related event logs")
+	if err := s.deliverEvents(ctx, payload.Number-1, msg.PrevPayloadEvents); err != nil {
+		return nil, err
 	}
 
 	if err := s.updateExecutionHead(ctx, payload); err != nil {
@@ -169,8 +173,66 @@ func (s msgServer) ExecutionPayload(ctx context.Context, msg *types.MsgExecution
 	return &types.ExecutionPayloadResponse{}, nil
 }
 
-// pushPayload pushes the given Engine API payload to EL and returns the engine payload status or an error.
-func pushPayload(ctx context.Context, engineCl ethclient.EngineClient, payload engine.ExecutableData) (engine.PayloadStatusV1, error) {
+// AddVotes records the execution block attested by a quorum of the previous block's vote extensions.
+// This is called as part of FinalizeBlock ABCI++ method, the votes were verified by ProcessProposal.
+func (s msgServer) AddVotes(ctx context.Context, msg *types.MsgAddVotes) (*types.AddVotesResponse, error) {
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	if sdkCtx.ExecMode() != sdk.ExecModeFinalize {
+		return nil, errors.New("only allowed in finalize mode")
+	}
+
+	// Mix the vote extension signatures into the randao of subsequent execution payloads.
+	if err := s.updateRandaoMix(ctx, sdkCtx.BlockHeight(), msg.Votes); err != nil {
+		return nil, errors.Wrap(err, "update randao mix")
+	}
+
+	attestation, ok, err := s.tallyVotes(msg.Votes)
+	if err != nil {
+		return nil, errors.Wrap(err, "tally votes")
+	} else if !ok {
+		log.Warn(ctx, "No quorum attestation of execution block in vote extensions", nil,
+			"height", sdkCtx.BlockHeight()-1,
+			"votes", len(msg.Votes),
+		)
+
+		return &types.AddVotesResponse{}, nil
+	}
+
+	// The votes of the previous block attest to the execution block included in it.
+	attestation.Height = uint64(sdkCtx.BlockHeight() - 1)
+	if err := s.setExecutionAttestation(ctx, attestation); err != nil {
+		return nil, err
+	}
+
+	log.Debug(ctx, "Execution block attested by quorum",
+		"height", attestation.Height,
+		"block_number", attestation.BlockNumber,
+		log.Hex7("block_hash", attestation.BlockHash),
+	)
+
+	return &types.AddVotesResponse{}, nil
+}
+
+// UpdateParams updates the module params, it is called by x/gov when a param change proposal passes.
+func (s msgServer) UpdateParams(ctx context.Context, msg *types.MsgUpdateParams) (*types.MsgUpdateParamsResponse, error) {
+	if msg.Authority != s.authority {
+		return nil, errors.New("invalid authority", "expected", s.authority, "got", msg.Authority)
+	}
+
+	if err := msg.Params.Validate(); err != nil {
+		return nil, errors.Wrap(err, "validate params")
+	}
+
+	if err := s.SetParams(ctx, msg.Params); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgUpdateParamsResponse{}, nil
+}
+
+// pushPayload pushes the given Engine API payload and its execution requests to EL, using the Engine API
+// version of the payload's fork, and returns the engine payload status or an error.
+func (k *Keeper) pushPayload(ctx context.Context, payload engine.ExecutableData, executionRequests [][]byte) (engine.PayloadStatusV1, error) {
 	sdkCtx := sdk.UnwrapSDKContext(ctx)
 	appHash := common.BytesToHash(sdkCtx.BlockHeader().AppHash)
 	if appHash == (common.Hash{}) {
@@ -180,7 +242,13 @@ func pushPayload(ctx context.Context, engineCl ethclient.EngineClient, payload e
 	emptyVersionHashes := make([]common.Hash, 0) // Cannot use nil.
 
 	// Push it back to the execution client (mark it as possible new head).
-	status, err := engineCl.NewPayloadV3(ctx, payload, emptyVersionHashes, &appHash)
+	var status engine.PayloadStatusV1
+	var err error
+	if k.engineVersionAt(payload.Timestamp) == engineV4 {
+		status, err = k.engineCl.NewPayloadV4(ctx, payload, emptyVersionHashes, &appHash, toHexBytes(executionRequests))
+	} else {
+		status, err = k.engineCl.NewPayloadV3(ctx, payload, emptyVersionHashes, &appHash)
+	}
 	if err != nil {
 		return engine.PayloadStatusV1{}, errors.Wrap(err, "new payload")
 	}
@@ -41,6 +41,7 @@ func Test_msgServer_ExecutionPayload(t *testing.T) {
 	esk := moduletestutil.NewMockEvmStakingKeeper(ctrl)
 	uk := moduletestutil.NewMockUpgradeKeeper(ctrl)
 	dk := moduletestutil.NewMockDistrKeeper(ctrl)
+	sk := moduletestutil.NewMockStakingKeeper(ctrl)
 
 	cmtAPI := newMockCometAPI(t, nil)
 	// set the header and proposer so we have the correct next proposer
@@ -51,15 +52,31 @@ func Test_msgServer_ExecutionPayload(t *testing.T) {
 
 	ctx, storeKey, storeService := setupCtxStore(t, &header)
 	ctx = ctx.WithExecMode(sdk.ExecModeFinalize)
-	evmLogProc := mockLogProvider{deliverErr: errors.New("test error")}
+	evmLogProc := mockLogProvider{}
 	mockEngine, err := newMockEngineAPI(storeKey, 2)
 	require.NoError(t, err)
-	keeper, err := NewKeeper(cdc, storeService, &mockEngine, mockClient, txConfig, ak, esk, uk, dk)
+	keeper, err := NewKeeper(cdc, storeService, &mockEngine, mockClient, txConfig, ak, esk, uk, dk, sk, authority)
 	require.NoError(t, err)
 	keeper.SetCometAPI(cmtAPI)
 	keeper.SetValidatorAddress(nxtAddr)
 	populateGenesisHead(ctx, t, keeper)
 
+	// Register a processor for the random events, failing delivery if deliverErr is set.
+	var deliverErr error
+	require.NoError(t, keeper.AddEventProcessors(keeperEventProcessor{
+		name:      evmLogProc.Name(),
+		order:     evmLogProc.Order(),
+		addresses: evmLogProc.Addresses(),
+		events:    evmLogProc.Events(),
+		deliver: func(ctx context.Context, height uint64, events []*types.EVMEvent) error {
+			if err := evmLogProc.Deliver(ctx, height, events); err != nil {
+				return err
+			}
+
+			return deliverErr
+		},
+	}))
+
 	msgSrv := NewMsgServerImpl(keeper)
 	createValidPayload := func(c context.Context) (*etypes.Block, engine.PayloadID, []byte) {
 		// get latest block to build on top
@@ -101,7 +118,6 @@ func Test_msgServer_ExecutionPayload(t *testing.T) {
 				esk.EXPECT().MaxWithdrawalPerBlock(c).Return(uint32(0), nil)
 				esk.EXPECT().DequeueEligibleWithdrawals(c, gomock.Any()).Return(nil, nil)
 				esk.EXPECT().DequeueEligibleRewardWithdrawals(c, gomock.Any()).Return(nil, nil)
-				esk.EXPECT().ProcessStakingEvents(c, gomock.Any(), gomock.Any()).Return(nil)
 
 				return sdk.UnwrapSDKContext(c)
 			},
@@ -116,6 +132,45 @@ func Test_msgServer_ExecutionPayload(t *testing.T) {
 				require.Empty(t, gotPayload.ExecutionPayload.Withdrawals)
 			},
 		},
+		{
+			name: "pass: retry payload and fork choice rejected by evm",
+			setup: func(c context.Context) sdk.Context {
+				esk.EXPECT().MaxWithdrawalPerBlock(c).Return(uint32(0), nil)
+				esk.EXPECT().DequeueEligibleWithdrawals(c, gomock.Any()).Return(nil, nil)
+				esk.EXPECT().DequeueEligibleRewardWithdrawals(c, gomock.Any()).Return(nil, nil)
+
+				// Rejections of the local EL must not change the result of finalizing the block.
+				var payloadRejected, fcuRejected bool
+				mockEngine.newPayloadV3Func = func(ctx context.Context, params engine.ExecutableData, hashes []common.Hash, root *common.Hash) (engine.PayloadStatusV1, error) {
+					if !payloadRejected {
+						payloadRejected = true
+						return engine.PayloadStatusV1{}, ethclient.ErrInvalidParams
+					}
+
+					return mockEngine.mock.NewPayloadV3(ctx, params, hashes, root)
+				}
+				mockEngine.forkchoiceUpdatedV3Func = func(ctx context.Context, update engine.ForkchoiceStateV1, attrs *engine.PayloadAttributes) (engine.ForkChoiceResponse, error) {
+					if !fcuRejected {
+						fcuRejected = true
+						return engine.ForkChoiceResponse{}, ethclient.ErrInvalidForkchoiceState
+					}
+
+					return mockEngine.mock.ForkchoiceUpdatedV3(ctx, update, attrs)
+				}
+
+				return sdk.UnwrapSDKContext(c)
+			},
+			createPayload:           createValidPayload,
+			createPrevPayloadEvents: createRandomEvents,
+			postCheck: func(c context.Context, block *etypes.Block, _ engine.PayloadID) {
+				mockEngine.newPayloadV3Func = nil
+				mockEngine.forkchoiceUpdatedV3Func = nil
+
+				head, err := keeper.getExecutionHead(c)
+				require.NoError(t, err)
+				require.Equal(t, block.Hash(), head.Hash())
+			},
+		},
 		{
 			name: "fail: sdk exec mode is not finalize",
 			setup: func(c context.Context) sdk.Context {
@@ -170,6 +225,21 @@ func Test_msgServer_ExecutionPayload(t *testing.T) {
 			createPayload: createValidPayload,
 			expectedError: "error on withdrawals dequeue",
 		},
+		{
+			name: "fail: dequeued withdrawals differ from finalized payload",
+			setup: func(ctx context.Context) sdk.Context {
+				esk.EXPECT().MaxWithdrawalPerBlock(ctx).Return(uint32(1), nil)
+				esk.EXPECT().DequeueEligibleWithdrawals(ctx, gomock.Any()).Return(nil, nil)
+				esk.EXPECT().DequeueEligibleRewardWithdrawals(ctx, gomock.Any()).Return(etypes.Withdrawals{
+					{Index: 0, Address: common.HexToAddress("0x1"), Amount: 1},
+				}, nil)
+
+				return sdk.UnwrapSDKContext(ctx)
+			},
+			createPayload:           createValidPayload,
+			createPrevPayloadEvents: createRandomEvents,
+			expectedError:           "compare dequeued and finalized withdrawals",
+		},
 		{
 			name: "fail: NewPayloadV3 returns status invalid",
 			setup: func(ctx context.Context) sdk.Context {
@@ -199,32 +269,31 @@ func Test_msgServer_ExecutionPayload(t *testing.T) {
 			expectedError:           "payload invalid",
 		},
 		{
-			name: "fail: ProcessStakingEvents error",
+			name: "fail: deliver events error",
 			setup: func(ctx context.Context) sdk.Context {
 				esk.EXPECT().MaxWithdrawalPerBlock(ctx).Return(uint32(0), nil)
 				esk.EXPECT().DequeueEligibleWithdrawals(ctx, gomock.Any()).Return(nil, nil)
 				esk.EXPECT().DequeueEligibleRewardWithdrawals(ctx, gomock.Any()).Return(nil, nil)
-				esk.EXPECT().ProcessStakingEvents(ctx, gomock.Any(), gomock.Any()).Return(errors.New("failed to process staking events"))
+				deliverErr = errors.New("failed to process events")
 
 				return sdk.UnwrapSDKContext(ctx)
 			},
 			createPayload:           createValidPayload,
 			createPrevPayloadEvents: createRandomEvents,
-			expectedError:           "deliver staking-related event logs",
+			expectedError:           "deliver event logs",
 		},
 		{
-			name: "fail: ProcessUpgradeEvents error",
+			name: "fail: invalid event",
 			setup: func(ctx context.Context) sdk.Context {
 				esk.EXPECT().MaxWithdrawalPerBlock(ctx).Return(uint32(0), nil)
 				esk.EXPECT().DequeueEligibleWithdrawals(ctx, gomock.Any()).Return(nil, nil)
 				esk.EXPECT().DequeueEligibleRewardWithdrawals(ctx, gomock.Any()).Return(nil, nil)
-				esk.EXPECT().ProcessStakingEvents(ctx, gomock.Any(), gomock.Any()).Return(nil)
 
 				return sdk.UnwrapSDKContext(ctx)
 			},
 			createPayload: createValidPayload,
 			createPrevPayloadEvents: func(_ context.Context, _ common.Hash) []*types.EVMEvent {
-				// crate invalid upgrade event to trigger ProcessUpgradeEvents failure
+				// create invalid upgrade event to trigger event verification failure
 				upgradeAbi, err := bindings.UpgradeEntrypointMetaData.GetAbi()
 				require.NoError(t, err, "failed to load ABI")
 				data, err := upgradeAbi.Events["SoftwareUpgrade"].Inputs.NonIndexed().Pack("test-upgrade", int64(0), "test-info")
@@ -236,7 +305,24 @@ func Test_msgServer_ExecutionPayload(t *testing.T) {
 					Data:    data,
 				}}
 			},
-			expectedError: "deliver upgrade-related event logs",
+			expectedError: "verify event",
+		},
+		{
+			name: "pass: event without processor is skipped",
+			setup: func(ctx context.Context) sdk.Context {
+				esk.EXPECT().MaxWithdrawalPerBlock(ctx).Return(uint32(0), nil)
+				esk.EXPECT().DequeueEligibleWithdrawals(ctx, gomock.Any()).Return(nil, nil)
+				esk.EXPECT().DequeueEligibleRewardWithdrawals(ctx, gomock.Any()).Return(nil, nil)
+
+				return sdk.UnwrapSDKContext(ctx)
+			},
+			createPayload: createValidPayload,
+			createPrevPayloadEvents: func(_ context.Context, _ common.Hash) []*types.EVMEvent {
+				return []*types.EVMEvent{{
+					Address: tutil.RandomBytes(common.AddressLength),
+					Topics:  [][]byte{tutil.RandomHash().Bytes()},
+				}}
+			},
 		},
 	}
 
@@ -249,6 +335,7 @@ func Test_msgServer_ExecutionPayload(t *testing.T) {
 			var block *etypes.Block
 			var events []*types.EVMEvent
 
+			deliverErr = nil
 			cachedCtx, _ := ctx.CacheContext()
 			if tc.setup != nil {
 				cachedCtx = tc.setup(cachedCtx)
@@ -284,6 +371,54 @@ func Test_msgServer_ExecutionPayload(t *testing.T) {
 	// assertExecutionPayload(ctx)
 }
 
+func Test_msgServer_UpdateParams(t *testing.T) {
+	t.Parallel()
+
+	validParams := types.NewParams(common.HexToHash("0x01").Bytes(), 100, 30_000_000, 30_000, 32)
+	tcs := []struct {
+		name        string
+		msg         *types.MsgUpdateParams
+		expectedErr string
+	}{
+		{
+			name: "pass: valid params",
+			msg:  &types.MsgUpdateParams{Authority: authority, Params: validParams},
+		},
+		{
+			name:        "fail: invalid authority",
+			msg:         &types.MsgUpdateParams{Authority: authtypes.NewModuleAddress(types.ModuleName).String(), Params: validParams},
+			expectedErr: "invalid authority",
+		},
+		{
+			name: "fail: invalid params",
+			msg: &types.MsgUpdateParams{
+				Authority: authority,
+				Params:    types.NewParams(common.HexToHash("0x01").Bytes(), 100, 30_000_000, 0, 32),
+			},
+			expectedErr: "validate params",
+		},
+	}
+
+	for _, tc := range tcs {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			ctx, keeper := createTestKeeper(t)
+			msgSrv := NewMsgServerImpl(keeper)
+
+			_, err := msgSrv.UpdateParams(ctx, tc.msg)
+			params, getErr := keeper.GetParams(ctx)
+			require.NoError(t, getErr)
+			if tc.expectedErr != "" {
+				require.ErrorContains(t, err, tc.expectedErr)
+				require.Equal(t, types.Params{}, params)
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, tc.msg.Params, params)
+			}
+		})
+	}
+}
+
 // populateGenesisHead inserts the mock genesis execution head into the database.
 func populateGenesisHead(ctx context.Context, t *testing.T, keeper *Keeper) {
 	t.Helper()
@@ -410,7 +545,8 @@ func Test_pushPayload(t *testing.T) {
 				tt.args.transformPayload(&payload)
 			}
 
-			status, err := pushPayload(ctx, &mockEngine, payload)
+			keeper := &Keeper{engineCl: &mockEngine}
+			status, err := keeper.pushPayload(ctx, payload, nil)
 			if (err != nil) != tt.wantErr {
 				t.Errorf("pushPayload() error = %v, wantErr %v", err, tt.wantErr)
 				return
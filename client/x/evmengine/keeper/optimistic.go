@@ -0,0 +1,104 @@
+package keeper
+
+import (
+	"context"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"time"
+
+	"github.com/ethereum/go-ethereum/beacon/engine"
+	"github.com/ethereum/go-ethereum/common"
+
+	"github.com/piplabs/story/lib/errors"
+	"github.com/piplabs/story/lib/log"
+)
+
+// optimisticPayload is an optimistically triggered payload build.
+type optimisticPayload struct {
+	ID         engine.PayloadID `json:"id"`
+	Height     uint64           `json:"height"`
+	Version    engineVersion    `json:"version"`
+	ParentHash common.Hash      `json:"parent_hash"` // Execution head the payload is built on top of.
+	UpdatedAt  time.Time        `json:"updated_at"`
+}
+
+// LoadOptimisticPayload restores the optimistic payload persisted to the local payload file before a restart.
+// The payload is only restored if it is built on top of the current execution head, otherwise the file is removed.
+func (k *Keeper) LoadOptimisticPayload(ctx context.Context) error {
+	if k.payloadFile == "" {
+		return nil
+	}
+
+	bz, err := os.ReadFile(k.payloadFile)
+	if errors.Is(err, os.ErrNotExist) {
+		return nil
+	} else if err != nil {
+		return errors.Wrap(err, "read optimistic payload file")
+	}
+
+	var payload optimisticPayload
+	if err := json.Unmarshal(bz, &payload); err != nil {
+		log.Warn(ctx, "Discarding invalid persisted optimistic payload", err)
+		return k.removeOptimisticPayloadFile()
+	}
+
+	// Don't call GetPayload to check whether the EL still knows the payload, since that stops the build.
+	// PrepareProposal builds a new payload if the EL dropped it, e.g. when it restarted as well.
+	head, err := k.getExecutionHead(ctx)
+	if err != nil {
+		return errors.Wrap(err, "get execution head")
+	}
+
+	if payload.ParentHash != head.Hash() || payload.Height <= head.GetCreatedHeight() {
+		log.Warn(ctx, "Discarding stale persisted optimistic payload", nil,
+			"height", payload.Height,
+			"payload", payload.ID.String(),
+			"head_height", head.GetCreatedHeight(),
+		)
+
+		return k.removeOptimisticPayloadFile()
+	}
+
+	k.mutablePayload.Lock()
+	k.mutablePayload.optimisticPayload = payload
+	k.mutablePayload.Unlock()
+
+	log.Info(ctx, "Restored persisted optimistic payload", "height", payload.Height, "payload", payload.ID.String())
+
+	return nil
+}
+
+// persistOptimisticPayload atomically writes the optimistic payload to the local payload file, if configured.
+func (k *Keeper) persistOptimisticPayload(payload optimisticPayload) error {
+	if k.payloadFile == "" {
+		return nil
+	}
+
+	bz, err := json.Marshal(payload)
+	if err != nil {
+		return errors.Wrap(err, "marshal optimistic payload")
+	}
+
+	if err := os.MkdirAll(filepath.Dir(k.payloadFile), 0o755); err != nil {
+		return errors.Wrap(err, "create optimistic payload dir")
+	}
+
+	tmpFile := k.payloadFile + ".tmp"
+	if err := os.WriteFile(tmpFile, bz, 0o600); err != nil {
+		return errors.Wrap(err, "write optimistic payload file")
+	} else if err := os.Rename(tmpFile, k.payloadFile); err != nil {
+		return errors.Wrap(err, "rename optimistic payload file")
+	}
+
+	return nil
+}
+
+// removeOptimisticPayloadFile removes the local payload file, ignoring it if it doesn't exist.
+func (k *Keeper) removeOptimisticPayloadFile() error {
+	if err := os.Remove(k.payloadFile); err != nil && !errors.Is(err, os.ErrNotExist) {
+		return errors.Wrap(err, "remove optimistic payload file")
+	}
+
+	return nil
+}
@@ -0,0 +1,62 @@
+package keeper
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/beacon/engine"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/require"
+)
+
+func TestKeeper_LoadOptimisticPayload(t *testing.T) {
+	t.Parallel()
+	ctx, keeper := createTestKeeper(t)
+	populateGenesisHead(ctx, t, keeper)
+
+	payloadFile := filepath.Join(t.TempDir(), "optimistic_payload.json")
+	keeper.SetOptimisticPayloadFile(payloadFile)
+
+	// A missing payload file is ignored.
+	require.NoError(t, keeper.LoadOptimisticPayload(ctx))
+	require.Equal(t, optimisticPayload{}, keeper.getOptimisticPayload())
+
+	head, err := keeper.getExecutionHead(ctx)
+	require.NoError(t, err)
+
+	require.NoError(t, keeper.setOptimisticPayload(engine.PayloadID{0x1}, 2, engineV3, head.Hash()))
+	require.FileExists(t, payloadFile)
+	expected := keeper.getOptimisticPayload()
+
+	// Simulate a restart, the payload is restored if it is built on top of the execution head.
+	keeper.mutablePayload.optimisticPayload = optimisticPayload{}
+	require.NoError(t, keeper.LoadOptimisticPayload(ctx))
+	actual := keeper.getOptimisticPayload()
+	require.Equal(t, expected.ID, actual.ID)
+	require.Equal(t, expected.Height, actual.Height)
+	require.Equal(t, expected.Version, actual.Version)
+	require.Equal(t, expected.ParentHash, actual.ParentHash)
+	require.True(t, expected.UpdatedAt.Equal(actual.UpdatedAt))
+	require.FileExists(t, payloadFile)
+
+	// A payload built on top of another execution block is discarded.
+	require.NoError(t, keeper.setOptimisticPayload(engine.PayloadID{0x1}, 2, engineV3, common.Hash{0xff}))
+	keeper.mutablePayload.optimisticPayload = optimisticPayload{}
+	require.NoError(t, keeper.LoadOptimisticPayload(ctx))
+	require.Equal(t, optimisticPayload{}, keeper.getOptimisticPayload())
+	require.NoFileExists(t, payloadFile)
+
+	// A payload for a height that isn't after the execution head is discarded.
+	require.NoError(t, keeper.setOptimisticPayload(engine.PayloadID{0x1}, head.GetCreatedHeight(), engineV3, head.Hash()))
+	keeper.mutablePayload.optimisticPayload = optimisticPayload{}
+	require.NoError(t, keeper.LoadOptimisticPayload(ctx))
+	require.Equal(t, optimisticPayload{}, keeper.getOptimisticPayload())
+	require.NoFileExists(t, payloadFile)
+
+	// An invalid payload file is discarded.
+	require.NoError(t, os.WriteFile(payloadFile, []byte("invalid"), 0o600))
+	require.NoError(t, keeper.LoadOptimisticPayload(ctx))
+	require.Equal(t, optimisticPayload{}, keeper.getOptimisticPayload())
+	require.NoFileExists(t, payloadFile)
+}
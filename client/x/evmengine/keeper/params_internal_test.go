@@ -35,7 +35,7 @@ func TestKeeper_GetSetParams(t *testing.T) {
 	// check existing params
 	params, err := keeper.GetParams(ctx)
 	require.NoError(t, err)
-	require.Equal(t, types.DefaultParams(), params, "params should be equal to the default params")
+	require.Equal(t, types.Params{}, params, "params should be empty before genesis")
 
 	// set execution block hash
 	dummyHash := common.HexToHash("0x047e24c3455107d87c68dffa307b3b7fa1877f3e9d7f30c7ee359f2eff3a75d9")
@@ -4,11 +4,13 @@ import (
 	"context"
 	"fmt"
 
-	"github.com/cosmos/gogoproto/proto"
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	etypes "github.com/ethereum/go-ethereum/core/types"
 
 	"github.com/piplabs/story/client/x/evmengine/types"
 	"github.com/piplabs/story/lib/errors"
+	"github.com/piplabs/story/lib/ethclient"
 	"github.com/piplabs/story/lib/log"
 )
 
@@ -24,6 +26,11 @@ func (s proposalServer) ExecutionPayload(ctx context.Context, msg *types.MsgExec
 		return nil, errors.Wrap(err, "unmarshal payload")
 	}
 
+	// Ensure that the payload fees are sent to the fee recipient of the proposer.
+	if err := s.verifyFeeRecipient(ctx, payload.FeeRecipient); err != nil {
+		return nil, errors.Wrap(err, "verify fee recipient")
+	}
+
 	// Ensure that the withdrawals in the payload are from the front indices of the queue.
 	if err := s.compareWithdrawals(ctx, payload.Withdrawals); err != nil {
 		return nil, errors.Wrap(err, "compare local and received withdrawals")
@@ -31,9 +38,11 @@ func (s proposalServer) ExecutionPayload(ctx context.Context, msg *types.MsgExec
 
 	// Push the payload to the EVM.
 	err = retryForever(ctx, func(ctx context.Context) (bool, error) {
-		status, err := pushPayload(ctx, s.engineCl, payload)
-		if err != nil || isUnknown(status) {
-			// We need to retry forever on networking errors, but can't easily identify them, so retry all errors.
+		status, err := s.pushPayload(ctx, payload, msg.ExecutionRequests)
+		if err != nil && !ethclient.IsRetryable(err) {
+			return false, errors.Wrap(err, "push new payload to evm rejected") // Don't retry
+		} else if err != nil || isUnknown(status) {
+			// Retry networking and server errors, and unknown status.
 			log.Warn(ctx, "Verifying proposal failed: push new payload to evm (will retry)", err,
 				"status", status.Status)
 
@@ -53,19 +62,49 @@ func (s proposalServer) ExecutionPayload(ctx context.Context, msg *types.MsgExec
 	}
 
 	// Collect local view of the evm logs from the previous payload.
-	evmEvents, err := s.evmEvents(ctx, payload.ParentHash)
+	localEvents, err := s.cachedEVMEvents(ctx, payload.ParentHash)
 	if err != nil {
 		return nil, errors.Wrap(err, "prepare evm event logs")
 	}
 
+	// Ensure the proposed evm event logs are known and decodable, so none are skipped when delivering them.
+	if params, err := s.GetParams(ctx); err != nil {
+		return nil, errors.Wrap(err, "get params")
+	} else if params.GetVerifyEventLogs() {
+		if err := s.verifyEvents(msg.PrevPayloadEvents); err != nil {
+			return nil, errors.Wrap(err, "verify prev payload events")
+		}
+	}
+
 	// Ensure the proposed evm event logs are equal to the local view.
-	if err := evmEventsEqual(evmEvents, msg.PrevPayloadEvents); err != nil {
+	if err := evmEventsEqual(localEvents, msg.PrevPayloadEvents); err != nil {
 		return nil, errors.Wrap(err, "verify prev payload events")
 	}
 
 	return &types.ExecutionPayloadResponse{}, nil
 }
 
+// AddVotes verifies the vote extensions of the previous block's extended commit included in a proposal.
+// It ensures they are signed by the validators of the last commit and represent more than 2/3 of its voting power.
+func (s proposalServer) AddVotes(ctx context.Context, msg *types.MsgAddVotes) (*types.AddVotesResponse, error) {
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	if err := baseapp.ValidateVoteExtensions(sdkCtx, s.stakingKeeper, 0, "", toExtendedCommitInfo(msg)); err != nil {
+		return nil, errors.Wrap(err, "validate vote extensions")
+	}
+
+	// Ensure the vote extensions are well-formed, so that tallying them in FinalizeBlock succeeds.
+	if _, _, err := s.tallyVotes(msg.Votes); err != nil {
+		return nil, errors.Wrap(err, "tally votes")
+	}
+
+	return &types.AddVotesResponse{}, nil
+}
+
+// UpdateParams always returns an error since params are only updated via x/gov, never included in proposals.
+func (proposalServer) UpdateParams(context.Context, *types.MsgUpdateParams) (*types.MsgUpdateParamsResponse, error) {
+	return nil, errors.New("update params not allowed in proposals")
+}
+
 // NewProposalServer returns an implementation of the MsgServer interface
 // for the provided Keeper.
 func NewProposalServer(keeper *Keeper) types.MsgServiceServer {
@@ -74,22 +113,25 @@ func NewProposalServer(keeper *Keeper) types.MsgServiceServer {
 
 var _ types.MsgServiceServer = proposalServer{}
 
-// TODO: benchmark this function, might be adding to overhead. Esp. the for loop. If so, parallelize since array checks are independent.
-func evmEventsEqual(a, b []*types.EVMEvent) error {
-	if len(a) != len(b) {
-		return errors.New("count mismatch")
+// evmEventsEqual returns an error if the proposed EVM log events differ from the local ones.
+// Only the hash of the proposed events is computed, the hash of the local events is cached.
+func evmEventsEqual(local evmEventsEntry, proposed []*types.EVMEvent) error {
+	if len(local.events) != len(proposed) {
+		return errors.New("count mismatch", "local", len(local.events), "proposed", len(proposed))
 	}
 
-	for i := range a {
-		if !proto.Equal(a[i], b[i]) {
-			return errors.New("log mismatch", "index", i)
-		}
+	hash, err := evmEventsHash(proposed)
+	if err != nil {
+		return err
+	} else if hash != local.hash {
+		return errors.New("log mismatch", "local", local.hash, "proposed", hash)
 	}
 
 	return nil
 }
 
-// compareWithdrawals compares the local peek and received withdrawals.
+// compareWithdrawals compares the local peek and received withdrawals. The indexes of the
+// peeked withdrawals continue the global withdrawal index of x/evmstaking, shared by both queues.
 func (s proposalServer) compareWithdrawals(ctx context.Context, actualWithdrawals etypes.Withdrawals) error {
 	maxWithdrawals, err := s.evmstakingKeeper.MaxWithdrawalPerBlock(ctx)
 	if err != nil {
@@ -109,7 +151,7 @@ func (s proposalServer) compareWithdrawals(ctx context.Context, actualWithdrawal
 	}
 
 	maxRewardWithdrawals := maxWithdrawals - uint32(len(expectedWithdrawals))
-	expectedRewardWithdrawals, err := s.evmstakingKeeper.PeekEligibleRewardWithdrawals(ctx, maxRewardWithdrawals)
+	expectedRewardWithdrawals, err := s.evmstakingKeeper.PeekEligibleRewardWithdrawals(ctx, maxRewardWithdrawals, uint64(len(expectedWithdrawals)))
 	if err != nil {
 		return errors.Wrap(err, "peek reward withdrawals")
 	}
@@ -119,39 +161,31 @@ func (s proposalServer) compareWithdrawals(ctx context.Context, actualWithdrawal
 		"local", expectedTotalWithdrawals,
 		"received", len(actualWithdrawals),
 	)
-	if expectedTotalWithdrawals != len(actualWithdrawals) {
+
+	return withdrawalsEqual(append(expectedWithdrawals, expectedRewardWithdrawals...), actualWithdrawals)
+}
+
+// withdrawalsEqual returns an error if the expected and actual withdrawals differ
+// in length or in any of the index, address and amount fields.
+func withdrawalsEqual(expected, actual etypes.Withdrawals) error {
+	if len(expected) != len(actual) {
 		return fmt.Errorf(
 			"expected total withdrawals %v should equal to actual withdrawals %v",
-			expectedTotalWithdrawals, len(actualWithdrawals),
+			len(expected), len(actual),
 		)
 	}
 
-	pos := 0
-	for i := range expectedWithdrawals {
-		if expectedWithdrawals[i].Index != actualWithdrawals[pos].Index {
-			return errors.New("invalid withdrawal index")
-		}
-		// skip the Validator index equality check (always 0)
-		if expectedWithdrawals[i].Address != actualWithdrawals[pos].Address {
-			return errors.New("invalid withdrawal address")
-		}
-		if expectedWithdrawals[i].Amount != actualWithdrawals[pos].Amount {
-			return errors.New("invalid withdrawal amount")
-		}
-		pos++
-	}
-	for i := range expectedRewardWithdrawals {
-		if expectedRewardWithdrawals[i].Index != actualWithdrawals[pos].Index {
-			return errors.New("invalid withdrawal index")
+	for i := range expected {
+		if expected[i].Index != actual[i].Index {
+			return errors.New("invalid withdrawal index", "pos", i, "expected", expected[i].Index, "actual", actual[i].Index)
 		}
 		// skip the Validator index equality check (always 0)
-		if expectedRewardWithdrawals[i].Address != actualWithdrawals[pos].Address {
-			return errors.New("invalid withdrawal address")
+		if expected[i].Address != actual[i].Address {
+			return errors.New("invalid withdrawal address", "pos", i, "expected", expected[i].Address, "actual", actual[i].Address)
 		}
-		if expectedRewardWithdrawals[i].Amount != actualWithdrawals[pos].Amount {
-			return errors.New("invalid withdrawal amount")
+		if expected[i].Amount != actual[i].Amount {
+			return errors.New("invalid withdrawal amount", "pos", i, "expected", expected[i].Amount, "actual", actual[i].Amount)
 		}
-		pos++
 	}
 
 	return nil
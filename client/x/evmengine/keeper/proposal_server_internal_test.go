@@ -5,6 +5,7 @@ import (
 	"encoding/json"
 	"testing"
 
+	"github.com/cometbft/cometbft/crypto/secp256k1"
 	cmtproto "github.com/cometbft/cometbft/proto/tendermint/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	authtx "github.com/cosmos/cosmos-sdk/x/auth/tx"
@@ -14,11 +15,13 @@ import (
 	etypes "github.com/ethereum/go-ethereum/core/types"
 	"github.com/stretchr/testify/require"
 
+	"github.com/piplabs/story/client/genutil/evm/predeploys"
 	moduletestutil "github.com/piplabs/story/client/x/evmengine/testutil"
 	"github.com/piplabs/story/client/x/evmengine/types"
 	"github.com/piplabs/story/lib/ethclient"
 	"github.com/piplabs/story/lib/ethclient/mock"
 	"github.com/piplabs/story/lib/expbackoff"
+	"github.com/piplabs/story/lib/k1util"
 	"github.com/piplabs/story/lib/tutil"
 
 	"go.uber.org/mock/gomock"
@@ -35,20 +38,30 @@ func Test_proposalServer_ExecutionPayload(t *testing.T) {
 	esk := moduletestutil.NewMockEvmStakingKeeper(ctrl)
 	uk := moduletestutil.NewMockUpgradeKeeper(ctrl)
 	dk := moduletestutil.NewMockDistrKeeper(ctrl)
+	sk := moduletestutil.NewMockStakingKeeper(ctrl)
 	esk.EXPECT().MaxWithdrawalPerBlock(gomock.Any()).Return(uint32(0), nil).AnyTimes()
 	esk.EXPECT().PeekEligibleWithdrawals(gomock.Any(), gomock.Any()).Return(nil, nil).AnyTimes()
-	esk.EXPECT().PeekEligibleRewardWithdrawals(gomock.Any(), gomock.Any()).Return(nil, nil).AnyTimes()
+	esk.EXPECT().PeekEligibleRewardWithdrawals(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil, nil).AnyTimes()
+	esk.EXPECT().GetValidatorFeeRecipient(gomock.Any(), gomock.Any()).Return(common.Address{}, false, nil).AnyTimes()
+
+	// the proposer is this validator, so the payload is built for its derived address
+	valKey := secp256k1.GenPrivKey()
+	valPubKey, err := k1util.PBPubKeyFromBytes(valKey.PubKey().Bytes())
+	require.NoError(t, err)
+	sk.EXPECT().GetPubKeyByConsAddr(gomock.Any(), gomock.Any()).Return(valPubKey, nil).AnyTimes()
 
 	sdkCtx, storeKey, storeService := setupCtxStore(t, &cmtproto.Header{AppHash: tutil.RandomHash().Bytes()})
 	sdkCtx = sdkCtx.WithExecMode(sdk.ExecModeFinalize)
 	mockEngine, err := newMockEngineAPI(storeKey, 0)
 	require.NoError(t, err)
-	keeper, err := NewKeeper(cdc, storeService, &mockEngine, mockClient, txConfig, ak, esk, uk, dk)
+	keeper, err := NewKeeper(cdc, storeService, &mockEngine, mockClient, txConfig, ak, esk, uk, dk, sk, authority)
 	require.NoError(t, err)
 	populateGenesisHead(sdkCtx, t, keeper)
 	propSrv := NewProposalServer(keeper)
 
-	keeper.SetValidatorAddress(common.BytesToAddress([]byte("test")))
+	valAddr, err := k1util.PubKeyToAddress(valKey.PubKey())
+	require.NoError(t, err)
+	keeper.SetValidatorAddress(valAddr)
 
 	var payloadData []byte
 	var payloadID engine.PayloadID
@@ -99,6 +112,85 @@ func Test_proposalServer_ExecutionPayload(t *testing.T) {
 
 	newPayload(sdkCtx)
 	assertExecutionPayload(sdkCtx)
+
+	// Proposals including undecodable events are only rejected by comparing them with the local events,
+	// until the event verification is enabled by the params.
+	undecodable := []*types.EVMEvent{{
+		Address: common.HexToAddress(predeploys.UBIPool).Bytes(),
+		Topics:  [][]byte{types.UBIClaimedEvent.ID.Bytes()},
+	}}
+	newPayload(sdkCtx)
+	_, err = propSrv.ExecutionPayload(sdkCtx, &types.MsgExecutionPayload{
+		Authority:         authtypes.NewModuleAddress(types.ModuleName).String(),
+		ExecutionPayload:  payloadData,
+		PrevPayloadEvents: undecodable,
+	})
+	require.ErrorContains(t, err, "verify prev payload events: count mismatch")
+
+	params, err := keeper.GetParams(sdkCtx)
+	require.NoError(t, err)
+	params.VerifyEventLogs = true
+	require.NoError(t, keeper.SetParams(sdkCtx, params))
+
+	_, err = propSrv.ExecutionPayload(sdkCtx, &types.MsgExecutionPayload{
+		Authority:         authtypes.NewModuleAddress(types.ModuleName).String(),
+		ExecutionPayload:  payloadData,
+		PrevPayloadEvents: undecodable,
+	})
+	require.ErrorContains(t, err, "verify prev payload events: unknown event: undecodable event")
+}
+
+func Test_withdrawalsEqual(t *testing.T) {
+	t.Parallel()
+	addr1 := common.HexToAddress("0x1")
+	addr2 := common.HexToAddress("0x2")
+	withdrawals := etypes.Withdrawals{
+		{Index: 0, Address: addr1, Amount: 100},
+		{Index: 1, Address: addr2, Amount: 200},
+	}
+
+	tcs := []struct {
+		name        string
+		actual      etypes.Withdrawals
+		expectedErr string
+	}{
+		{
+			name:   "pass: equal withdrawals",
+			actual: etypes.Withdrawals{{Index: 0, Address: addr1, Amount: 100}, {Index: 1, Address: addr2, Amount: 200}},
+		},
+		{
+			name:        "fail: count mismatch",
+			actual:      withdrawals[:1],
+			expectedErr: "should equal to actual withdrawals",
+		},
+		{
+			name:        "fail: index mismatch",
+			actual:      etypes.Withdrawals{{Index: 0, Address: addr1, Amount: 100}, {Index: 2, Address: addr2, Amount: 200}},
+			expectedErr: "invalid withdrawal index",
+		},
+		{
+			name:        "fail: address mismatch",
+			actual:      etypes.Withdrawals{{Index: 0, Address: addr2, Amount: 100}, {Index: 1, Address: addr2, Amount: 200}},
+			expectedErr: "invalid withdrawal address",
+		},
+		{
+			name:        "fail: amount mismatch",
+			actual:      etypes.Withdrawals{{Index: 0, Address: addr1, Amount: 100}, {Index: 1, Address: addr2, Amount: 201}},
+			expectedErr: "invalid withdrawal amount",
+		},
+	}
+
+	for _, tc := range tcs {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			err := withdrawalsEqual(withdrawals, tc.actual)
+			if tc.expectedErr != "" {
+				require.ErrorContains(t, err, tc.expectedErr)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
 }
 
 func fastBackoffForT() {
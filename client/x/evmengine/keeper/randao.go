@@ -0,0 +1,125 @@
+package keeper
+
+import (
+	"context"
+
+	cmtproto "github.com/cometbft/cometbft/proto/tendermint/types"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+
+	"github.com/piplabs/story/client/x/evmengine/types"
+	"github.com/piplabs/story/lib/errors"
+)
+
+// randaoForkActive returns true if the vote extension randao fork is active at the given consensus height.
+func (k *Keeper) randaoForkActive(height int64) bool {
+	return k.randaoForkHeight != nil && height >= *k.randaoForkHeight
+}
+
+// prevRandao returns the PrevRandao of the execution payload proposed at the given consensus height
+// on top of the execution head.
+//
+// Before the randao fork, it is the head block hash. From the fork, the head block hash is mixed
+// with the randao mix of the vote extension signatures of the previous extended commits. These are
+// fixed by the committed state, so the proposer of the height can't change the value, but the previous
+// proposers could bias it, see updateRandaoMix. It is less predictable than the head block hash, but it
+// isn't an unbiasable randomness source. The result only depends on committed state, so it is identical
+// in PrepareProposal and ProcessProposal.
+func (k *Keeper) prevRandao(ctx context.Context, height int64, head *ExecutionHead) (common.Hash, error) {
+	if !k.randaoForkActive(height) {
+		return head.Hash(), nil // We use head block hash as randao before the fork.
+	}
+
+	mix, err := k.getRandaoMix(ctx, height)
+	if err != nil {
+		return common.Hash{}, err
+	}
+
+	return crypto.Keccak256Hash(mix.Bytes(), head.Hash().Bytes()), nil
+}
+
+// getRandaoMix returns the randao mix used by the execution payload proposed at the given consensus height,
+// i.e., excluding the commit included at that height, or the zero hash if nothing was mixed yet.
+func (k *Keeper) getRandaoMix(ctx context.Context, height int64) (common.Hash, error) {
+	mix, ok, err := k.loadRandaoMix(ctx)
+	if err != nil {
+		return common.Hash{}, err
+	} else if !ok {
+		return common.Hash{}, nil
+	}
+
+	// The commit included at this height was already mixed, e.g. when the execution payload
+	// is finalized after the votes of the same block.
+	if mix.Height >= uint64(height) {
+		return common.BytesToHash(mix.PrevMix), nil
+	}
+
+	return common.BytesToHash(mix.Mix), nil
+}
+
+// updateRandaoMix mixes the vote extension signatures of the committed votes of the extended commit
+// included at the current height into the randao mix. It is a noop before the randao fork.
+//
+// The randao mix is biasable, it must not be relied on where a bias is profitable. The proposer of the
+// current height chooses which votes of the extended commit to include, as long as they reach a quorum,
+// so it can choose between up to 2^n mixes with n the number of votes beyond the quorum. It can also
+// withhold its proposal, falling back to the mix of the next proposer. The signatures themselves are
+// deterministic, so this is the only choice, and it is limited to the next proposed execution payload.
+// An unbiasable mix requires a threshold signature of the quorum, which the vote extensions don't provide.
+func (k *Keeper) updateRandaoMix(ctx context.Context, height int64, votes []*types.ExtendedVote) error {
+	if !k.randaoForkActive(height) {
+		return nil
+	}
+
+	prevMix, err := k.getRandaoMix(ctx, height)
+	if err != nil {
+		return err
+	}
+
+	data := [][]byte{prevMix.Bytes()}
+	for _, vote := range votes {
+		if cmtproto.BlockIDFlag(vote.BlockIdFlag) != cmtproto.BlockIDFlagCommit {
+			continue
+		}
+		data = append(data, vote.ExtensionSignature)
+	}
+
+	return k.setRandaoMix(ctx, &types.RandaoMix{
+		Height:  uint64(height),
+		Mix:     crypto.Keccak256(data...),
+		PrevMix: prevMix.Bytes(),
+	})
+}
+
+// loadRandaoMix returns the stored randao mix and true, or false if nothing was mixed yet.
+func (k *Keeper) loadRandaoMix(ctx context.Context) (*types.RandaoMix, bool, error) {
+	store := k.storeService.OpenKVStore(ctx)
+	bz, err := store.Get(types.RandaoMixKey)
+	if err != nil {
+		return nil, false, errors.Wrap(err, "get randao mix")
+	} else if bz == nil {
+		return nil, false, nil
+	}
+
+	var mix types.RandaoMix
+	if err := k.cdc.Unmarshal(bz, &mix); err != nil {
+		return nil, false, errors.Wrap(err, "unmarshal randao mix")
+	}
+
+	return &mix, true, nil
+}
+
+// setRandaoMix stores the randao mix.
+func (k *Keeper) setRandaoMix(ctx context.Context, mix *types.RandaoMix) error {
+	bz, err := k.cdc.Marshal(mix)
+	if err != nil {
+		return errors.Wrap(err, "marshal randao mix")
+	}
+
+	store := k.storeService.OpenKVStore(ctx)
+	if err := store.Set(types.RandaoMixKey, bz); err != nil {
+		return errors.Wrap(err, "set randao mix")
+	}
+
+	return nil
+}
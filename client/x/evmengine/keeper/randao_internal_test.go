@@ -0,0 +1,125 @@
+package keeper
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+
+	cmtproto "github.com/cometbft/cometbft/proto/tendermint/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtx "github.com/cosmos/cosmos-sdk/x/auth/tx"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/stretchr/testify/require"
+
+	moduletestutil "github.com/piplabs/story/client/x/evmengine/testutil"
+	"github.com/piplabs/story/client/x/evmengine/types"
+	"github.com/piplabs/story/lib/ethclient"
+	"github.com/piplabs/story/lib/ethclient/mock"
+	"github.com/piplabs/story/lib/tutil"
+
+	"go.uber.org/mock/gomock"
+)
+
+func TestKeeper_prevRandao(t *testing.T) {
+	t.Parallel()
+	ctx, keeper := createTestKeeper(t)
+	populateGenesisHead(ctx, t, keeper)
+
+	head, err := keeper.getExecutionHead(ctx)
+	require.NoError(t, err)
+
+	forkHeight := int64(5)
+	keeper.SetRandaoForkHeight(&forkHeight)
+
+	// The head block hash is used before the fork.
+	random, err := keeper.prevRandao(ctx, forkHeight-1, head)
+	require.NoError(t, err)
+	require.Equal(t, head.Hash(), random)
+
+	// Nothing was mixed at the fork height yet.
+	random, err = keeper.prevRandao(ctx, forkHeight, head)
+	require.NoError(t, err)
+	require.Equal(t, crypto.Keccak256Hash(common.Hash{}.Bytes(), head.Hash().Bytes()), random)
+
+	// Only committed votes are mixed, and the votes of a block don't affect its own randao.
+	sig := tutil.RandomBytes(64)
+	votes := []*types.ExtendedVote{
+		{BlockIdFlag: int32(cmtproto.BlockIDFlagCommit), ExtensionSignature: sig},
+		{BlockIdFlag: int32(cmtproto.BlockIDFlagAbsent), ExtensionSignature: tutil.RandomBytes(64)},
+	}
+	require.NoError(t, keeper.updateRandaoMix(ctx, forkHeight, votes))
+
+	sameHeight, err := keeper.prevRandao(ctx, forkHeight, head)
+	require.NoError(t, err)
+	require.Equal(t, random, sameHeight)
+
+	mix := crypto.Keccak256Hash(common.Hash{}.Bytes(), sig)
+	random, err = keeper.prevRandao(ctx, forkHeight+1, head)
+	require.NoError(t, err)
+	require.Equal(t, crypto.Keccak256Hash(mix.Bytes(), head.Hash().Bytes()), random)
+
+	// The mix isn't updated before the fork.
+	ctx, keeper = createTestKeeper(t)
+	keeper.SetRandaoForkHeight(&forkHeight)
+	require.NoError(t, keeper.updateRandaoMix(ctx, forkHeight-1, votes))
+	bz, err := keeper.storeService.OpenKVStore(ctx).Get(types.RandaoMixKey)
+	require.NoError(t, err)
+	require.Nil(t, bz)
+}
+
+func TestKeeper_randaoFork(t *testing.T) {
+	t.Parallel()
+
+	cdc := getCodec(t)
+	txConfig := authtx.NewTxConfig(cdc, nil)
+	ctrl := gomock.NewController(t)
+	ak := moduletestutil.NewMockAccountKeeper(ctrl)
+	esk := moduletestutil.NewMockEvmStakingKeeper(ctrl)
+	uk := moduletestutil.NewMockUpgradeKeeper(ctrl)
+	dk := moduletestutil.NewMockDistrKeeper(ctrl)
+	sk := moduletestutil.NewMockStakingKeeper(ctrl)
+
+	forkHeight := int64(5)
+	appHash := tutil.RandomHash()
+	blockTime := time.Now()
+	ctx, storeKey, storeService := setupCtxStore(t, &cmtproto.Header{Height: forkHeight, AppHash: appHash.Bytes(), Time: blockTime})
+	engineCl, err := ethclient.NewEngineMock(storeKey)
+	require.NoError(t, err)
+
+	keeper, err := NewKeeper(cdc, storeService, engineCl, mock.NewMockClient(ctrl), txConfig, ak, esk, uk, dk, sk, authority)
+	require.NoError(t, err)
+	keeper.SetRandaoForkHeight(&forkHeight)
+	populateGenesisHead(ctx, t, keeper)
+
+	head, err := keeper.getExecutionHead(ctx)
+	require.NoError(t, err)
+	require.NoError(t, keeper.updateRandaoMix(ctx, forkHeight-1, []*types.ExtendedVote{
+		{BlockIdFlag: int32(cmtproto.BlockIDFlagCommit), ExtensionSignature: tutil.RandomBytes(64)},
+	}))
+
+	// Build the payload proposed at the fork height.
+	fcr, version, err := keeper.startBuild(ctx, forkHeight, common.Address{}, nil, appHash, blockTime)
+	require.NoError(t, err)
+	require.NotNil(t, fcr.PayloadID)
+
+	envelope, _, err := keeper.getPayload(ctx, version, *fcr.PayloadID)
+	require.NoError(t, err)
+	require.NotEqual(t, head.Hash(), envelope.ExecutionPayload.Random)
+
+	expected, err := keeper.prevRandao(ctx, forkHeight, head)
+	require.NoError(t, err)
+	require.Equal(t, expected, envelope.ExecutionPayload.Random)
+
+	payloadData, err := json.Marshal(envelope.ExecutionPayload)
+	require.NoError(t, err)
+	msg := &types.MsgExecutionPayload{ExecutionPayload: payloadData}
+
+	// The payload is verified against the same randao.
+	_, err = keeper.parseAndVerifyProposedPayload(ctx, msg)
+	require.NoError(t, err)
+
+	// The payload is rejected before the fork, when the head block hash is expected.
+	_, err = keeper.parseAndVerifyProposedPayload(sdk.UnwrapSDKContext(ctx).WithBlockHeight(forkHeight-1), msg)
+	require.ErrorContains(t, err, "invalid payload random")
+}
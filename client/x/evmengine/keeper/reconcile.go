@@ -0,0 +1,135 @@
+package keeper
+
+import (
+	"context"
+	"math/big"
+
+	"cosmossdk.io/orm/types/ormerrors"
+
+	"github.com/ethereum/go-ethereum/beacon/engine"
+	etypes "github.com/ethereum/go-ethereum/core/types"
+
+	"github.com/piplabs/story/lib/errors"
+	"github.com/piplabs/story/lib/ethclient"
+	"github.com/piplabs/story/lib/log"
+)
+
+// headStatus is the status of the EL canonical head relative to the CL execution head.
+type headStatus string
+
+const (
+	headInSync headStatus = "in sync" // The EL canonical head is the CL execution head.
+	headAhead  headStatus = "ahead"   // The EL canonical chain extends the CL execution head, e.g., after a CL rollback.
+	headBehind headStatus = "behind"  // The EL canonical head is lower than the CL execution head, e.g., after an EL data wipe.
+	headForked headStatus = "forked"  // The EL canonical chain doesn't include the CL execution head.
+)
+
+// ReconcileExecutionHead compares the EL canonical head with the execution head stored in the
+// committed state and logs a diagnosis if they differ. If update is true, the EL is driven to the
+// CL execution head with a forkchoice update. It must be called on startup before CometBFT starts,
+// so mismatches are detected before they fail consensus deep inside pushPayload.
+func (k *Keeper) ReconcileExecutionHead(ctx context.Context, update bool) error {
+	_, err := k.reconcileExecutionHead(ctx, update)
+	return err
+}
+
+// reconcileExecutionHead reconciles the EL canonical head with the CL execution head,
+// see ReconcileExecutionHead, and returns the resulting status of the EL canonical head.
+func (k *Keeper) reconcileExecutionHead(ctx context.Context, update bool) (headStatus, error) {
+	head, err := k.getExecutionHead(ctx)
+	if ormerrors.IsNotFound(err) {
+		log.Info(ctx, "Skipping execution head reconciliation, no execution head yet")
+		return "", nil
+	} else if err != nil {
+		return "", errors.Wrap(err, "get execution head")
+	}
+
+	status, elHead, err := k.diagnoseHead(ctx, head)
+	if err != nil {
+		return "", errors.Wrap(err, "diagnose execution head")
+	}
+
+	switch {
+	case status == headInSync:
+		log.Info(ctx, "Execution head in sync with EL", headAttrs(head, elHead)...)
+		return status, nil
+	case !update:
+		log.Warn(ctx, "Execution head mismatch: EL is "+string(status)+" (enable evm-reconcile-head to drive the EL to the CL head)", nil, headAttrs(head, elHead)...)
+		return status, nil
+	default:
+		log.Warn(ctx, "Execution head mismatch: EL is "+string(status)+", driving EL to CL head", nil, headAttrs(head, elHead)...)
+	}
+
+	// CometBFT has instant finality, so head/safe/finalized is the CL execution head.
+	fcs := engine.ForkchoiceStateV1{
+		HeadBlockHash:      head.Hash(),
+		SafeBlockHash:      head.Hash(),
+		FinalizedBlockHash: head.Hash(),
+	}
+
+	fcr, err := k.engineCl.ForkchoiceUpdatedV3(ctx, fcs, nil)
+	if err != nil {
+		return "", errors.Wrap(err, "forkchoice update")
+	} else if invalid, err := isInvalid(fcr.PayloadStatus); invalid {
+		return "", errors.Wrap(err, "forkchoice update invalid")
+	} else if isSyncing(fcr.PayloadStatus) {
+		log.Warn(ctx, "EL syncing to CL execution head", nil, headAttrs(head, elHead)...)
+		return status, nil
+	}
+
+	// A VALID forkchoice update doesn't imply the EL moved, e.g., geth ignores updates to an ancestor
+	// of its canonical head. So diagnose the EL canonical head again to log the actual result.
+	status, elHead, err = k.diagnoseHead(ctx, head)
+	if err != nil {
+		return "", errors.Wrap(err, "diagnose execution head")
+	}
+
+	switch status {
+	case headInSync:
+		log.Info(ctx, "EL driven to CL execution head", headAttrs(head, elHead)...)
+	case headAhead:
+		log.Warn(ctx, "EL still ahead of CL execution head, the EL ignores forkchoice updates to canonical ancestors "+
+			"(roll back the EL to the CL head, e.g. with debug_setHead)", nil, headAttrs(head, elHead)...)
+	default:
+		log.Warn(ctx, "EL not driven to CL execution head: EL is "+string(status), nil, headAttrs(head, elHead)...)
+	}
+
+	return status, nil
+}
+
+// headAttrs returns the log attributes of the CL execution head and the EL canonical head.
+func headAttrs(head *ExecutionHead, elHead *etypes.Header) []any {
+	return []any{
+		"cl_height", head.GetBlockHeight(),
+		log.Hex7("cl_hash", head.GetBlockHash()),
+		"el_height", elHead.Number.Uint64(),
+		log.Hex7("el_hash", elHead.Hash().Bytes()),
+	}
+}
+
+// diagnoseHead returns the status and the EL canonical head relative to the CL execution head.
+func (k *Keeper) diagnoseHead(ctx context.Context, head *ExecutionHead) (headStatus, *etypes.Header, error) {
+	elHead, err := k.engineCl.HeaderByType(ctx, ethclient.HeadLatest)
+	if err != nil {
+		return "", nil, errors.Wrap(err, "latest EL header")
+	}
+
+	switch {
+	case elHead.Hash() == head.Hash():
+		return headInSync, elHead, nil
+	case elHead.Number.Uint64() < head.GetBlockHeight():
+		return headBehind, elHead, nil
+	case elHead.Number.Uint64() == head.GetBlockHeight():
+		return headForked, elHead, nil
+	}
+
+	// The EL is higher, ensure its canonical chain includes the CL execution head.
+	canonical, err := k.engineCl.HeaderByNumber(ctx, new(big.Int).SetUint64(head.GetBlockHeight()))
+	if err != nil {
+		return "", nil, errors.Wrap(err, "canonical EL header", "height", head.GetBlockHeight())
+	} else if canonical.Hash() != head.Hash() {
+		return headForked, elHead, nil
+	}
+
+	return headAhead, elHead, nil
+}
@@ -0,0 +1,188 @@
+package keeper
+
+import (
+	"context"
+	"math/big"
+	"slices"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/beacon/engine"
+	etypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/piplabs/story/lib/errors"
+	"github.com/piplabs/story/lib/ethclient"
+)
+
+// reconcileEngine is an engine client that records forkchoice updates. Like geth, it ignores VALID
+// forkchoice updates to a canonical block, and reorgs its canonical chain otherwise.
+type reconcileEngine struct {
+	ethclient.EngineClient
+	canonical []*etypes.Header
+	reorged   []*etypes.Header // Canonical chain after a reorg, unchanged if nil.
+	status    string
+	updates   []engine.ForkchoiceStateV1
+}
+
+func (e *reconcileEngine) HeaderByType(context.Context, ethclient.HeadType) (*etypes.Header, error) {
+	return e.canonical[len(e.canonical)-1], nil
+}
+
+func (e *reconcileEngine) HeaderByNumber(_ context.Context, number *big.Int) (*etypes.Header, error) {
+	if number.Uint64() >= uint64(len(e.canonical)) {
+		return nil, errors.New("not found")
+	}
+
+	return e.canonical[number.Uint64()], nil
+}
+
+func (e *reconcileEngine) ForkchoiceUpdatedV3(_ context.Context, update engine.ForkchoiceStateV1, _ *engine.PayloadAttributes) (engine.ForkChoiceResponse, error) {
+	e.updates = append(e.updates, update)
+
+	isCanonical := slices.ContainsFunc(e.canonical, func(h *etypes.Header) bool { return h.Hash() == update.HeadBlockHash })
+	if e.status == engine.VALID && !isCanonical && e.reorged != nil {
+		e.canonical = e.reorged
+	}
+
+	return engine.ForkChoiceResponse{PayloadStatus: engine.PayloadStatusV1{Status: e.status}}, nil
+}
+
+func TestKeeper_ReconcileExecutionHead(t *testing.T) {
+	t.Parallel()
+
+	chain := func(n int, extra byte) []*etypes.Header {
+		var headers []*etypes.Header
+		for i := range n {
+			headers = append(headers, &etypes.Header{Number: big.NewInt(int64(i)), Extra: []byte{extra}})
+		}
+
+		return headers
+	}
+	clChain := chain(3, 0)
+	clHead := clChain[1]
+
+	tcs := []struct {
+		name        string
+		canonical   []*etypes.Header
+		reorged     []*etypes.Header
+		status      string
+		wantStatus  headStatus
+		finalStatus headStatus
+		expectedErr string
+	}{
+		{
+			name:        "in sync",
+			canonical:   clChain[:2],
+			wantStatus:  headInSync,
+			finalStatus: headInSync,
+		},
+		{
+			name:        "ahead",
+			canonical:   clChain,
+			status:      engine.VALID,
+			wantStatus:  headAhead,
+			finalStatus: headAhead, // The forkchoice update to a canonical ancestor is ignored.
+		},
+		{
+			name:        "behind",
+			canonical:   clChain[:1],
+			status:      engine.SYNCING,
+			wantStatus:  headBehind,
+			finalStatus: headBehind,
+		},
+		{
+			name:        "forked at same height",
+			canonical:   chain(2, 1),
+			reorged:     clChain[:2],
+			status:      engine.VALID,
+			wantStatus:  headForked,
+			finalStatus: headInSync,
+		},
+		{
+			name:        "forked below el head",
+			canonical:   chain(3, 1),
+			reorged:     clChain[:2],
+			status:      engine.VALID,
+			wantStatus:  headForked,
+			finalStatus: headInSync,
+		},
+		{
+			name:        "forked without reorg",
+			canonical:   chain(3, 1),
+			status:      engine.VALID,
+			wantStatus:  headForked,
+			finalStatus: headForked,
+		},
+		{
+			name:        "invalid forkchoice update",
+			canonical:   chain(3, 1),
+			status:      engine.INVALID,
+			wantStatus:  headForked,
+			expectedErr: "forkchoice update invalid",
+		},
+	}
+
+	for _, tc := range tcs {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			ctx, keeper := createTestKeeper(t)
+			engineCl := &reconcileEngine{canonical: tc.canonical, reorged: tc.reorged, status: tc.status}
+			keeper.engineCl = engineCl
+
+			// The reconciliation is skipped without an execution head.
+			require.NoError(t, keeper.ReconcileExecutionHead(ctx, true))
+
+			require.NoError(t, keeper.InsertGenesisHead(ctx, clChain[0].Hash().Bytes()))
+			require.NoError(t, keeper.updateExecutionHead(ctx, engine.ExecutableData{
+				Number:     clHead.Number.Uint64(),
+				ParentHash: clChain[0].Hash(),
+				BlockHash:  clHead.Hash(),
+			}))
+
+			head, err := keeper.getExecutionHead(ctx)
+			require.NoError(t, err)
+			status, _, err := keeper.diagnoseHead(ctx, head)
+			require.NoError(t, err)
+			require.Equal(t, tc.wantStatus, status)
+
+			// The EL is only driven to the CL head if configured to.
+			require.NoError(t, keeper.ReconcileExecutionHead(ctx, false))
+			require.Empty(t, engineCl.updates)
+
+			status, err = keeper.reconcileExecutionHead(ctx, true)
+			if tc.expectedErr != "" {
+				require.ErrorContains(t, err, tc.expectedErr)
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, tc.finalStatus, status)
+			}
+
+			if tc.wantStatus == headInSync {
+				require.Empty(t, engineCl.updates)
+			} else {
+				require.Len(t, engineCl.updates, 1)
+				require.Equal(t, clHead.Hash(), engineCl.updates[0].HeadBlockHash)
+				require.Equal(t, clHead.Hash(), engineCl.updates[0].FinalizedBlockHash)
+			}
+		})
+	}
+}
+
+// failingHeadTable is an execution head table whose reads fail.
+type failingHeadTable struct {
+	ExecutionHeadTable
+}
+
+func (failingHeadTable) Get(context.Context, uint64) (*ExecutionHead, error) {
+	return nil, errors.New("read failed")
+}
+
+func TestKeeper_ReconcileExecutionHead_GetHeadError(t *testing.T) {
+	t.Parallel()
+	ctx, keeper := createTestKeeper(t)
+	keeper.engineCl = &reconcileEngine{}
+	keeper.headTable = failingHeadTable{ExecutionHeadTable: keeper.headTable}
+
+	// Only a missing execution head skips the reconciliation.
+	require.ErrorContains(t, keeper.ReconcileExecutionHead(ctx, true), "read failed")
+}
@@ -3,12 +3,16 @@ package keeper
 
 import (
 	"context"
+	"encoding/hex"
+	"math/big"
 	"strconv"
 
 	"cosmossdk.io/math"
+	"cosmossdk.io/orm/types/ormerrors"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/ethereum/go-ethereum/crypto"
 
 	"github.com/piplabs/story/client/x/evmengine/types"
 	"github.com/piplabs/story/contracts/bindings"
@@ -26,16 +30,35 @@ func (k *Keeper) ProcessUbiEvents(ctx context.Context, height uint64, logs []*ty
 			return err
 		}
 
-		//nolint:gocritic,revive // more cases later
 		switch ethlog.Topics[0] {
 		case types.UBIPercentageSetEvent.ID:
 			ev, err := k.ubiContract.ParseUBIPercentageSet(ethlog)
 			if err != nil {
-				clog.Error(ctx, "Failed to parse UBIPercentageSet log", err)
-				continue
+				return errors.Wrap(err, "parse UBIPercentageSet log")
 			}
 			if err = k.ProcessUBIPercentageSet(ctx, ev); err != nil {
 				clog.Error(ctx, "Failed to process UBI percentage set", err)
+				k.evmstakingKeeper.RecordFailedEvent(ctx, evmLog, types.UBIPercentageSetEvent.Name, ev, err)
+				continue
+			}
+		case types.UBIDistributionSetEvent.ID:
+			ev, err := k.ubiContract.ParseUBIDistributionSet(ethlog)
+			if err != nil {
+				return errors.Wrap(err, "parse UBIDistributionSet log")
+			}
+			if err = k.ProcessUBIDistributionSet(ctx, ev); err != nil {
+				clog.Error(ctx, "Failed to process UBI distribution set", err)
+				k.evmstakingKeeper.RecordFailedEvent(ctx, evmLog, types.UBIDistributionSetEvent.Name, ev, err)
+				continue
+			}
+		case types.UBIClaimedEvent.ID:
+			ev, err := k.ubiContract.ParseUBIClaimed(ethlog)
+			if err != nil {
+				return errors.Wrap(err, "parse UBIClaimed log")
+			}
+			if err = k.ProcessUBIClaimed(ctx, ev); err != nil {
+				clog.Error(ctx, "Failed to process UBI claimed", err)
+				k.evmstakingKeeper.RecordFailedEvent(ctx, evmLog, types.UBIClaimedEvent.Name, ev, err)
 				continue
 			}
 		}
@@ -75,3 +98,150 @@ func (k *Keeper) ProcessUBIPercentageSet(ctx context.Context, ev *bindings.UBIPo
 
 	return nil
 }
+
+// ProcessUBIDistributionSet adds the UBI amounts of a distribution to the accrued UBI of the validators.
+func (k *Keeper) ProcessUBIDistributionSet(ctx context.Context, ev *bindings.UBIPoolUBIDistributionSet) (err error) {
+	if track, err := k.trackValidatorUBI(ctx); err != nil || !track {
+		return err
+	}
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	cachedCtx, writeCache := sdkCtx.CacheContext()
+
+	defer func() {
+		if err == nil {
+			writeCache()
+			return
+		}
+		sdkCtx.EventManager().EmitEvents(sdk.Events{
+			sdk.NewEvent(
+				types.EventTypeUbiDistributionFailure,
+				sdk.NewAttribute(types.AttributeKeyBlockHeight, strconv.FormatInt(sdkCtx.BlockHeight(), 10)),
+				sdk.NewAttribute(types.AttributeKeyUbiDistributionID, ev.Month.String()),
+				sdk.NewAttribute(types.AttributeKeyUbiAmount, ev.TotalUBI.String()),
+				sdk.NewAttribute(types.AttributeKeyStatusCode, errors.UnwrapErrCode(err).String()),
+			),
+		})
+	}()
+
+	if len(ev.ValidatorUncmpPubKeys) != len(ev.Amounts) {
+		return errors.WrapErrWithCode(errors.InvalidRequest, errors.New("validator pubkeys and amounts length mismatch"))
+	}
+
+	// The UBIPool contract ensures the amounts add up to the total UBI, so the accrued UBI stays consistent with it.
+	sum := new(big.Int)
+	for _, amount := range ev.Amounts {
+		sum.Add(sum, amount)
+	}
+	if sum.Cmp(ev.TotalUBI) != 0 {
+		return errors.WrapErrWithCode(errors.InvalidRequest, errors.New("validator amounts don't add up to the total UBI",
+			"total", ev.TotalUBI, "sum", sum))
+	}
+
+	for i, uncmpPubKey := range ev.ValidatorUncmpPubKeys {
+		cmpPubKey, err := uncmpToCmpPubKey(uncmpPubKey)
+		if err != nil {
+			return errors.WrapErrWithCode(errors.InvalidUncmpPubKey, err)
+		}
+
+		if err := k.addValidatorUBI(cachedCtx, cmpPubKey, math.NewIntFromBigInt(ev.Amounts[i]), math.ZeroInt()); err != nil {
+			return errors.Wrap(err, "add accrued validator UBI")
+		}
+	}
+
+	return nil
+}
+
+// ProcessUBIClaimed adds the claimed UBI amount to the claimed UBI of the validator.
+func (k *Keeper) ProcessUBIClaimed(ctx context.Context, ev *bindings.UBIPoolUBIClaimed) (err error) {
+	if track, err := k.trackValidatorUBI(ctx); err != nil || !track {
+		return err
+	}
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	cachedCtx, writeCache := sdkCtx.CacheContext()
+
+	defer func() {
+		if err == nil {
+			writeCache()
+			return
+		}
+		sdkCtx.EventManager().EmitEvents(sdk.Events{
+			sdk.NewEvent(
+				types.EventTypeUbiClaimFailure,
+				sdk.NewAttribute(types.AttributeKeyBlockHeight, strconv.FormatInt(sdkCtx.BlockHeight(), 10)),
+				sdk.NewAttribute(types.AttributeKeyUbiDistributionID, ev.DistributionId.String()),
+				sdk.NewAttribute(types.AttributeKeyValidatorPubKey, hex.EncodeToString(ev.ValidatorUncmpPubkey)),
+				sdk.NewAttribute(types.AttributeKeyUbiAmount, ev.Amount.String()),
+				sdk.NewAttribute(types.AttributeKeyStatusCode, errors.UnwrapErrCode(err).String()),
+			),
+		})
+	}()
+
+	cmpPubKey, err := uncmpToCmpPubKey(ev.ValidatorUncmpPubkey)
+	if err != nil {
+		return errors.WrapErrWithCode(errors.InvalidUncmpPubKey, err)
+	}
+
+	if err := k.addValidatorUBI(cachedCtx, cmpPubKey, math.ZeroInt(), math.NewIntFromBigInt(ev.Amount)); err != nil {
+		return errors.Wrap(err, "add claimed validator UBI")
+	}
+
+	return nil
+}
+
+// trackValidatorUBI returns whether the UBI distributed to and claimed by validators is tracked,
+// see Params.TrackValidatorUbi.
+func (k *Keeper) trackValidatorUBI(ctx context.Context) (bool, error) {
+	params, err := k.GetParams(ctx)
+	if err != nil {
+		return false, errors.Wrap(err, "get params")
+	}
+
+	return params.GetTrackValidatorUbi(), nil
+}
+
+// getValidatorUBI returns the UBI accrued and claimed by the validator, zero if none.
+func (k *Keeper) getValidatorUBI(ctx context.Context, cmpPubKey []byte) (accrued, claimed math.Int, err error) {
+	ubi, err := k.ubiTable.Get(ctx, cmpPubKey)
+	if ormerrors.IsNotFound(err) {
+		return math.ZeroInt(), math.ZeroInt(), nil
+	} else if err != nil {
+		return math.Int{}, math.Int{}, errors.Wrap(err, "get validator UBI")
+	}
+
+	accrued, ok := math.NewIntFromString(ubi.GetAccrued())
+	if !ok {
+		return math.Int{}, math.Int{}, errors.New("invalid accrued UBI", "accrued", ubi.GetAccrued())
+	}
+	claimed, ok = math.NewIntFromString(ubi.GetClaimed())
+	if !ok {
+		return math.Int{}, math.Int{}, errors.New("invalid claimed UBI", "claimed", ubi.GetClaimed())
+	}
+
+	return accrued, claimed, nil
+}
+
+// addValidatorUBI adds the amounts to the UBI accrued and claimed by the validator.
+func (k *Keeper) addValidatorUBI(ctx context.Context, cmpPubKey []byte, accrued, claimed math.Int) error {
+	prevAccrued, prevClaimed, err := k.getValidatorUBI(ctx, cmpPubKey)
+	if err != nil {
+		return err
+	}
+
+	return k.ubiTable.Save(ctx, &ValidatorUBI{
+		ValidatorPubkey: cmpPubKey,
+		Accrued:         prevAccrued.Add(accrued).String(),
+		Claimed:         prevClaimed.Add(claimed).String(),
+	})
+}
+
+// uncmpToCmpPubKey returns the 33 byte compressed public key of the 65 byte uncompressed secp256k1 public key.
+func uncmpToCmpPubKey(uncmpPubKey []byte) ([]byte, error) {
+	pubKey, err := crypto.UnmarshalPubkey(uncmpPubKey)
+	if err != nil {
+		return nil, errors.Wrap(err, "unmarshal uncompressed pubkey")
+	}
+
+	return crypto.CompressPubkey(pubKey), nil
+}
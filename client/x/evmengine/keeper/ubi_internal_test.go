@@ -0,0 +1,163 @@
+package keeper
+
+import (
+	"math/big"
+	"testing"
+
+	"cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+
+	"github.com/piplabs/story/client/genutil/evm/predeploys"
+	moduletestutil "github.com/piplabs/story/client/x/evmengine/testutil"
+	"github.com/piplabs/story/client/x/evmengine/types"
+	"github.com/piplabs/story/contracts/bindings"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestKeeper_ProcessUbiEvents(t *testing.T) {
+	t.Parallel()
+	ctx, keeper := createTestKeeper(t)
+
+	// The failed claim and distribution are recorded in x/evmstaking with their decoded event.
+	esk := moduletestutil.NewMockEvmStakingKeeper(gomock.NewController(t))
+	esk.EXPECT().RecordFailedEvent(gomock.Any(), gomock.Any(), types.UBIClaimedEvent.Name, gomock.Not(gomock.Nil()), gomock.Any()).Times(1)
+	esk.EXPECT().RecordFailedEvent(gomock.Any(), gomock.Any(), types.UBIDistributionSetEvent.Name, gomock.Not(gomock.Nil()), gomock.Any()).Times(1)
+	keeper.evmstakingKeeper = esk
+
+	ubiAbi, err := bindings.UBIPoolMetaData.GetAbi()
+	require.NoError(t, err)
+	ubiAddress := common.HexToAddress(predeploys.UBIPool)
+
+	var uncmpPubKeys [][]byte
+	for range 2 {
+		key, err := crypto.GenerateKey()
+		require.NoError(t, err)
+		uncmpPubKeys = append(uncmpPubKeys, crypto.FromECDSAPub(&key.PublicKey))
+	}
+
+	distributionWithTotal := func(id int64, total int64, amounts ...int64) *types.EVMEvent {
+		var bigAmounts []*big.Int
+		for _, amount := range amounts {
+			bigAmounts = append(bigAmounts, big.NewInt(amount))
+		}
+		data, err := ubiAbi.Events["UBIDistributionSet"].Inputs.NonIndexed().Pack(
+			big.NewInt(id), big.NewInt(total), uncmpPubKeys[:len(amounts)], bigAmounts)
+		require.NoError(t, err)
+
+		return &types.EVMEvent{
+			Address: ubiAddress.Bytes(),
+			Topics:  [][]byte{types.UBIDistributionSetEvent.ID.Bytes()},
+			Data:    data,
+		}
+	}
+	distribution := func(id int64, amounts ...int64) *types.EVMEvent {
+		var total int64
+		for _, amount := range amounts {
+			total += amount
+		}
+
+		return distributionWithTotal(id, total, amounts...)
+	}
+	claim := func(id int64, uncmpPubKey []byte, amount int64) *types.EVMEvent {
+		data, err := ubiAbi.Events["UBIClaimed"].Inputs.NonIndexed().Pack(
+			big.NewInt(id), uncmpPubKey, common.HexToAddress("0x1234"), big.NewInt(amount))
+		require.NoError(t, err)
+
+		return &types.EVMEvent{
+			Address: ubiAddress.Bytes(),
+			Topics:  [][]byte{types.UBIClaimedEvent.ID.Bytes()},
+			Data:    data,
+		}
+	}
+
+	// The validator UBI isn't tracked before enabled by the params.
+	err = keeper.ProcessUbiEvents(ctx, 1, []*types.EVMEvent{
+		distribution(1, 100, 200),
+		claim(1, uncmpPubKeys[0], 100),
+	})
+	require.NoError(t, err)
+	resp, err := keeper.ValidatorUbi(ctx, &types.QueryValidatorUbiRequest{ValidatorPubkey: hexutil.Encode(uncmpPubKeys[0])})
+	require.NoError(t, err)
+	require.True(t, resp.Accrued.IsZero())
+	require.True(t, resp.Claimed.IsZero())
+
+	params, err := keeper.GetParams(ctx)
+	require.NoError(t, err)
+	params.TrackValidatorUbi = true
+	require.NoError(t, keeper.SetParams(ctx, params))
+
+	err = keeper.ProcessUbiEvents(ctx, 1, []*types.EVMEvent{
+		distribution(1, 100, 200),
+		claim(1, uncmpPubKeys[0], 100),
+		distribution(2, 300),
+		claim(1, []byte("invalid pubkey"), 200),  // Failed but continue.
+		distributionWithTotal(3, 1000, 100, 200), // Amounts don't add up to the total, failed but continue.
+	})
+	require.NoError(t, err)
+
+	// The failed claim and distribution emit a failure event.
+	failures := make(map[string]int)
+	for _, ev := range sdk.UnwrapSDKContext(ctx).EventManager().Events() {
+		failures[ev.Type]++
+	}
+	require.Equal(t, 1, failures[types.EventTypeUbiClaimFailure])
+	require.Equal(t, 1, failures[types.EventTypeUbiDistributionFailure])
+
+	tcs := []struct {
+		name    string
+		pubKey  []byte
+		accrued int64
+		claimed int64
+	}{
+		{name: "claimed one of two distributions", pubKey: uncmpPubKeys[0], accrued: 400, claimed: 100},
+		{name: "unclaimed distribution", pubKey: uncmpPubKeys[1], accrued: 200, claimed: 0},
+	}
+
+	for _, tc := range tcs {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			cmpPubKey, err := uncmpToCmpPubKey(tc.pubKey)
+			require.NoError(t, err)
+
+			// The validator can be queried by compressed or uncompressed pubkey.
+			for _, pubKey := range [][]byte{cmpPubKey, tc.pubKey} {
+				resp, err := keeper.ValidatorUbi(ctx, &types.QueryValidatorUbiRequest{ValidatorPubkey: hexutil.Encode(pubKey)})
+				require.NoError(t, err)
+				require.Equal(t, math.NewInt(tc.accrued), resp.Accrued)
+				require.Equal(t, math.NewInt(tc.claimed), resp.Claimed)
+			}
+		})
+	}
+}
+
+func TestKeeper_ValidatorUbi(t *testing.T) {
+	t.Parallel()
+	ctx, keeper := createTestKeeper(t)
+
+	key, err := crypto.GenerateKey()
+	require.NoError(t, err)
+
+	resp, err := keeper.ValidatorUbi(ctx, &types.QueryValidatorUbiRequest{
+		ValidatorPubkey: hexutil.Encode(crypto.CompressPubkey(&key.PublicKey)),
+	})
+	require.NoError(t, err)
+	require.True(t, resp.Accrued.IsZero())
+	require.True(t, resp.Claimed.IsZero())
+
+	_, err = keeper.ValidatorUbi(ctx, &types.QueryValidatorUbiRequest{ValidatorPubkey: "0x1234"})
+	require.Equal(t, codes.InvalidArgument, status.Code(err))
+
+	_, err = keeper.ValidatorUbi(ctx, &types.QueryValidatorUbiRequest{ValidatorPubkey: "not hex"})
+	require.Equal(t, codes.InvalidArgument, status.Code(err))
+
+	_, err = keeper.ValidatorUbi(ctx, nil)
+	require.Equal(t, codes.InvalidArgument, status.Code(err))
+}
@@ -26,16 +26,25 @@ func (k *Keeper) ProcessUpgradeEvents(ctx context.Context, height uint64, logs [
 			return err
 		}
 
-		//nolint:gocritic,revive // more cases later
 		switch ethlog.Topics[0] {
 		case types.SoftwareUpgradeEvent.ID:
 			ev, err := k.upgradeContract.ParseSoftwareUpgrade(ethlog)
 			if err != nil {
-				clog.Error(ctx, "Failed to parse SubmitProposal log", err)
-				continue
+				return errors.Wrap(err, "parse SoftwareUpgrade log")
 			}
 			if err = k.ProcessSoftwareUpgrade(ctx, ev); err != nil {
 				clog.Error(ctx, "Failed to process submit proposal", err)
+				k.evmstakingKeeper.RecordFailedEvent(ctx, evmLog, types.SoftwareUpgradeEvent.Name, ev, err)
+				continue
+			}
+		case types.CancelUpgradeEvent.ID:
+			ev, err := k.upgradeContract.ParseCancelUpgrade(ethlog)
+			if err != nil {
+				return errors.Wrap(err, "parse CancelUpgrade log")
+			}
+			if err = k.ProcessCancelUpgrade(ctx, ev); err != nil {
+				clog.Error(ctx, "Failed to process cancel upgrade", err)
+				k.evmstakingKeeper.RecordFailedEvent(ctx, evmLog, types.CancelUpgradeEvent.Name, ev, err)
 				continue
 			}
 		}
@@ -79,3 +88,29 @@ func (k *Keeper) ProcessSoftwareUpgrade(ctx context.Context, ev *bindings.Upgrad
 
 	return nil
 }
+
+// ProcessCancelUpgrade clears the currently scheduled upgrade plan, if any.
+func (k *Keeper) ProcessCancelUpgrade(ctx context.Context, _ *bindings.UpgradeEntrypointCancelUpgrade) (err error) {
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	cachedCtx, writeCache := sdkCtx.CacheContext()
+
+	defer func() {
+		if err == nil {
+			writeCache()
+			return
+		}
+		sdkCtx.EventManager().EmitEvents(sdk.Events{
+			sdk.NewEvent(
+				types.EventTypeCancelUpgradeFailure,
+				sdk.NewAttribute(types.AttributeKeyBlockHeight, strconv.FormatInt(sdkCtx.BlockHeight(), 10)),
+				sdk.NewAttribute(types.AttributeKeyStatusCode, errors.UnwrapErrCode(err).String()),
+			),
+		})
+	}()
+
+	if err = k.upgradeKeeper.ClearUpgradePlan(cachedCtx); err != nil {
+		return errors.Wrap(err, "process cancel upgrade: clear upgrade plan")
+	}
+
+	return nil
+}
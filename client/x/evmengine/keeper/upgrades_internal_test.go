@@ -3,6 +3,8 @@ package keeper
 import (
 	"testing"
 
+	upgradetypes "cosmossdk.io/x/upgrade/types"
+
 	cmtproto "github.com/cometbft/cometbft/proto/tendermint/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	authtx "github.com/cosmos/cosmos-sdk/x/auth/tx"
@@ -166,6 +168,20 @@ func TestKeeper_ProcessUpgradeEvents(t *testing.T) {
 				uk.EXPECT().ScheduleUpgrade(gomock.Any(), gomock.Any()).Return(nil).Times(2)
 			},
 		},
+		{
+			name: "pass: one valid cancel upgrade event",
+			evmEvents: func() []*types.EVMEvent {
+				return []*types.EVMEvent{
+					{
+						Address: dummyContractAddress.Bytes(),
+						Topics:  [][]byte{types.CancelUpgradeEvent.ID.Bytes()},
+					},
+				}
+			},
+			setupMock: func() {
+				uk.EXPECT().ClearUpgradePlan(gomock.Any()).Return(nil)
+			},
+		},
 		// Failed but pass cases: The following test cases simulate basic error scenarios.
 		// Since a mocked upgrade keeper is used, not all error cases can be tested here.
 		// Comprehensive error testing would require the real upgrade keeper, which is beyond the scope of this unit test.
@@ -206,7 +222,7 @@ func TestKeeper_ProcessUpgradeEvents(t *testing.T) {
 			},
 		},
 		{
-			name: "pass(failed but continue): invalid upgrade event - not an upgrade event, it don't reach ProcessSoftwareUpgrade",
+			name: "fail: undecodable upgrade event, it doesn't reach ProcessSoftwareUpgrade",
 			evmEvents: func() []*types.EVMEvent {
 				return []*types.EVMEvent{
 					{
@@ -215,6 +231,7 @@ func TestKeeper_ProcessUpgradeEvents(t *testing.T) {
 					},
 				}
 			},
+			expectedErr: "parse SoftwareUpgrade log",
 		},
 
 		// Fail case: When given EVMEvent is not valid
@@ -250,6 +267,83 @@ func TestKeeper_ProcessUpgradeEvents(t *testing.T) {
 	}
 }
 
+func TestKeeper_ProcessCancelUpgrade(t *testing.T) {
+	t.Parallel()
+	keeper, ctx, ctrl, uk := setupTestEnvironment(t)
+	t.Cleanup(ctrl.Finish)
+
+	tcs := []struct {
+		name        string
+		setupMock   func()
+		expectedErr string
+	}{
+		{
+			name: "pass: clear upgrade plan",
+			setupMock: func() {
+				uk.EXPECT().ClearUpgradePlan(gomock.Any()).Return(nil)
+			},
+		},
+		{
+			name: "fail: clear upgrade plan",
+			setupMock: func() {
+				uk.EXPECT().ClearUpgradePlan(gomock.Any()).Return(errors.New("store error"))
+			},
+			expectedErr: "clear upgrade plan",
+		},
+	}
+
+	for _, tc := range tcs {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			tc.setupMock()
+			err := keeper.ProcessCancelUpgrade(ctx, &bindings.UpgradeEntrypointCancelUpgrade{})
+			if tc.expectedErr != "" {
+				require.ErrorContains(t, err, tc.expectedErr)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestKeeper_ProcessUpgradeEvents_ScheduleCancelReschedule(t *testing.T) {
+	t.Parallel()
+	keeper, ctx, ctrl, uk := setupTestEnvironment(t)
+	t.Cleanup(ctrl.Finish)
+
+	upgradeAbi, err := bindings.UpgradeEntrypointMetaData.GetAbi()
+	require.NoError(t, err, "failed to load ABI")
+
+	scheduleEvent := func(name string, height int64) *types.EVMEvent {
+		data, err := upgradeAbi.Events["SoftwareUpgrade"].Inputs.NonIndexed().Pack(name, height, "test-info")
+		require.NoError(t, err)
+
+		return &types.EVMEvent{
+			Address: dummyContractAddress.Bytes(),
+			Topics:  [][]byte{types.SoftwareUpgradeEvent.ID.Bytes()},
+			Data:    data,
+		}
+	}
+	cancelEvent := &types.EVMEvent{
+		Address: dummyContractAddress.Bytes(),
+		Topics:  [][]byte{types.CancelUpgradeEvent.ID.Bytes()},
+	}
+
+	// The events must be applied in the order they were emitted, so the re-scheduled plan is the final one.
+	gomock.InOrder(
+		uk.EXPECT().ScheduleUpgrade(gomock.Any(), upgradetypes.Plan{Name: "test-upgrade1", Height: 10, Info: "test-info"}).Return(nil),
+		uk.EXPECT().ClearUpgradePlan(gomock.Any()).Return(nil),
+		uk.EXPECT().ScheduleUpgrade(gomock.Any(), upgradetypes.Plan{Name: "test-upgrade2", Height: 20, Info: "test-info"}).Return(nil),
+	)
+
+	err = keeper.ProcessUpgradeEvents(ctx, 1, []*types.EVMEvent{
+		scheduleEvent("test-upgrade1", 10),
+		cancelEvent,
+		scheduleEvent("test-upgrade2", 20),
+	})
+	require.NoError(t, err)
+}
+
 func setupTestEnvironment(t *testing.T) (*Keeper, sdk.Context, *gomock.Controller, *moduletestutil.MockUpgradeKeeper) {
 	t.Helper()
 	cdc := getCodec(t)
@@ -263,12 +357,14 @@ func setupTestEnvironment(t *testing.T) (*Keeper, sdk.Context, *gomock.Controlle
 	esk := moduletestutil.NewMockEvmStakingKeeper(ctrl)
 	uk := moduletestutil.NewMockUpgradeKeeper(ctrl)
 	dk := moduletestutil.NewMockDistrKeeper(ctrl)
+	sk := moduletestutil.NewMockStakingKeeper(ctrl)
+	esk.EXPECT().RecordFailedEvent(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).AnyTimes()
 
 	ctx, storeKey, storeService := setupCtxStore(t, &header)
 	mockEngine, err := newMockEngineAPI(storeKey, 0)
 	require.NoError(t, err)
 
-	keeper, err := NewKeeper(cdc, storeService, &mockEngine, mockClient, txConfig, ak, esk, uk, dk)
+	keeper, err := NewKeeper(cdc, storeService, &mockEngine, mockClient, txConfig, ak, esk, uk, dk, sk, authority)
 	require.NoError(t, err)
 	keeper.SetCometAPI(cmtAPI)
 	nxtAddr, err := k1util.PubKeyToAddress(cmtAPI.validatorSet.Validators[1].PubKey)
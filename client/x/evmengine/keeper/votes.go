@@ -0,0 +1,258 @@
+package keeper
+
+import (
+	"context"
+	"encoding/json"
+	"strconv"
+
+	abci "github.com/cometbft/cometbft/abci/types"
+	cmtproto "github.com/cometbft/cometbft/proto/tendermint/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	"github.com/ethereum/go-ethereum/beacon/engine"
+	"github.com/ethereum/go-ethereum/common"
+
+	"github.com/piplabs/story/client/x/evmengine/types"
+	"github.com/piplabs/story/lib/errors"
+	"github.com/piplabs/story/lib/log"
+)
+
+var _ types.VoteExtensionProvider = (*Keeper)(nil)
+
+// ExtendVote returns the vote extension of the local validator for the proposed block.
+// It attests to the execution block of the proposed payload, which was verified by ProcessProposal.
+// Blocks without an execution payload (e.g. empty proposals) get an empty vote extension.
+func (k *Keeper) ExtendVote(ctx sdk.Context, req *abci.RequestExtendVote) (*abci.ResponseExtendVote, error) {
+	payload, ok, err := k.proposedPayload(req.Txs)
+	if err != nil {
+		return nil, errors.Wrap(err, "proposed payload")
+	} else if !ok {
+		return &abci.ResponseExtendVote{}, nil
+	}
+
+	bz, err := k.cdc.Marshal(&types.ExecutionVote{
+		BlockNumber: payload.Number,
+		BlockHash:   payload.BlockHash.Bytes(),
+		ParentHash:  payload.ParentHash.Bytes(),
+	})
+	if err != nil {
+		return nil, errors.Wrap(err, "marshal execution vote")
+	}
+
+	log.Debug(ctx, "Extending vote with execution block",
+		"height", req.Height,
+		"block_number", payload.Number,
+		log.Hex7("block_hash", payload.BlockHash.Bytes()),
+	)
+
+	return &abci.ResponseExtendVote{VoteExtension: bz}, nil
+}
+
+// VerifyVoteExtension verifies the vote extension of another validator.
+// Non-empty extensions must attest to the execution block following the current execution head.
+func (k *Keeper) VerifyVoteExtension(ctx sdk.Context, req *abci.RequestVerifyVoteExtension) (
+	*abci.ResponseVerifyVoteExtension, error,
+) {
+	if len(req.VoteExtension) == 0 {
+		return &abci.ResponseVerifyVoteExtension{Status: abci.ResponseVerifyVoteExtension_ACCEPT}, nil
+	}
+
+	if err := k.verifyExecutionVote(ctx, req.VoteExtension); err != nil {
+		log.Warn(ctx, "Rejecting invalid vote extension", err,
+			"height", req.Height,
+			log.Hex7("validator", req.ValidatorAddress),
+		)
+
+		return &abci.ResponseVerifyVoteExtension{Status: abci.ResponseVerifyVoteExtension_REJECT}, nil
+	}
+
+	return &abci.ResponseVerifyVoteExtension{Status: abci.ResponseVerifyVoteExtension_ACCEPT}, nil
+}
+
+// PrepareVotes returns the MsgAddVotes with the vote extensions of the provided extended commit,
+// or no messages if vote extensions were not enabled at the previous height.
+func (k *Keeper) PrepareVotes(ctx context.Context, commit abci.ExtendedCommitInfo) ([]sdk.Msg, error) {
+	if !types.VoteExtensionsEnabled(sdk.UnwrapSDKContext(ctx)) {
+		return nil, nil
+	}
+
+	votes := make([]*types.ExtendedVote, 0, len(commit.Votes))
+	for _, vote := range commit.Votes {
+		votes = append(votes, &types.ExtendedVote{
+			ValidatorAddress:   vote.Validator.Address,
+			Power:              vote.Validator.Power,
+			BlockIdFlag:        int32(vote.BlockIdFlag),
+			VoteExtension:      vote.VoteExtension,
+			ExtensionSignature: vote.ExtensionSignature,
+		})
+	}
+
+	return []sdk.Msg{&types.MsgAddVotes{
+		Authority: authtypes.NewModuleAddress(types.ModuleName).String(),
+		Round:     commit.Round,
+		Votes:     votes,
+	}}, nil
+}
+
+// GetExecutionAttestation returns the latest quorum attestation of an execution block
+// and true, or false if no execution block was attested yet.
+func (k *Keeper) GetExecutionAttestation(ctx context.Context) (*types.ExecutionAttestation, bool, error) {
+	store := k.storeService.OpenKVStore(ctx)
+	bz, err := store.Get(types.ExecutionAttestationKey)
+	if err != nil {
+		return nil, false, errors.Wrap(err, "get execution attestation")
+	} else if bz == nil {
+		return nil, false, nil
+	}
+
+	var attestation types.ExecutionAttestation
+	if err := k.cdc.Unmarshal(bz, &attestation); err != nil {
+		return nil, false, errors.Wrap(err, "unmarshal execution attestation")
+	}
+
+	return &attestation, true, nil
+}
+
+// setExecutionAttestation stores the latest quorum attestation of an execution block and emits its event.
+func (k *Keeper) setExecutionAttestation(ctx context.Context, attestation *types.ExecutionAttestation) error {
+	if err := k.saveExecutionAttestation(ctx, attestation); err != nil {
+		return err
+	}
+
+	sdk.UnwrapSDKContext(ctx).EventManager().EmitEvent(sdk.NewEvent(
+		types.EventTypeExecutionAttestation,
+		sdk.NewAttribute(types.AttributeKeyBlockHeight, strconv.FormatUint(attestation.Height, 10)),
+		sdk.NewAttribute(types.AttributeKeyBlockNumber, strconv.FormatUint(attestation.BlockNumber, 10)),
+		sdk.NewAttribute(types.AttributeKeyBlockHash, common.BytesToHash(attestation.BlockHash).Hex()),
+		sdk.NewAttribute(types.AttributeKeyAttestedPower, strconv.FormatInt(attestation.AttestedPower, 10)),
+		sdk.NewAttribute(types.AttributeKeyTotalPower, strconv.FormatInt(attestation.TotalPower, 10)),
+	))
+
+	return nil
+}
+
+// saveExecutionAttestation stores the latest quorum attestation of an execution block.
+func (k *Keeper) saveExecutionAttestation(ctx context.Context, attestation *types.ExecutionAttestation) error {
+	bz, err := k.cdc.Marshal(attestation)
+	if err != nil {
+		return errors.Wrap(err, "marshal execution attestation")
+	}
+
+	store := k.storeService.OpenKVStore(ctx)
+	if err := store.Set(types.ExecutionAttestationKey, bz); err != nil {
+		return errors.Wrap(err, "set execution attestation")
+	}
+
+	return nil
+}
+
+// proposedPayload returns the execution payload included in the proposed transactions, if any.
+func (k *Keeper) proposedPayload(txs [][]byte) (engine.ExecutableData, bool, error) {
+	for _, rawTX := range txs {
+		tx, err := k.txConfig.TxDecoder()(rawTX)
+		if err != nil {
+			return engine.ExecutableData{}, false, errors.Wrap(err, "decode transaction")
+		}
+
+		for _, msg := range tx.GetMsgs() {
+			payloadMsg, ok := msg.(*types.MsgExecutionPayload)
+			if !ok {
+				continue
+			}
+
+			var payload engine.ExecutableData
+			if err := json.Unmarshal(payloadMsg.ExecutionPayload, &payload); err != nil {
+				return engine.ExecutableData{}, false, errors.Wrap(err, "unmarshal payload")
+			}
+
+			return payload, true, nil
+		}
+	}
+
+	return engine.ExecutableData{}, false, nil
+}
+
+// verifyExecutionVote returns an error if the vote extension isn't a valid
+// attestation of the execution block following the current execution head.
+func (k *Keeper) verifyExecutionVote(ctx context.Context, voteExtension []byte) error {
+	var vote types.ExecutionVote
+	if err := k.cdc.Unmarshal(voteExtension, &vote); err != nil {
+		return errors.Wrap(err, "unmarshal execution vote")
+	} else if err := vote.Verify(); err != nil {
+		return errors.Wrap(err, "verify execution vote")
+	}
+
+	head, err := k.getExecutionHead(ctx)
+	if err != nil {
+		return errors.Wrap(err, "latest execution block")
+	}
+
+	if vote.BlockNumber != head.GetBlockHeight()+1 {
+		return errors.New("invalid vote block number", "vote", vote.BlockNumber, "head", head.GetBlockHeight())
+	} else if common.BytesToHash(vote.ParentHash) != head.Hash() {
+		return errors.New("invalid vote parent hash", "vote", common.BytesToHash(vote.ParentHash), "head", head.Hash())
+	}
+
+	return nil
+}
+
+// tallyVotes returns the execution block attested by more than 2/3 of the voting power of the votes and true,
+// or false if there is no quorum. Only committed votes with a non-empty vote extension attest to a block.
+func (k *Keeper) tallyVotes(votes []*types.ExtendedVote) (*types.ExecutionAttestation, bool, error) {
+	var totalPower int64
+	attested := make(map[common.Hash]*types.ExecutionAttestation)
+	for _, vote := range votes {
+		totalPower += vote.Power
+		if cmtproto.BlockIDFlag(vote.BlockIdFlag) != cmtproto.BlockIDFlagCommit || len(vote.VoteExtension) == 0 {
+			continue
+		}
+
+		var execVote types.ExecutionVote
+		if err := k.cdc.Unmarshal(vote.VoteExtension, &execVote); err != nil {
+			return nil, false, errors.Wrap(err, "unmarshal execution vote")
+		} else if err := execVote.Verify(); err != nil {
+			return nil, false, errors.Wrap(err, "verify execution vote")
+		}
+
+		hash := common.BytesToHash(execVote.BlockHash)
+		if _, ok := attested[hash]; !ok {
+			attested[hash] = &types.ExecutionAttestation{
+				BlockNumber: execVote.BlockNumber,
+				BlockHash:   execVote.BlockHash,
+			}
+		}
+		attested[hash].AttestedPower += vote.Power
+	}
+
+	// At most a single block can be attested by more than 2/3 of the voting power.
+	for _, attestation := range attested {
+		if attestation.AttestedPower*3 > totalPower*2 {
+			attestation.TotalPower = totalPower
+
+			return attestation, true, nil
+		}
+	}
+
+	return nil, false, nil
+}
+
+// toExtendedCommitInfo converts the votes of a MsgAddVotes back to the extended commit info they were prepared from.
+func toExtendedCommitInfo(msg *types.MsgAddVotes) abci.ExtendedCommitInfo {
+	votes := make([]abci.ExtendedVoteInfo, 0, len(msg.Votes))
+	for _, vote := range msg.Votes {
+		votes = append(votes, abci.ExtendedVoteInfo{
+			Validator: abci.Validator{
+				Address: vote.ValidatorAddress,
+				Power:   vote.Power,
+			},
+			VoteExtension:      vote.VoteExtension,
+			ExtensionSignature: vote.ExtensionSignature,
+			BlockIdFlag:        cmtproto.BlockIDFlag(vote.BlockIdFlag),
+		})
+	}
+
+	return abci.ExtendedCommitInfo{
+		Round: msg.Round,
+		Votes: votes,
+	}
+}
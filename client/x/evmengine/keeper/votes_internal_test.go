@@ -0,0 +1,283 @@
+package keeper
+
+import (
+	"encoding/json"
+	"math/big"
+	"testing"
+
+	abci "github.com/cometbft/cometbft/abci/types"
+	cmtproto "github.com/cometbft/cometbft/proto/tendermint/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	"github.com/ethereum/go-ethereum/beacon/engine"
+	"github.com/ethereum/go-ethereum/common"
+	etypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/trie"
+	"github.com/stretchr/testify/require"
+
+	"github.com/piplabs/story/client/x/evmengine/types"
+	"github.com/piplabs/story/lib/tutil"
+)
+
+func TestKeeper_ExtendVerifyVote(t *testing.T) {
+	t.Parallel()
+	ctx, keeper := createTestKeeper(t)
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	populateGenesisHead(ctx, t, keeper)
+
+	head, err := keeper.getExecutionHead(ctx)
+	require.NoError(t, err)
+
+	proposalTx := func(payload engine.ExecutableData) [][]byte {
+		payloadData, err := json.Marshal(payload)
+		require.NoError(t, err)
+		tx, err := keeper.proposalTx(&types.MsgExecutionPayload{
+			Authority:        authtypes.NewModuleAddress(types.ModuleName).String(),
+			ExecutionPayload: payloadData,
+		})
+		require.NoError(t, err)
+
+		return [][]byte{tx}
+	}
+
+	extend := func(txs [][]byte) []byte {
+		resp, err := keeper.ExtendVote(sdkCtx, &abci.RequestExtendVote{Height: 2, Txs: txs})
+		require.NoError(t, err)
+
+		return resp.VoteExtension
+	}
+
+	verify := func(ext []byte) abci.ResponseVerifyVoteExtension_VerifyStatus {
+		resp, err := keeper.VerifyVoteExtension(sdkCtx, &abci.RequestVerifyVoteExtension{Height: 2, VoteExtension: ext})
+		require.NoError(t, err)
+
+		return resp.Status
+	}
+
+	t.Run("payload", func(t *testing.T) {
+		t.Parallel()
+		payload := newTestPayload(t, head.GetBlockHeight()+1, head.Hash())
+		ext := extend(proposalTx(payload))
+
+		var vote types.ExecutionVote
+		require.NoError(t, vote.Unmarshal(ext))
+		require.Equal(t, payload.Number, vote.BlockNumber)
+		require.Equal(t, payload.BlockHash.Bytes(), vote.BlockHash)
+		require.Equal(t, abci.ResponseVerifyVoteExtension_ACCEPT, verify(ext))
+	})
+
+	t.Run("empty block", func(t *testing.T) {
+		t.Parallel()
+		ext := extend(nil)
+		require.Empty(t, ext)
+		require.Equal(t, abci.ResponseVerifyVoteExtension_ACCEPT, verify(ext))
+	})
+
+	t.Run("invalid number", func(t *testing.T) {
+		t.Parallel()
+		ext := extend(proposalTx(newTestPayload(t, head.GetBlockHeight()+2, head.Hash())))
+		require.Equal(t, abci.ResponseVerifyVoteExtension_REJECT, verify(ext))
+	})
+
+	t.Run("invalid parent hash", func(t *testing.T) {
+		t.Parallel()
+		ext := extend(proposalTx(newTestPayload(t, head.GetBlockHeight()+1, tutil.RandomHash())))
+		require.Equal(t, abci.ResponseVerifyVoteExtension_REJECT, verify(ext))
+	})
+
+	t.Run("malformed extension", func(t *testing.T) {
+		t.Parallel()
+		require.Equal(t, abci.ResponseVerifyVoteExtension_REJECT, verify([]byte("invalid")))
+	})
+}
+
+func TestKeeper_PrepareVotes(t *testing.T) {
+	t.Parallel()
+	ctx, keeper := createTestKeeper(t)
+	sdkCtx := sdk.UnwrapSDKContext(ctx).WithBlockHeight(3)
+
+	commit := abci.ExtendedCommitInfo{
+		Round: 1,
+		Votes: []abci.ExtendedVoteInfo{
+			newExtendedVoteInfo(t, 10, cmtproto.BlockIDFlagCommit, voteExtension(t, 1, common.Hash{1})),
+			newExtendedVoteInfo(t, 5, cmtproto.BlockIDFlagAbsent, nil),
+		},
+	}
+
+	// Vote extensions are disabled by default.
+	msgs, err := keeper.PrepareVotes(sdkCtx, commit)
+	require.NoError(t, err)
+	require.Empty(t, msgs)
+
+	// Vote extensions enabled at height 2 are included in proposals from height 3.
+	sdkCtx = sdkCtx.WithConsensusParams(cmtproto.ConsensusParams{
+		Abci: &cmtproto.ABCIParams{VoteExtensionsEnableHeight: 2},
+	})
+	msgs, err = keeper.PrepareVotes(sdkCtx, commit)
+	require.NoError(t, err)
+	require.Len(t, msgs, 1)
+
+	msg, ok := msgs[0].(*types.MsgAddVotes)
+	require.True(t, ok)
+	require.Equal(t, commit, toExtendedCommitInfo(msg))
+}
+
+func TestKeeper_tallyVotes(t *testing.T) {
+	t.Parallel()
+	_, keeper := createTestKeeper(t)
+
+	hash1, hash2 := common.Hash{1}, common.Hash{2}
+	newVote := func(power int64, flag cmtproto.BlockIDFlag, ext []byte) *types.ExtendedVote {
+		return &types.ExtendedVote{
+			ValidatorAddress: tutil.RandomBytes(20),
+			Power:            power,
+			BlockIdFlag:      int32(flag),
+			VoteExtension:    ext,
+		}
+	}
+
+	tcs := []struct {
+		name        string
+		votes       []*types.ExtendedVote
+		expected    *types.ExecutionAttestation
+		expectedErr string
+	}{
+		{
+			name: "quorum",
+			votes: []*types.ExtendedVote{
+				newVote(10, cmtproto.BlockIDFlagCommit, voteExtension(t, 1, hash1)),
+				newVote(10, cmtproto.BlockIDFlagCommit, voteExtension(t, 1, hash1)),
+				newVote(5, cmtproto.BlockIDFlagAbsent, nil),
+				newVote(1, cmtproto.BlockIDFlagCommit, voteExtension(t, 1, hash2)),
+			},
+			expected: &types.ExecutionAttestation{
+				BlockNumber:   1,
+				BlockHash:     hash1.Bytes(),
+				AttestedPower: 20,
+				TotalPower:    26,
+			},
+		},
+		{
+			name: "split votes",
+			votes: []*types.ExtendedVote{
+				newVote(10, cmtproto.BlockIDFlagCommit, voteExtension(t, 1, hash1)),
+				newVote(10, cmtproto.BlockIDFlagCommit, voteExtension(t, 1, hash2)),
+				newVote(10, cmtproto.BlockIDFlagCommit, voteExtension(t, 1, hash1)),
+			},
+		},
+		{
+			name: "empty extensions",
+			votes: []*types.ExtendedVote{
+				newVote(10, cmtproto.BlockIDFlagCommit, nil),
+				newVote(10, cmtproto.BlockIDFlagCommit, voteExtension(t, 1, hash1)),
+				newVote(10, cmtproto.BlockIDFlagCommit, voteExtension(t, 1, hash1)),
+			},
+		},
+		{
+			name: "malformed extension",
+			votes: []*types.ExtendedVote{
+				newVote(10, cmtproto.BlockIDFlagCommit, []byte("invalid")),
+			},
+			expectedErr: "unmarshal execution vote",
+		},
+	}
+
+	for _, tc := range tcs {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			attestation, ok, err := keeper.tallyVotes(tc.votes)
+			if tc.expectedErr != "" {
+				require.ErrorContains(t, err, tc.expectedErr)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.expected != nil, ok)
+			require.Equal(t, tc.expected, attestation)
+		})
+	}
+}
+
+func Test_msgServer_AddVotes(t *testing.T) {
+	t.Parallel()
+	ctx, keeper := createTestKeeper(t)
+	sdkCtx := sdk.UnwrapSDKContext(ctx).WithBlockHeight(5)
+	msgSrv := NewMsgServerImpl(keeper)
+
+	hash := tutil.RandomHash()
+	msg := &types.MsgAddVotes{
+		Authority: authtypes.NewModuleAddress(types.ModuleName).String(),
+		Votes: []*types.ExtendedVote{
+			{Power: 10, BlockIdFlag: int32(cmtproto.BlockIDFlagCommit), VoteExtension: voteExtension(t, 7, hash)},
+			{Power: 4, BlockIdFlag: int32(cmtproto.BlockIDFlagAbsent)},
+		},
+	}
+
+	_, err := msgSrv.AddVotes(sdkCtx, msg)
+	require.ErrorContains(t, err, "only allowed in finalize mode")
+
+	sdkCtx = sdkCtx.WithExecMode(sdk.ExecModeFinalize)
+	_, err = msgSrv.AddVotes(sdkCtx, msg)
+	require.NoError(t, err)
+
+	attestation, ok, err := keeper.GetExecutionAttestation(sdkCtx)
+	require.NoError(t, err)
+	require.True(t, ok)
+	require.Equal(t, &types.ExecutionAttestation{
+		Height:        4,
+		BlockNumber:   7,
+		BlockHash:     hash.Bytes(),
+		AttestedPower: 10,
+		TotalPower:    14,
+	}, attestation)
+	require.Len(t, sdkCtx.EventManager().Events(), 1)
+	require.Equal(t, types.EventTypeExecutionAttestation, sdkCtx.EventManager().Events()[0].Type)
+
+	// Without quorum, the previous attestation is kept.
+	msg.Votes[1].BlockIdFlag = int32(cmtproto.BlockIDFlagCommit)
+	msg.Votes[1].Power = 10
+	_, err = msgSrv.AddVotes(sdkCtx.WithBlockHeight(6), msg)
+	require.NoError(t, err)
+
+	latest, ok, err := keeper.GetExecutionAttestation(sdkCtx)
+	require.NoError(t, err)
+	require.True(t, ok)
+	require.Equal(t, attestation, latest)
+}
+
+// newTestPayload returns a valid execution payload with the given number and parent hash.
+func newTestPayload(t *testing.T, number uint64, parentHash common.Hash) engine.ExecutableData {
+	t.Helper()
+	header := &etypes.Header{
+		Number:     new(big.Int).SetUint64(number),
+		ParentHash: parentHash,
+		Difficulty: big.NewInt(0),
+		BaseFee:    big.NewInt(1),
+	}
+	block := etypes.NewBlock(header, &etypes.Body{Withdrawals: make([]*etypes.Withdrawal, 0)}, nil, trie.NewStackTrie(nil))
+
+	return *engine.BlockToExecutableData(block, big.NewInt(0), nil).ExecutionPayload
+}
+
+// voteExtension returns a marshalled execution vote for the given block.
+func voteExtension(t *testing.T, number uint64, hash common.Hash) []byte {
+	t.Helper()
+	bz, err := (&types.ExecutionVote{
+		BlockNumber: number,
+		BlockHash:   hash.Bytes(),
+		ParentHash:  tutil.RandomHash().Bytes(),
+	}).Marshal()
+	require.NoError(t, err)
+
+	return bz
+}
+
+func newExtendedVoteInfo(t *testing.T, power int64, flag cmtproto.BlockIDFlag, ext []byte) abci.ExtendedVoteInfo {
+	t.Helper()
+
+	return abci.ExtendedVoteInfo{
+		Validator:          abci.Validator{Address: tutil.RandomBytes(20), Power: power},
+		VoteExtension:      ext,
+		ExtensionSignature: tutil.RandomBytes(64),
+		BlockIdFlag:        flag,
+	}
+}
@@ -7,6 +7,8 @@ import (
 
 	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/codec"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
 	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
 
 	"github.com/piplabs/story/client/x/evmengine/keeper"
@@ -37,6 +39,8 @@ type ModuleInputs struct {
 	EvmStakingKeeper types.EvmStakingKeeper
 	UpgradeKeeper    types.UpgradeKeeper
 	DistrKeeper      types.DistrKeeper
+	StakingKeeper    types.StakingKeeper
+	EventProcessors  []types.InjectedEvmEventProcessor
 }
 
 type ModuleOutputs struct {
@@ -48,6 +52,12 @@ type ModuleOutputs struct {
 }
 
 func ProvideModule(in ModuleInputs) (ModuleOutputs, error) {
+	// default to governance authority if not provided
+	authority := authtypes.NewModuleAddress(govtypes.ModuleName)
+	if in.Config.GetAuthority() != "" {
+		authority = authtypes.NewModuleAddressOrBech32Address(in.Config.GetAuthority())
+	}
+
 	k, err := keeper.NewKeeper(
 		in.Cdc,
 		in.StoreService,
@@ -58,11 +68,19 @@ func ProvideModule(in ModuleInputs) (ModuleOutputs, error) {
 		in.EvmStakingKeeper,
 		in.UpgradeKeeper,
 		in.DistrKeeper,
+		in.StakingKeeper,
+		authority.String(),
 	)
 	if err != nil {
 		return ModuleOutputs{}, err
 	}
 
+	for _, proc := range in.EventProcessors {
+		if err := k.AddEventProcessors(proc.EvmEventProcessor); err != nil {
+			return ModuleOutputs{}, err
+		}
+	}
+
 	m := NewAppModule(
 		in.Cdc,
 		k,
@@ -15,7 +15,9 @@ import (
 
 	math "cosmossdk.io/math"
 	types "cosmossdk.io/x/upgrade/types"
+	crypto "github.com/cometbft/cometbft/proto/tendermint/crypto"
 	types0 "github.com/cosmos/cosmos-sdk/types"
+	common "github.com/ethereum/go-ethereum/common"
 	types1 "github.com/ethereum/go-ethereum/core/types"
 	types2 "github.com/piplabs/story/client/x/evmengine/types"
 	bindings "github.com/piplabs/story/contracts/bindings"
@@ -114,6 +116,22 @@ func (mr *MockEvmStakingKeeperMockRecorder) DequeueEligibleWithdrawals(ctx, maxD
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DequeueEligibleWithdrawals", reflect.TypeOf((*MockEvmStakingKeeper)(nil).DequeueEligibleWithdrawals), ctx, maxDequeue)
 }
 
+// GetValidatorFeeRecipient mocks base method.
+func (m *MockEvmStakingKeeper) GetValidatorFeeRecipient(ctx context.Context, validatorEvmAddr common.Address) (common.Address, bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetValidatorFeeRecipient", ctx, validatorEvmAddr)
+	ret0, _ := ret[0].(common.Address)
+	ret1, _ := ret[1].(bool)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GetValidatorFeeRecipient indicates an expected call of GetValidatorFeeRecipient.
+func (mr *MockEvmStakingKeeperMockRecorder) GetValidatorFeeRecipient(ctx, validatorEvmAddr any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetValidatorFeeRecipient", reflect.TypeOf((*MockEvmStakingKeeper)(nil).GetValidatorFeeRecipient), ctx, validatorEvmAddr)
+}
+
 // MaxWithdrawalPerBlock mocks base method.
 func (m *MockEvmStakingKeeper) MaxWithdrawalPerBlock(ctx context.Context) (uint32, error) {
 	m.ctrl.T.Helper()
@@ -160,18 +178,18 @@ func (mr *MockEvmStakingKeeperMockRecorder) ParseWithdrawLog(ethlog any) *gomock
 }
 
 // PeekEligibleRewardWithdrawals mocks base method.
-func (m *MockEvmStakingKeeper) PeekEligibleRewardWithdrawals(ctx context.Context, maxPeek uint32) (types1.Withdrawals, error) {
+func (m *MockEvmStakingKeeper) PeekEligibleRewardWithdrawals(ctx context.Context, maxPeek uint32, indexOffset uint64) (types1.Withdrawals, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "PeekEligibleRewardWithdrawals", ctx, maxPeek)
+	ret := m.ctrl.Call(m, "PeekEligibleRewardWithdrawals", ctx, maxPeek, indexOffset)
 	ret0, _ := ret[0].(types1.Withdrawals)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // PeekEligibleRewardWithdrawals indicates an expected call of PeekEligibleRewardWithdrawals.
-func (mr *MockEvmStakingKeeperMockRecorder) PeekEligibleRewardWithdrawals(ctx, maxPeek any) *gomock.Call {
+func (mr *MockEvmStakingKeeperMockRecorder) PeekEligibleRewardWithdrawals(ctx, maxPeek, indexOffset any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PeekEligibleRewardWithdrawals", reflect.TypeOf((*MockEvmStakingKeeper)(nil).PeekEligibleRewardWithdrawals), ctx, maxPeek)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PeekEligibleRewardWithdrawals", reflect.TypeOf((*MockEvmStakingKeeper)(nil).PeekEligibleRewardWithdrawals), ctx, maxPeek, indexOffset)
 }
 
 // PeekEligibleWithdrawals mocks base method.
@@ -189,18 +207,16 @@ func (mr *MockEvmStakingKeeperMockRecorder) PeekEligibleWithdrawals(ctx, maxPeek
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PeekEligibleWithdrawals", reflect.TypeOf((*MockEvmStakingKeeper)(nil).PeekEligibleWithdrawals), ctx, maxPeek)
 }
 
-// ProcessStakingEvents mocks base method.
-func (m *MockEvmStakingKeeper) ProcessStakingEvents(ctx context.Context, height uint64, logs []*types2.EVMEvent) error {
+// RecordFailedEvent mocks base method.
+func (m *MockEvmStakingKeeper) RecordFailedEvent(ctx context.Context, evmLog *types2.EVMEvent, eventType string, ev any, procErr error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "ProcessStakingEvents", ctx, height, logs)
-	ret0, _ := ret[0].(error)
-	return ret0
+	m.ctrl.Call(m, "RecordFailedEvent", ctx, evmLog, eventType, ev, procErr)
 }
 
-// ProcessStakingEvents indicates an expected call of ProcessStakingEvents.
-func (mr *MockEvmStakingKeeperMockRecorder) ProcessStakingEvents(ctx, height, logs any) *gomock.Call {
+// RecordFailedEvent indicates an expected call of RecordFailedEvent.
+func (mr *MockEvmStakingKeeperMockRecorder) RecordFailedEvent(ctx, evmLog, eventType, ev, procErr any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ProcessStakingEvents", reflect.TypeOf((*MockEvmStakingKeeper)(nil).ProcessStakingEvents), ctx, height, logs)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RecordFailedEvent", reflect.TypeOf((*MockEvmStakingKeeper)(nil).RecordFailedEvent), ctx, evmLog, eventType, ev, procErr)
 }
 
 // MockUpgradeKeeper is a mock of UpgradeKeeper interface.
@@ -227,6 +243,20 @@ func (m *MockUpgradeKeeper) EXPECT() *MockUpgradeKeeperMockRecorder {
 	return m.recorder
 }
 
+// ClearUpgradePlan mocks base method.
+func (m *MockUpgradeKeeper) ClearUpgradePlan(ctx context.Context) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ClearUpgradePlan", ctx)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ClearUpgradePlan indicates an expected call of ClearUpgradePlan.
+func (mr *MockUpgradeKeeperMockRecorder) ClearUpgradePlan(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ClearUpgradePlan", reflect.TypeOf((*MockUpgradeKeeper)(nil).ClearUpgradePlan), ctx)
+}
+
 // ScheduleUpgrade mocks base method.
 func (m *MockUpgradeKeeper) ScheduleUpgrade(ctx context.Context, plan types.Plan) error {
 	m.ctrl.T.Helper()
@@ -265,6 +295,36 @@ func (m *MockDistrKeeper) EXPECT() *MockDistrKeeperMockRecorder {
 	return m.recorder
 }
 
+// GetUbi mocks base method.
+func (m *MockDistrKeeper) GetUbi(ctx context.Context) (math.LegacyDec, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetUbi", ctx)
+	ret0, _ := ret[0].(math.LegacyDec)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetUbi indicates an expected call of GetUbi.
+func (mr *MockDistrKeeperMockRecorder) GetUbi(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUbi", reflect.TypeOf((*MockDistrKeeper)(nil).GetUbi), ctx)
+}
+
+// GetUbiBalanceByDenom mocks base method.
+func (m *MockDistrKeeper) GetUbiBalanceByDenom(ctx context.Context, denom string) (math.Int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetUbiBalanceByDenom", ctx, denom)
+	ret0, _ := ret[0].(math.Int)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetUbiBalanceByDenom indicates an expected call of GetUbiBalanceByDenom.
+func (mr *MockDistrKeeperMockRecorder) GetUbiBalanceByDenom(ctx, denom any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUbiBalanceByDenom", reflect.TypeOf((*MockDistrKeeper)(nil).GetUbiBalanceByDenom), ctx, denom)
+}
+
 // SetUbi mocks base method.
 func (m *MockDistrKeeper) SetUbi(ctx context.Context, newUbi math.LegacyDec) error {
 	m.ctrl.T.Helper()
@@ -278,3 +338,42 @@ func (mr *MockDistrKeeperMockRecorder) SetUbi(ctx, newUbi any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetUbi", reflect.TypeOf((*MockDistrKeeper)(nil).SetUbi), ctx, newUbi)
 }
+
+// MockStakingKeeper is a mock of StakingKeeper interface.
+type MockStakingKeeper struct {
+	ctrl     *gomock.Controller
+	recorder *MockStakingKeeperMockRecorder
+	isgomock struct{}
+}
+
+// MockStakingKeeperMockRecorder is the mock recorder for MockStakingKeeper.
+type MockStakingKeeperMockRecorder struct {
+	mock *MockStakingKeeper
+}
+
+// NewMockStakingKeeper creates a new mock instance.
+func NewMockStakingKeeper(ctrl *gomock.Controller) *MockStakingKeeper {
+	mock := &MockStakingKeeper{ctrl: ctrl}
+	mock.recorder = &MockStakingKeeperMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockStakingKeeper) EXPECT() *MockStakingKeeperMockRecorder {
+	return m.recorder
+}
+
+// GetPubKeyByConsAddr mocks base method.
+func (m *MockStakingKeeper) GetPubKeyByConsAddr(ctx context.Context, consAddr types0.ConsAddress) (crypto.PublicKey, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetPubKeyByConsAddr", ctx, consAddr)
+	ret0, _ := ret[0].(crypto.PublicKey)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetPubKeyByConsAddr indicates an expected call of GetPubKeyByConsAddr.
+func (mr *MockStakingKeeperMockRecorder) GetPubKeyByConsAddr(ctx, consAddr any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPubKeyByConsAddr", reflect.TypeOf((*MockStakingKeeper)(nil).GetPubKeyByConsAddr), ctx, consAddr)
+}
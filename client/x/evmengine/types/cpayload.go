@@ -5,6 +5,7 @@ import (
 
 	abci "github.com/cometbft/cometbft/abci/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/accounts/abi"
 	"github.com/ethereum/go-ethereum/common"
 )
 
@@ -18,14 +19,33 @@ type VoteExtensionProvider interface {
 }
 
 // EvmEventProcessor abstracts logic that processes EVM log events of the
-// previous execution payload (current head) identified by
-// the provided block hash.
+// previous execution payload (current head).
 //
-// EVMEngine calls this during PreparePayload to collect all EVM-log-events to include in
-// the consensus block. It is also called during ProcessPayload to verify the proposed EVM events.
+// EVMEngine fetches the EVM log events of all registered processors' addresses and events during PreparePayload
+// to include in the consensus block, and verifies the proposed EVM events against its local view during
+// ProcessPayload. When the block is finalized, each processor is delivered the events of its own addresses.
 type EvmEventProcessor interface {
+	// Name returns the name of the processor, used for logging.
 	Name() string
-	Prepare(ctx context.Context, blockHash common.Hash) ([]*EVMEvent, error)
+	// Order returns the delivery order of the processor, processors with a lower order are delivered first.
+	// The order decides which state writes of a block land first, so it must be positive and unique among
+	// the processors instead of depending on their registration order.
+	Order() uint32
+	// Addresses returns the contract addresses of the EVM log events to deliver to the processor.
 	Addresses() []common.Address
-	Deliver(ctx context.Context, blockHash common.Hash, log *EVMEvent) error
+	// Events returns the EVM log events delivered to the processor. Only these events are fetched,
+	// and proposals including events that can't be decoded as one of them are rejected.
+	Events() []abi.Event
+	// Deliver processes the EVM log events emitted by the processor's addresses in the execution block
+	// of the provided height, in the order they were emitted.
+	Deliver(ctx context.Context, height uint64, events []*EVMEvent) error
 }
+
+// InjectedEvmEventProcessor wraps an EvmEventProcessor so that modules can provide
+// multiple processors to EVMEngine via depinject.
+type InjectedEvmEventProcessor struct {
+	EvmEventProcessor
+}
+
+// IsManyPerContainerType implements depinject.ManyPerContainerType.
+func (InjectedEvmEventProcessor) IsManyPerContainerType() {}
@@ -2,13 +2,24 @@ package types
 
 // evmstaking module event types.
 const (
-	EventTypeUpgradeFailure   = "upgrade_failure"
-	EventTypeUpdateUbiFailure = "update_ubi_failure"
+	EventTypeUpgradeFailure         = "upgrade_failure"
+	EventTypeCancelUpgradeFailure   = "cancel_upgrade_failure"
+	EventTypeUpdateUbiFailure       = "update_ubi_failure"
+	EventTypeUbiDistributionFailure = "ubi_distribution_failure"
+	EventTypeUbiClaimFailure        = "ubi_claim_failure"
+	EventTypeExecutionAttestation   = "execution_attestation"
 
-	AttributeKeyStatusCode    = "status_code"
-	AttributeKeyBlockHeight   = "block_height"
-	AttributeKeyUpgradeName   = "upgrade_name"
-	AttributeKeyUpgradeHeight = "upgrade_height"
-	AttributeKeyUpgradeInfo   = "upgrade_info"
-	AttributeKeyUbiPercentage = "ubi_percentage"
+	AttributeKeyStatusCode        = "status_code"
+	AttributeKeyBlockHeight       = "block_height"
+	AttributeKeyUpgradeName       = "upgrade_name"
+	AttributeKeyUpgradeHeight     = "upgrade_height"
+	AttributeKeyUpgradeInfo       = "upgrade_info"
+	AttributeKeyUbiPercentage     = "ubi_percentage"
+	AttributeKeyUbiDistributionID = "ubi_distribution_id"
+	AttributeKeyUbiAmount         = "ubi_amount"
+	AttributeKeyValidatorPubKey   = "validator_pubkey"
+	AttributeKeyBlockNumber       = "block_number"
+	AttributeKeyBlockHash         = "block_hash"
+	AttributeKeyAttestedPower     = "attested_power"
+	AttributeKeyTotalPower        = "total_power"
 )
@@ -6,7 +6,9 @@ import (
 	"cosmossdk.io/math"
 	upgradetypes "cosmossdk.io/x/upgrade/types"
 
+	cmtprotocrypto "github.com/cometbft/cometbft/proto/tendermint/crypto"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/common"
 	ethtypes "github.com/ethereum/go-ethereum/core/types"
 
 	"github.com/piplabs/story/contracts/bindings"
@@ -19,18 +21,27 @@ type AccountKeeper interface {
 type EvmStakingKeeper interface {
 	ParseDepositLog(ethlog ethtypes.Log) (*bindings.IPTokenStakingDeposit, error)
 	ParseWithdrawLog(ethlog ethtypes.Log) (*bindings.IPTokenStakingWithdraw, error)
-	ProcessStakingEvents(ctx context.Context, height uint64, logs []*EVMEvent) error
 	MaxWithdrawalPerBlock(ctx context.Context) (uint32, error)
 	DequeueEligibleWithdrawals(ctx context.Context, maxDequeue uint32) (withdrawals ethtypes.Withdrawals, err error)
 	PeekEligibleWithdrawals(ctx context.Context, maxPeek uint32) (withdrawals ethtypes.Withdrawals, err error)
 	DequeueEligibleRewardWithdrawals(ctx context.Context, maxDequeue uint32) (withdrawals ethtypes.Withdrawals, err error)
-	PeekEligibleRewardWithdrawals(ctx context.Context, maxPeek uint32) (withdrawals ethtypes.Withdrawals, err error)
+	PeekEligibleRewardWithdrawals(ctx context.Context, maxPeek uint32, indexOffset uint64) (withdrawals ethtypes.Withdrawals, err error)
+	GetValidatorFeeRecipient(ctx context.Context, validatorEvmAddr common.Address) (common.Address, bool, error)
+	RecordFailedEvent(ctx context.Context, evmLog *EVMEvent, eventType string, ev any, procErr error)
 }
 
 type UpgradeKeeper interface {
 	ScheduleUpgrade(ctx context.Context, plan upgradetypes.Plan) error
+	ClearUpgradePlan(ctx context.Context) error
 }
 
 type DistrKeeper interface {
+	GetUbi(ctx context.Context) (math.LegacyDec, error)
 	SetUbi(ctx context.Context, newUbi math.LegacyDec) error
+	GetUbiBalanceByDenom(ctx context.Context, denom string) (math.Int, error)
+}
+
+// StakingKeeper defines the expected interface of the staking module, used to verify vote extension signatures.
+type StakingKeeper interface {
+	GetPubKeyByConsAddr(ctx context.Context, consAddr sdk.ConsAddress) (cmtprotocrypto.PublicKey, error)
 }
@@ -4,7 +4,9 @@
 package types
 
 import (
+	cosmossdk_io_math "cosmossdk.io/math"
 	fmt "fmt"
+	_ "github.com/cosmos/cosmos-proto"
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	proto "github.com/cosmos/gogoproto/proto"
 	io "io"
@@ -25,6 +27,16 @@ const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
 type GenesisState struct {
 	Params Params `protobuf:"bytes,1,opt,name=params,proto3" json:"params"`
+	// Execution block number of params.execution_block_hash, non-zero when the genesis is exported from a running chain.
+	ExecutionBlockNumber uint64 `protobuf:"varint,2,opt,name=execution_block_number,json=executionBlockNumber,proto3" json:"execution_block_number,omitempty"`
+	// Retained execution head history, ordered by consensus chain height.
+	HeadHistory []ExecutionHeadInfo `protobuf:"bytes,3,rep,name=head_history,json=headHistory,proto3" json:"head_history"`
+	// UBI distributed to and claimed by the validators, ordered by validator public key.
+	ValidatorUbis []ValidatorUBIInfo `protobuf:"bytes,4,rep,name=validator_ubis,json=validatorUbis,proto3" json:"validator_ubis"`
+	// Randao mix of the vote extension signatures, unset if nothing was mixed yet.
+	RandaoMix *RandaoMix `protobuf:"bytes,5,opt,name=randao_mix,json=randaoMix,proto3" json:"randao_mix,omitempty"`
+	// Latest quorum attestation of an execution block, unset if nothing was attested yet.
+	ExecutionAttestation *ExecutionAttestation `protobuf:"bytes,6,opt,name=execution_attestation,json=executionAttestation,proto3" json:"execution_attestation,omitempty"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -67,8 +79,91 @@ func (m *GenesisState) GetParams() Params {
 	return Params{}
 }
 
+func (m *GenesisState) GetExecutionBlockNumber() uint64 {
+	if m != nil {
+		return m.ExecutionBlockNumber
+	}
+	return 0
+}
+
+func (m *GenesisState) GetHeadHistory() []ExecutionHeadInfo {
+	if m != nil {
+		return m.HeadHistory
+	}
+	return nil
+}
+
+func (m *GenesisState) GetValidatorUbis() []ValidatorUBIInfo {
+	if m != nil {
+		return m.ValidatorUbis
+	}
+	return nil
+}
+
+func (m *GenesisState) GetRandaoMix() *RandaoMix {
+	if m != nil {
+		return m.RandaoMix
+	}
+	return nil
+}
+
+func (m *GenesisState) GetExecutionAttestation() *ExecutionAttestation {
+	if m != nil {
+		return m.ExecutionAttestation
+	}
+	return nil
+}
+
+// ValidatorUBIInfo is the UBI distributed to and claimed by a validator on the UBIPool contract.
+type ValidatorUBIInfo struct {
+	ValidatorPubkey []byte                `protobuf:"bytes,1,opt,name=validator_pubkey,json=validatorPubkey,proto3" json:"validator_pubkey,omitempty"`
+	Accrued         cosmossdk_io_math.Int `protobuf:"bytes,2,opt,name=accrued,proto3,customtype=cosmossdk.io/math.Int" json:"accrued"`
+	Claimed         cosmossdk_io_math.Int `protobuf:"bytes,3,opt,name=claimed,proto3,customtype=cosmossdk.io/math.Int" json:"claimed"`
+}
+
+func (m *ValidatorUBIInfo) Reset()         { *m = ValidatorUBIInfo{} }
+func (m *ValidatorUBIInfo) String() string { return proto.CompactTextString(m) }
+func (*ValidatorUBIInfo) ProtoMessage()    {}
+func (*ValidatorUBIInfo) Descriptor() ([]byte, []int) {
+	return fileDescriptor_393029c4e964f2cd, []int{1}
+}
+func (m *ValidatorUBIInfo) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ValidatorUBIInfo) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ValidatorUBIInfo.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ValidatorUBIInfo) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ValidatorUBIInfo.Merge(m, src)
+}
+func (m *ValidatorUBIInfo) XXX_Size() int {
+	return m.Size()
+}
+func (m *ValidatorUBIInfo) XXX_DiscardUnknown() {
+	xxx_messageInfo_ValidatorUBIInfo.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ValidatorUBIInfo proto.InternalMessageInfo
+
+func (m *ValidatorUBIInfo) GetValidatorPubkey() []byte {
+	if m != nil {
+		return m.ValidatorPubkey
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*GenesisState)(nil), "client.x.evmengine.types.GenesisState")
+	proto.RegisterType((*ValidatorUBIInfo)(nil), "client.x.evmengine.types.ValidatorUBIInfo")
 }
 
 func init() {
@@ -76,19 +171,37 @@ func init() {
 }
 
 var fileDescriptor_393029c4e964f2cd = []byte{
-	// 180 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x52, 0x4b, 0xce, 0xc9, 0x4c,
-	0xcd, 0x2b, 0xd1, 0xaf, 0xd0, 0x4f, 0x2d, 0xcb, 0x4d, 0xcd, 0x4b, 0xcf, 0xcc, 0x4b, 0xd5, 0x2f,
-	0xa9, 0x2c, 0x48, 0x2d, 0xd6, 0x4f, 0x4f, 0xcd, 0x4b, 0x2d, 0xce, 0x2c, 0xd6, 0x2b, 0x28, 0xca,
-	0x2f, 0xc9, 0x17, 0x92, 0x80, 0xa8, 0xd3, 0xab, 0xd0, 0x83, 0xab, 0xd3, 0x03, 0xab, 0x93, 0x12,
-	0x49, 0xcf, 0x4f, 0xcf, 0x07, 0x2b, 0xd2, 0x07, 0xb1, 0x20, 0xea, 0xa5, 0x54, 0x71, 0x9a, 0x5b,
-	0x90, 0x58, 0x94, 0x98, 0x0b, 0x35, 0x56, 0xc9, 0x8f, 0x8b, 0xc7, 0x1d, 0x62, 0x4f, 0x70, 0x49,
-	0x62, 0x49, 0xaa, 0x90, 0x1d, 0x17, 0x1b, 0x44, 0x5e, 0x82, 0x51, 0x81, 0x51, 0x83, 0xdb, 0x48,
-	0x41, 0x0f, 0x97, 0xbd, 0x7a, 0x01, 0x60, 0x75, 0x4e, 0x2c, 0x27, 0xee, 0xc9, 0x33, 0x04, 0x41,
-	0x75, 0x39, 0x19, 0x9d, 0x78, 0x24, 0xc7, 0x78, 0xe1, 0x91, 0x1c, 0xe3, 0x83, 0x47, 0x72, 0x8c,
-	0x13, 0x1e, 0xcb, 0x31, 0x5c, 0x78, 0x2c, 0xc7, 0x70, 0xe3, 0xb1, 0x1c, 0x43, 0x94, 0x04, 0x2e,
-	0x07, 0x25, 0xb1, 0x81, 0x9d, 0x62, 0x0c, 0x08, 0x00, 0x00, 0xff, 0xff, 0x30, 0xfa, 0xa6, 0x8e,
-	0x0b, 0x01, 0x00, 0x00,
+	// 478 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x92, 0xdf, 0x8a, 0xd3, 0x40,
+	0x14, 0xc6, 0x1b, 0x5b, 0x2b, 0x3b, 0xad, 0xba, 0x84, 0x5d, 0x89, 0x7b, 0x91, 0x2d, 0xeb, 0x1f,
+	0xaa, 0x8b, 0x13, 0xa8, 0x5e, 0x0b, 0x06, 0x16, 0xb7, 0x17, 0xca, 0x12, 0x5d, 0x05, 0x6f, 0xc2,
+	0x24, 0x39, 0xb6, 0x43, 0x9b, 0x99, 0x3a, 0x33, 0x29, 0xe9, 0x5b, 0xf8, 0x30, 0x3e, 0xc4, 0x82,
+	0x37, 0x8b, 0x57, 0x22, 0xb2, 0x48, 0xfb, 0x22, 0x92, 0x99, 0xa4, 0x2d, 0x42, 0x50, 0xbc, 0x3b,
+	0x39, 0xdf, 0x77, 0x7e, 0x27, 0xe7, 0xcc, 0x41, 0x0f, 0xe3, 0x29, 0x05, 0xa6, 0xbc, 0xdc, 0x83,
+	0x79, 0x0a, 0x6c, 0x44, 0x19, 0x78, 0x6a, 0x31, 0x03, 0xe9, 0x8d, 0x80, 0x81, 0xa4, 0x12, 0xcf,
+	0x04, 0x57, 0xdc, 0x76, 0x8c, 0x0f, 0xe7, 0x78, 0xed, 0xc3, 0xda, 0x77, 0xb0, 0x37, 0xe2, 0x23,
+	0xae, 0x4d, 0x5e, 0x11, 0x19, 0xff, 0xc1, 0xdd, 0x98, 0xcb, 0x94, 0xcb, 0xd0, 0x08, 0xe6, 0xa3,
+	0x94, 0x1e, 0xd4, 0xb6, 0x9c, 0x11, 0x41, 0xd2, 0xca, 0x76, 0xbf, 0xd6, 0xf6, 0x29, 0x03, 0xb1,
+	0xf8, 0xab, 0x6b, 0xce, 0x15, 0x94, 0xac, 0xa3, 0x9f, 0x4d, 0xd4, 0x7d, 0x69, 0xe6, 0x79, 0xa3,
+	0x88, 0x02, 0xfb, 0x39, 0x6a, 0x9b, 0x66, 0x8e, 0xd5, 0xb3, 0xfa, 0x9d, 0x41, 0x0f, 0xd7, 0xcd,
+	0x87, 0xcf, 0xb4, 0xcf, 0x6f, 0x5d, 0x5c, 0x1d, 0x36, 0x82, 0xb2, 0xca, 0x7e, 0x86, 0xee, 0x40,
+	0x0e, 0x71, 0xa6, 0x28, 0x67, 0x61, 0x34, 0xe5, 0xf1, 0x24, 0x64, 0x59, 0x1a, 0x81, 0x70, 0xae,
+	0xf5, 0xac, 0x7e, 0x2b, 0xd8, 0x5b, 0xab, 0x7e, 0x21, 0xbe, 0xd6, 0x9a, 0xfd, 0x16, 0x75, 0xc7,
+	0x40, 0x92, 0x70, 0x4c, 0xa5, 0xe2, 0x62, 0xe1, 0x34, 0x7b, 0xcd, 0x7e, 0x67, 0x70, 0x5c, 0xdf,
+	0xfb, 0xa4, 0xa2, 0x9c, 0x02, 0x49, 0x86, 0xec, 0x23, 0x2f, 0x7f, 0xa3, 0x53, 0x60, 0x4e, 0x0d,
+	0xc5, 0x7e, 0x8f, 0x6e, 0xcd, 0xc9, 0x94, 0x26, 0x44, 0x71, 0x11, 0x66, 0x11, 0x95, 0x4e, 0x4b,
+	0x73, 0x1f, 0xd7, 0x73, 0xdf, 0x55, 0xfe, 0x73, 0x7f, 0xb8, 0x85, 0xbd, 0xb9, 0xe6, 0x9c, 0x47,
+	0x54, 0xda, 0x3e, 0x42, 0x82, 0xb0, 0x84, 0xf0, 0x30, 0xa5, 0xb9, 0x73, 0x5d, 0x2f, 0xea, 0x5e,
+	0x3d, 0x34, 0xd0, 0xde, 0x57, 0x34, 0x0f, 0x76, 0x44, 0x15, 0xda, 0x31, 0xda, 0xdf, 0x2c, 0x8a,
+	0x28, 0x05, 0x52, 0x91, 0x22, 0x76, 0xda, 0x1a, 0x87, 0xff, 0x61, 0xf6, 0x17, 0x9b, 0xaa, 0xad,
+	0xbd, 0x6e, 0x65, 0x8f, 0xbe, 0x5a, 0x68, 0xf7, 0xcf, 0x91, 0xec, 0x47, 0x68, 0x77, 0xb3, 0x96,
+	0x59, 0x16, 0x4d, 0x60, 0xa1, 0x1f, 0xbb, 0x1b, 0xdc, 0x5e, 0xe7, 0xcf, 0x74, 0xda, 0x3e, 0x41,
+	0x37, 0x48, 0x1c, 0x8b, 0x0c, 0x12, 0xfd, 0x7c, 0x3b, 0xfe, 0x71, 0xb1, 0x8e, 0x1f, 0x57, 0x87,
+	0xfb, 0xe6, 0x70, 0x65, 0x32, 0xc1, 0x94, 0x7b, 0x29, 0x51, 0x63, 0x3c, 0x64, 0xea, 0xdb, 0x97,
+	0x27, 0xc8, 0x08, 0xc5, 0x57, 0x50, 0xd5, 0x16, 0x98, 0x78, 0x4a, 0x68, 0x0a, 0x89, 0xd3, 0xfc,
+	0x0f, 0x4c, 0x59, 0xeb, 0x0f, 0x2e, 0x96, 0xae, 0x75, 0xb9, 0x74, 0xad, 0x5f, 0x4b, 0xd7, 0xfa,
+	0xbc, 0x72, 0x1b, 0x97, 0x2b, 0xb7, 0xf1, 0x7d, 0xe5, 0x36, 0x3e, 0x38, 0x75, 0xc7, 0x1e, 0xb5,
+	0xf5, 0x9d, 0x3f, 0xfd, 0x3d, 0x00, 0x44, 0xd5, 0x3d, 0xc4, 0xcf, 0x03, 0x00, 0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -111,6 +224,63 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.ExecutionAttestation != nil {
+		{
+			size, err := m.ExecutionAttestation.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenesis(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x32
+	}
+	if m.RandaoMix != nil {
+		{
+			size, err := m.RandaoMix.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenesis(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.ValidatorUbis) > 0 {
+		for iNdEx := len(m.ValidatorUbis) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ValidatorUbis[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.HeadHistory) > 0 {
+		for iNdEx := len(m.HeadHistory) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.HeadHistory[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if m.ExecutionBlockNumber != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.ExecutionBlockNumber))
+		i--
+		dAtA[i] = 0x10
+	}
 	{
 		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
 		if err != nil {
@@ -124,6 +294,56 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *ValidatorUBIInfo) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ValidatorUBIInfo) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ValidatorUBIInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.Claimed.Size()
+		i -= size
+		if _, err := m.Claimed.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	{
+		size := m.Accrued.Size()
+		i -= size
+		if _, err := m.Accrued.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.ValidatorPubkey) > 0 {
+		i -= len(m.ValidatorPubkey)
+		copy(dAtA[i:], m.ValidatorPubkey)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.ValidatorPubkey)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintGenesis(dAtA []byte, offset int, v uint64) int {
 	offset -= sovGenesis(v)
 	base := offset
@@ -143,6 +363,46 @@ func (m *GenesisState) Size() (n int) {
 	_ = l
 	l = m.Params.Size()
 	n += 1 + l + sovGenesis(uint64(l))
+	if m.ExecutionBlockNumber != 0 {
+		n += 1 + sovGenesis(uint64(m.ExecutionBlockNumber))
+	}
+	if len(m.HeadHistory) > 0 {
+		for _, e := range m.HeadHistory {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.ValidatorUbis) > 0 {
+		for _, e := range m.ValidatorUbis {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if m.RandaoMix != nil {
+		l = m.RandaoMix.Size()
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	if m.ExecutionAttestation != nil {
+		l = m.ExecutionAttestation.Size()
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	return n
+}
+
+func (m *ValidatorUBIInfo) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ValidatorPubkey)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	l = m.Accrued.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	l = m.Claimed.Size()
+	n += 1 + l + sovGenesis(uint64(l))
 	return n
 }
 
@@ -214,6 +474,317 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExecutionBlockNumber", wireType)
+			}
+			m.ExecutionBlockNumber = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ExecutionBlockNumber |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HeadHistory", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.HeadHistory = append(m.HeadHistory, ExecutionHeadInfo{})
+			if err := m.HeadHistory[len(m.HeadHistory)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorUbis", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ValidatorUbis = append(m.ValidatorUbis, ValidatorUBIInfo{})
+			if err := m.ValidatorUbis[len(m.ValidatorUbis)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RandaoMix", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.RandaoMix == nil {
+				m.RandaoMix = &RandaoMix{}
+			}
+			if err := m.RandaoMix.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExecutionAttestation", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ExecutionAttestation == nil {
+				m.ExecutionAttestation = &ExecutionAttestation{}
+			}
+			if err := m.ExecutionAttestation.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ValidatorUBIInfo) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ValidatorUBIInfo: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ValidatorUBIInfo: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorPubkey", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ValidatorPubkey = append(m.ValidatorPubkey[:0], dAtA[iNdEx:postIndex]...)
+			if m.ValidatorPubkey == nil {
+				m.ValidatorPubkey = []byte{}
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Accrued", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Accrued.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Claimed", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Claimed.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
@@ -16,4 +16,7 @@ const (
 // KVStore key prefixes.
 var (
 	ParamsKey = collections.NewPrefix(0)
+	// Prefix 1 is used by the ORM module DB (ExecutionHeadTable).
+	ExecutionAttestationKey = collections.NewPrefix(2)
+	RandaoMixKey            = collections.NewPrefix(3)
 )
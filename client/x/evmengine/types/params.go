@@ -2,22 +2,71 @@ package types
 
 import (
 	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/params"
 
 	"github.com/piplabs/story/lib/errors"
 )
 
+// Params default values.
+const (
+	// DefaultHeadHistoryRetention keeps about three days of execution head history at 2.5s blocks.
+	DefaultHeadHistoryRetention uint64 = 100_000
+
+	// DefaultTargetGasLimit is geth's default --miner.gaslimit.
+	DefaultTargetGasLimit uint64 = 30_000_000
+
+	// DefaultMaxGasLimitDelta allows the EL's own adjustment steps (parent/1024) of gas limits up to about 1 billion.
+	DefaultMaxGasLimitDelta uint64 = 1_000_000
+
+	// DefaultMaxExtraDataLength is the maximum extra data length accepted by geth.
+	DefaultMaxExtraDataLength = params.MaximumExtraDataSize
+)
+
 // NewParams creates a new Params instance.
-func NewParams(executionBlockHash []byte) Params {
+func NewParams(
+	executionBlockHash []byte,
+	headHistoryRetention uint64,
+	targetGasLimit uint64,
+	maxGasLimitDelta uint64,
+	maxExtraDataLength uint64,
+) Params {
 	return Params{
-		ExecutionBlockHash: executionBlockHash,
+		ExecutionBlockHash:   executionBlockHash,
+		HeadHistoryRetention: headHistoryRetention,
+		TargetGasLimit:       targetGasLimit,
+		MaxGasLimitDelta:     maxGasLimitDelta,
+		MaxExtraDataLength:   maxExtraDataLength,
 	}
 }
 
 // DefaultParams returns a default set of parameters.
+// New chains include the positions of EVM events, verify them, track validator UBI, validate the payload
+// limits and prune the execution head history from genesis, existing chains from the v0.13.0 upgrade.
 func DefaultParams() Params {
-	return NewParams(
+	params := NewParams(
 		nil,
+		DefaultHeadHistoryRetention,
+		DefaultTargetGasLimit,
+		DefaultMaxGasLimitDelta,
+		DefaultMaxExtraDataLength,
 	)
+	params.EvmEventPositions = true
+	params.VerifyEventLogs = true
+	params.TrackValidatorUbi = true
+
+	return params
+}
+
+func (p Params) Validate() error {
+	if err := ValidateExecutionBlockHash(p.ExecutionBlockHash); err != nil {
+		return err
+	}
+
+	if err := ValidateGasLimit(p.TargetGasLimit, p.MaxGasLimitDelta); err != nil {
+		return err
+	}
+
+	return ValidateMaxExtraDataLength(p.MaxExtraDataLength)
 }
 
 func ValidateExecutionBlockHash(executionBlockHash []byte) error {
@@ -27,3 +76,27 @@ func ValidateExecutionBlockHash(executionBlockHash []byte) error {
 
 	return nil
 }
+
+func ValidateGasLimit(targetGasLimit uint64, maxGasLimitDelta uint64) error {
+	if targetGasLimit == 0 {
+		return nil // Disabled
+	}
+
+	if targetGasLimit < params.MinGasLimit {
+		return errors.New("target gas limit below minimum", "target", targetGasLimit, "min", params.MinGasLimit)
+	}
+
+	if maxGasLimitDelta == 0 {
+		return errors.New("max gas limit delta must be positive if the target gas limit is set")
+	}
+
+	return nil
+}
+
+func ValidateMaxExtraDataLength(maxExtraDataLength uint64) error {
+	if maxExtraDataLength > params.MaximumExtraDataSize {
+		return errors.New("max extra data length exceeds maximum", "length", maxExtraDataLength, "max", params.MaximumExtraDataSize)
+	}
+
+	return nil
+}
@@ -26,6 +26,25 @@ const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 // Params defines the parameters for the module.
 type Params struct {
 	ExecutionBlockHash []byte `protobuf:"bytes,1,opt,name=execution_block_hash,json=executionBlockHash,proto3" json:"execution_block_hash,omitempty" yaml:"execution_block_hash"`
+	// Number of consensus chain heights for which the finalized execution head is kept, 0 keeps all.
+	HeadHistoryRetention uint64 `protobuf:"varint,2,opt,name=head_history_retention,json=headHistoryRetention,proto3" json:"head_history_retention,omitempty" yaml:"head_history_retention"`
+	// Target gas limit of proposed execution blocks, 0 disables the gas limit validation.
+	// The gas limit is decided by the EL, so proposed gas limits aren't required to converge on it,
+	// they may only move away from it by a single EL adjustment step (parent/1024) per block.
+	TargetGasLimit uint64 `protobuf:"varint,3,opt,name=target_gas_limit,json=targetGasLimit,proto3" json:"target_gas_limit,omitempty" yaml:"target_gas_limit"`
+	// Maximum gas limit adjustment of a proposed execution block from its parent, in either direction.
+	MaxGasLimitDelta uint64 `protobuf:"varint,4,opt,name=max_gas_limit_delta,json=maxGasLimitDelta,proto3" json:"max_gas_limit_delta,omitempty" yaml:"max_gas_limit_delta"`
+	// Maximum extra data length of proposed execution blocks, 0 disables the extra data validation.
+	MaxExtraDataLength uint64 `protobuf:"varint,5,opt,name=max_extra_data_length,json=maxExtraDataLength,proto3" json:"max_extra_data_length,omitempty" yaml:"max_extra_data_length"`
+	// Whether EVM events include their block hash, tx hash and log index, which changes the encoding of proposals.
+	// Disabled on existing chains until the v0.13.0 upgrade, so their blocks are replayed with the original encoding.
+	EvmEventPositions bool `protobuf:"varint,6,opt,name=evm_event_positions,json=evmEventPositions,proto3" json:"evm_event_positions,omitempty" yaml:"evm_event_positions"`
+	// Whether proposals only include the known and decodable EVM events of the event processors.
+	// Disabled on existing chains until the v0.13.0 upgrade, since their blocks include other contract logs.
+	VerifyEventLogs bool `protobuf:"varint,7,opt,name=verify_event_logs,json=verifyEventLogs,proto3" json:"verify_event_logs,omitempty" yaml:"verify_event_logs"`
+	// Whether the UBI distributed to and claimed by validators on the UBIPool contract is tracked.
+	// Disabled on existing chains until the v0.13.0 upgrade, since tracking writes new state in block finalization.
+	TrackValidatorUbi bool `protobuf:"varint,8,opt,name=track_validator_ubi,json=trackValidatorUbi,proto3" json:"track_validator_ubi,omitempty" yaml:"track_validator_ubi"`
 }
 
 func (m *Params) Reset()         { *m = Params{} }
@@ -68,6 +87,55 @@ func (m *Params) GetExecutionBlockHash() []byte {
 	return nil
 }
 
+func (m *Params) GetHeadHistoryRetention() uint64 {
+	if m != nil {
+		return m.HeadHistoryRetention
+	}
+	return 0
+}
+
+func (m *Params) GetTargetGasLimit() uint64 {
+	if m != nil {
+		return m.TargetGasLimit
+	}
+	return 0
+}
+
+func (m *Params) GetMaxGasLimitDelta() uint64 {
+	if m != nil {
+		return m.MaxGasLimitDelta
+	}
+	return 0
+}
+
+func (m *Params) GetMaxExtraDataLength() uint64 {
+	if m != nil {
+		return m.MaxExtraDataLength
+	}
+	return 0
+}
+
+func (m *Params) GetEvmEventPositions() bool {
+	if m != nil {
+		return m.EvmEventPositions
+	}
+	return false
+}
+
+func (m *Params) GetVerifyEventLogs() bool {
+	if m != nil {
+		return m.VerifyEventLogs
+	}
+	return false
+}
+
+func (m *Params) GetTrackValidatorUbi() bool {
+	if m != nil {
+		return m.TrackValidatorUbi
+	}
+	return false
+}
+
 func init() {
 	proto.RegisterType((*Params)(nil), "client.x.evmengine.types.Params")
 }
@@ -77,19 +145,36 @@ func init() {
 }
 
 var fileDescriptor_45d874549062308c = []byte{
-	// 188 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x52, 0x4d, 0xce, 0xc9, 0x4c,
-	0xcd, 0x2b, 0xd1, 0xaf, 0xd0, 0x4f, 0x2d, 0xcb, 0x4d, 0xcd, 0x4b, 0xcf, 0xcc, 0x4b, 0xd5, 0x2f,
-	0xa9, 0x2c, 0x48, 0x2d, 0xd6, 0x2f, 0x48, 0x2c, 0x4a, 0xcc, 0x2d, 0xd6, 0x2b, 0x28, 0xca, 0x2f,
-	0xc9, 0x17, 0x92, 0x80, 0x28, 0xd3, 0xab, 0xd0, 0x83, 0x2b, 0xd3, 0x03, 0x2b, 0x93, 0x12, 0x49,
-	0xcf, 0x4f, 0xcf, 0x07, 0x2b, 0xd2, 0x07, 0xb1, 0x20, 0xea, 0x95, 0xa2, 0xb9, 0xd8, 0x02, 0xc0,
-	0xfa, 0x85, 0x02, 0xb9, 0x44, 0x52, 0x2b, 0x52, 0x93, 0x4b, 0x4b, 0x32, 0xf3, 0xf3, 0xe2, 0x93,
-	0x72, 0xf2, 0x93, 0xb3, 0xe3, 0x33, 0x12, 0x8b, 0x33, 0x24, 0x18, 0x15, 0x18, 0x35, 0x78, 0x9c,
-	0xe4, 0x3f, 0xdd, 0x93, 0x97, 0xae, 0x4c, 0xcc, 0xcd, 0xb1, 0x52, 0xc2, 0xa6, 0x4a, 0x29, 0x48,
-	0x08, 0x2e, 0xec, 0x04, 0x12, 0xf5, 0x48, 0x2c, 0xce, 0x70, 0x32, 0x3a, 0xf1, 0x48, 0x8e, 0xf1,
-	0xc2, 0x23, 0x39, 0xc6, 0x07, 0x8f, 0xe4, 0x18, 0x27, 0x3c, 0x96, 0x63, 0xb8, 0xf0, 0x58, 0x8e,
-	0xe1, 0xc6, 0x63, 0x39, 0x86, 0x28, 0x09, 0x5c, 0xbe, 0x49, 0x62, 0x03, 0xbb, 0xcb, 0x18, 0x10,
-	0x00, 0x00, 0xff, 0xff, 0x94, 0x06, 0xfa, 0x5b, 0xf0, 0x00, 0x00, 0x00,
+	// 457 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x74, 0x92, 0xc1, 0x8a, 0xd3, 0x40,
+	0x18, 0xc7, 0x1b, 0xdd, 0xad, 0x4b, 0x10, 0xdd, 0xce, 0x56, 0x1d, 0x76, 0xd7, 0xa4, 0x0e, 0x08,
+	0x3d, 0x35, 0xa0, 0x37, 0x8f, 0x65, 0x8b, 0x3d, 0x54, 0x59, 0x23, 0x2a, 0x78, 0x19, 0xbe, 0xb6,
+	0x9f, 0xc9, 0xb0, 0x49, 0xa6, 0x64, 0x66, 0x43, 0xfa, 0x08, 0xde, 0x7c, 0x2c, 0x8f, 0x7b, 0xf4,
+	0x14, 0xa4, 0x7d, 0x83, 0x3c, 0x81, 0xcc, 0xc4, 0xee, 0xca, 0x6e, 0xbc, 0x85, 0x5f, 0x7e, 0xfc,
+	0xf8, 0x33, 0x7c, 0xee, 0xcb, 0x45, 0x22, 0x30, 0xd3, 0x41, 0x19, 0x60, 0x91, 0x62, 0x16, 0x89,
+	0x0c, 0x03, 0xbd, 0x5e, 0xa1, 0x0a, 0x56, 0x90, 0x43, 0xaa, 0x46, 0xab, 0x5c, 0x6a, 0x49, 0x68,
+	0xa3, 0x8d, 0xca, 0xd1, 0xb5, 0x36, 0xb2, 0xda, 0x71, 0x3f, 0x92, 0x91, 0xb4, 0x52, 0x60, 0xbe,
+	0x1a, 0x9f, 0x7d, 0xdf, 0x77, 0xbb, 0xe7, 0x36, 0x40, 0x3e, 0xb8, 0x7d, 0x2c, 0x71, 0x71, 0xa9,
+	0x85, 0xcc, 0xf8, 0x3c, 0x91, 0x8b, 0x0b, 0x1e, 0x83, 0x8a, 0xa9, 0x33, 0x70, 0x86, 0x0f, 0xc7,
+	0x7e, 0x5d, 0xf9, 0x27, 0x6b, 0x48, 0x93, 0x37, 0xac, 0xcd, 0x62, 0x21, 0xb9, 0xc6, 0x63, 0x43,
+	0xa7, 0xa0, 0x62, 0xf2, 0xc5, 0x7d, 0x1a, 0x23, 0x2c, 0x79, 0x2c, 0x94, 0x96, 0xf9, 0x9a, 0xe7,
+	0xa8, 0x31, 0x33, 0x0a, 0xbd, 0x37, 0x70, 0x86, 0x7b, 0xe3, 0x17, 0x75, 0xe5, 0x3f, 0x6f, 0xa2,
+	0xed, 0x1e, 0x0b, 0xfb, 0xe6, 0xc7, 0xb4, 0xe1, 0xe1, 0x0e, 0x93, 0x89, 0x7b, 0xa8, 0x21, 0x8f,
+	0x50, 0xf3, 0x08, 0x14, 0x4f, 0x44, 0x2a, 0x34, 0xbd, 0x6f, 0x93, 0x27, 0x75, 0xe5, 0x3f, 0x6b,
+	0x92, 0xb7, 0x0d, 0x16, 0x3e, 0x6a, 0xd0, 0x5b, 0x50, 0x33, 0x03, 0xc8, 0x3b, 0xf7, 0x28, 0x85,
+	0xf2, 0xc6, 0xe0, 0x4b, 0x4c, 0x34, 0xd0, 0x3d, 0x5b, 0xf2, 0xea, 0xca, 0x3f, 0x6e, 0x4a, 0x2d,
+	0x12, 0x0b, 0x0f, 0x53, 0x28, 0x77, 0xa5, 0x33, 0x83, 0xc8, 0x47, 0xf7, 0x89, 0x31, 0xb1, 0xd4,
+	0x39, 0xf0, 0x25, 0x68, 0xe0, 0x09, 0x66, 0x91, 0x8e, 0xe9, 0xbe, 0x0d, 0x0e, 0xea, 0xca, 0x3f,
+	0xbd, 0x09, 0xde, 0xd1, 0x58, 0x48, 0x52, 0x28, 0x27, 0x06, 0x9f, 0x81, 0x86, 0x99, 0x85, 0xe4,
+	0xbd, 0x7b, 0x84, 0x45, 0xca, 0xb1, 0xc0, 0x4c, 0xf3, 0x95, 0x54, 0xc2, 0x3c, 0x80, 0xa2, 0xdd,
+	0x81, 0x33, 0x3c, 0xf8, 0x77, 0x63, 0x8b, 0xc4, 0xc2, 0x1e, 0x16, 0xe9, 0xc4, 0xc0, 0xf3, 0x1d,
+	0x23, 0x53, 0xb7, 0x57, 0x60, 0x2e, 0xbe, 0xad, 0xff, 0xda, 0x89, 0x8c, 0x14, 0x7d, 0x60, 0x6b,
+	0xa7, 0x75, 0xe5, 0xd3, 0xa6, 0x76, 0x47, 0x61, 0xe1, 0xe3, 0x86, 0xd9, 0xdc, 0x4c, 0x46, 0xca,
+	0x2c, 0xd3, 0x39, 0x2c, 0x2e, 0x78, 0x01, 0x89, 0x58, 0x82, 0x96, 0x39, 0xbf, 0x9c, 0x0b, 0x7a,
+	0x70, 0x7b, 0x59, 0x8b, 0xc4, 0xc2, 0x9e, 0xa5, 0x9f, 0x77, 0xf0, 0xd3, 0x5c, 0x8c, 0x5f, 0xfd,
+	0xdc, 0x78, 0xce, 0xd5, 0xc6, 0x73, 0x7e, 0x6f, 0x3c, 0xe7, 0xc7, 0xd6, 0xeb, 0x5c, 0x6d, 0xbd,
+	0xce, 0xaf, 0xad, 0xd7, 0xf9, 0x4a, 0xff, 0x77, 0xfc, 0xf3, 0xae, 0x3d, 0xe3, 0xd7, 0x7f, 0x06,
+	0x00, 0xbd, 0x45, 0x90, 0xda, 0x1f, 0x03, 0x00, 0x00,
 }
 
 func (m *Params) Marshal() (dAtA []byte, err error) {
@@ -112,6 +197,56 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.TrackValidatorUbi {
+		i--
+		if m.TrackValidatorUbi {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x40
+	}
+	if m.VerifyEventLogs {
+		i--
+		if m.VerifyEventLogs {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x38
+	}
+	if m.EvmEventPositions {
+		i--
+		if m.EvmEventPositions {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.MaxExtraDataLength != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.MaxExtraDataLength))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.MaxGasLimitDelta != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.MaxGasLimitDelta))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.TargetGasLimit != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.TargetGasLimit))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.HeadHistoryRetention != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.HeadHistoryRetention))
+		i--
+		dAtA[i] = 0x10
+	}
 	if len(m.ExecutionBlockHash) > 0 {
 		i -= len(m.ExecutionBlockHash)
 		copy(dAtA[i:], m.ExecutionBlockHash)
@@ -143,6 +278,27 @@ func (m *Params) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovParams(uint64(l))
 	}
+	if m.HeadHistoryRetention != 0 {
+		n += 1 + sovParams(uint64(m.HeadHistoryRetention))
+	}
+	if m.TargetGasLimit != 0 {
+		n += 1 + sovParams(uint64(m.TargetGasLimit))
+	}
+	if m.MaxGasLimitDelta != 0 {
+		n += 1 + sovParams(uint64(m.MaxGasLimitDelta))
+	}
+	if m.MaxExtraDataLength != 0 {
+		n += 1 + sovParams(uint64(m.MaxExtraDataLength))
+	}
+	if m.EvmEventPositions {
+		n += 2
+	}
+	if m.VerifyEventLogs {
+		n += 2
+	}
+	if m.TrackValidatorUbi {
+		n += 2
+	}
 	return n
 }
 
@@ -215,6 +371,142 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				m.ExecutionBlockHash = []byte{}
 			}
 			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HeadHistoryRetention", wireType)
+			}
+			m.HeadHistoryRetention = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.HeadHistoryRetention |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TargetGasLimit", wireType)
+			}
+			m.TargetGasLimit = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TargetGasLimit |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxGasLimitDelta", wireType)
+			}
+			m.MaxGasLimitDelta = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxGasLimitDelta |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxExtraDataLength", wireType)
+			}
+			m.MaxExtraDataLength = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxExtraDataLength |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EvmEventPositions", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.EvmEventPositions = bool(v != 0)
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VerifyEventLogs", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.VerifyEventLogs = bool(v != 0)
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TrackValidatorUbi", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.TrackValidatorUbi = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipParams(dAtA[iNdEx:])
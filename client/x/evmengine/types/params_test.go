@@ -14,15 +14,27 @@ func TestNewParams(t *testing.T) {
 
 	dummyHash := common.HexToHash("0x047e24c3455107d87c68dffa307b3b7fa1877f3e9d7f30c7ee359f2eff3a75d9")
 	tcs := []struct {
-		name               string
-		executionBlockHash []byte
-		expectedResult     types.Params
+		name                 string
+		executionBlockHash   []byte
+		headHistoryRetention uint64
+		targetGasLimit       uint64
+		maxGasLimitDelta     uint64
+		maxExtraDataLength   uint64
+		expectedResult       types.Params
 	}{
 		{
-			name:               "non-nil execution block hash",
-			executionBlockHash: dummyHash.Bytes(),
+			name:                 "non-nil execution block hash",
+			executionBlockHash:   dummyHash.Bytes(),
+			headHistoryRetention: 100,
+			targetGasLimit:       30_000_000,
+			maxGasLimitDelta:     30_000,
+			maxExtraDataLength:   32,
 			expectedResult: types.Params{
-				ExecutionBlockHash: dummyHash.Bytes(),
+				ExecutionBlockHash:   dummyHash.Bytes(),
+				HeadHistoryRetention: 100,
+				TargetGasLimit:       30_000_000,
+				MaxGasLimitDelta:     30_000,
+				MaxExtraDataLength:   32,
 			},
 		},
 		{
@@ -38,7 +50,7 @@ func TestNewParams(t *testing.T) {
 		t.Run(tc.name, func(t *testing.T) {
 			t.Parallel()
 
-			result := types.NewParams(tc.executionBlockHash)
+			result := types.NewParams(tc.executionBlockHash, tc.headHistoryRetention, tc.targetGasLimit, tc.maxGasLimitDelta, tc.maxExtraDataLength)
 			require.Equal(t, tc.expectedResult, result)
 		})
 	}
@@ -49,7 +61,14 @@ func TestDefaultParams(t *testing.T) {
 
 	result := types.DefaultParams()
 	require.Equal(t, types.Params{
-		ExecutionBlockHash: nil,
+		ExecutionBlockHash:   nil,
+		HeadHistoryRetention: types.DefaultHeadHistoryRetention,
+		TargetGasLimit:       types.DefaultTargetGasLimit,
+		MaxGasLimitDelta:     types.DefaultMaxGasLimitDelta,
+		MaxExtraDataLength:   types.DefaultMaxExtraDataLength,
+		EvmEventPositions:    true,
+		VerifyEventLogs:      true,
+		TrackValidatorUbi:    true,
 	}, result)
 }
 
@@ -86,3 +105,56 @@ func TestValidateExecutionBlockHash(t *testing.T) {
 		})
 	}
 }
+
+func TestParams_Validate(t *testing.T) {
+	t.Parallel()
+
+	dummyHash := common.HexToHash("0x047e24c3455107d87c68dffa307b3b7fa1877f3e9d7f30c7ee359f2eff3a75d9")
+	tcs := []struct {
+		name          string
+		params        types.Params
+		expectedError string
+	}{
+		{
+			name:   "pass: limits disabled",
+			params: types.NewParams(dummyHash.Bytes(), 0, 0, 0, 0),
+		},
+		{
+			name:   "pass: limits enabled",
+			params: types.NewParams(dummyHash.Bytes(), 0, 30_000_000, 30_000, 32),
+		},
+		{
+			name:          "fail: invalid execution block hash",
+			params:        types.NewParams(nil, 0, 0, 0, 0),
+			expectedError: "invalid execution block hash length",
+		},
+		{
+			name:          "fail: target gas limit below minimum",
+			params:        types.NewParams(dummyHash.Bytes(), 0, 1000, 30_000, 0),
+			expectedError: "target gas limit below minimum",
+		},
+		{
+			name:          "fail: zero max gas limit delta",
+			params:        types.NewParams(dummyHash.Bytes(), 0, 30_000_000, 0, 0),
+			expectedError: "max gas limit delta must be positive if the target gas limit is set",
+		},
+		{
+			name:          "fail: max extra data length exceeds maximum",
+			params:        types.NewParams(dummyHash.Bytes(), 0, 0, 0, 33),
+			expectedError: "max extra data length exceeds maximum",
+		},
+	}
+
+	for _, tc := range tcs {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			err := tc.params.Validate()
+			if tc.expectedError == "" {
+				require.NoError(t, err)
+			} else {
+				require.ErrorContains(t, err, tc.expectedError)
+			}
+		})
+	}
+}
@@ -0,0 +1,2754 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: client/x/evmengine/types/query.proto
+
+package types
+
+import (
+	context "context"
+	cosmossdk_io_math "cosmossdk.io/math"
+	fmt "fmt"
+	_ "github.com/cosmos/cosmos-proto"
+	query "github.com/cosmos/cosmos-sdk/types/query"
+	_ "github.com/cosmos/gogoproto/gogoproto"
+	grpc1 "github.com/cosmos/gogoproto/grpc"
+	proto "github.com/cosmos/gogoproto/proto"
+	_ "google.golang.org/genproto/googleapis/api/annotations"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// QueryParamsRequest is the request type for the Query/Params RPC method.
+type QueryParamsRequest struct {
+}
+
+func (m *QueryParamsRequest) Reset()         { *m = QueryParamsRequest{} }
+func (m *QueryParamsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryParamsRequest) ProtoMessage()    {}
+func (*QueryParamsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_77f6ff3ca6ecf992, []int{0}
+}
+func (m *QueryParamsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryParamsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryParamsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryParamsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryParamsRequest.Merge(m, src)
+}
+func (m *QueryParamsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryParamsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryParamsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryParamsRequest proto.InternalMessageInfo
+
+// QueryParamsResponse is the response type for the Query/Params RPC method.
+type QueryParamsResponse struct {
+	Params Params `protobuf:"bytes,1,opt,name=params,proto3" json:"params"`
+}
+
+func (m *QueryParamsResponse) Reset()         { *m = QueryParamsResponse{} }
+func (m *QueryParamsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryParamsResponse) ProtoMessage()    {}
+func (*QueryParamsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_77f6ff3ca6ecf992, []int{1}
+}
+func (m *QueryParamsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryParamsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryParamsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryParamsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryParamsResponse.Merge(m, src)
+}
+func (m *QueryParamsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryParamsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryParamsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryParamsResponse proto.InternalMessageInfo
+
+func (m *QueryParamsResponse) GetParams() Params {
+	if m != nil {
+		return m.Params
+	}
+	return Params{}
+}
+
+// ExecutionHeadInfo defines the execution block finalized at a consensus chain height.
+type ExecutionHeadInfo struct {
+	CreatedHeight uint64 `protobuf:"varint,1,opt,name=created_height,json=createdHeight,proto3" json:"created_height,omitempty"`
+	BlockHeight   uint64 `protobuf:"varint,2,opt,name=block_height,json=blockHeight,proto3" json:"block_height,omitempty"`
+	BlockHash     []byte `protobuf:"bytes,3,opt,name=block_hash,json=blockHash,proto3" json:"block_hash,omitempty"`
+	BlockTime     uint64 `protobuf:"varint,4,opt,name=block_time,json=blockTime,proto3" json:"block_time,omitempty"`
+}
+
+func (m *ExecutionHeadInfo) Reset()         { *m = ExecutionHeadInfo{} }
+func (m *ExecutionHeadInfo) String() string { return proto.CompactTextString(m) }
+func (*ExecutionHeadInfo) ProtoMessage()    {}
+func (*ExecutionHeadInfo) Descriptor() ([]byte, []int) {
+	return fileDescriptor_77f6ff3ca6ecf992, []int{2}
+}
+func (m *ExecutionHeadInfo) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ExecutionHeadInfo) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ExecutionHeadInfo.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ExecutionHeadInfo) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ExecutionHeadInfo.Merge(m, src)
+}
+func (m *ExecutionHeadInfo) XXX_Size() int {
+	return m.Size()
+}
+func (m *ExecutionHeadInfo) XXX_DiscardUnknown() {
+	xxx_messageInfo_ExecutionHeadInfo.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ExecutionHeadInfo proto.InternalMessageInfo
+
+func (m *ExecutionHeadInfo) GetCreatedHeight() uint64 {
+	if m != nil {
+		return m.CreatedHeight
+	}
+	return 0
+}
+
+func (m *ExecutionHeadInfo) GetBlockHeight() uint64 {
+	if m != nil {
+		return m.BlockHeight
+	}
+	return 0
+}
+
+func (m *ExecutionHeadInfo) GetBlockHash() []byte {
+	if m != nil {
+		return m.BlockHash
+	}
+	return nil
+}
+
+func (m *ExecutionHeadInfo) GetBlockTime() uint64 {
+	if m != nil {
+		return m.BlockTime
+	}
+	return 0
+}
+
+// QueryExecutionHeadRequest is the request type for the Query/ExecutionHead RPC method.
+type QueryExecutionHeadRequest struct {
+	Height uint64 `protobuf:"varint,1,opt,name=height,proto3" json:"height,omitempty"`
+}
+
+func (m *QueryExecutionHeadRequest) Reset()         { *m = QueryExecutionHeadRequest{} }
+func (m *QueryExecutionHeadRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryExecutionHeadRequest) ProtoMessage()    {}
+func (*QueryExecutionHeadRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_77f6ff3ca6ecf992, []int{3}
+}
+func (m *QueryExecutionHeadRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryExecutionHeadRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryExecutionHeadRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryExecutionHeadRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryExecutionHeadRequest.Merge(m, src)
+}
+func (m *QueryExecutionHeadRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryExecutionHeadRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryExecutionHeadRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryExecutionHeadRequest proto.InternalMessageInfo
+
+func (m *QueryExecutionHeadRequest) GetHeight() uint64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+// QueryExecutionHeadResponse is the response type for the Query/ExecutionHead RPC method.
+type QueryExecutionHeadResponse struct {
+	Head ExecutionHeadInfo `protobuf:"bytes,1,opt,name=head,proto3" json:"head"`
+}
+
+func (m *QueryExecutionHeadResponse) Reset()         { *m = QueryExecutionHeadResponse{} }
+func (m *QueryExecutionHeadResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryExecutionHeadResponse) ProtoMessage()    {}
+func (*QueryExecutionHeadResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_77f6ff3ca6ecf992, []int{4}
+}
+func (m *QueryExecutionHeadResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryExecutionHeadResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryExecutionHeadResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryExecutionHeadResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryExecutionHeadResponse.Merge(m, src)
+}
+func (m *QueryExecutionHeadResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryExecutionHeadResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryExecutionHeadResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryExecutionHeadResponse proto.InternalMessageInfo
+
+func (m *QueryExecutionHeadResponse) GetHead() ExecutionHeadInfo {
+	if m != nil {
+		return m.Head
+	}
+	return ExecutionHeadInfo{}
+}
+
+// QueryExecutionHeadsRequest is the request type for the Query/ExecutionHeads RPC method.
+type QueryExecutionHeadsRequest struct {
+	Pagination *query.PageRequest `protobuf:"bytes,1,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryExecutionHeadsRequest) Reset()         { *m = QueryExecutionHeadsRequest{} }
+func (m *QueryExecutionHeadsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryExecutionHeadsRequest) ProtoMessage()    {}
+func (*QueryExecutionHeadsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_77f6ff3ca6ecf992, []int{5}
+}
+func (m *QueryExecutionHeadsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryExecutionHeadsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryExecutionHeadsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryExecutionHeadsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryExecutionHeadsRequest.Merge(m, src)
+}
+func (m *QueryExecutionHeadsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryExecutionHeadsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryExecutionHeadsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryExecutionHeadsRequest proto.InternalMessageInfo
+
+func (m *QueryExecutionHeadsRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+// QueryExecutionHeadsResponse is the response type for the Query/ExecutionHeads RPC method.
+type QueryExecutionHeadsResponse struct {
+	Heads []ExecutionHeadInfo `protobuf:"bytes,1,rep,name=heads,proto3" json:"heads"`
+	// pagination defines an optional pagination for the request.
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryExecutionHeadsResponse) Reset()         { *m = QueryExecutionHeadsResponse{} }
+func (m *QueryExecutionHeadsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryExecutionHeadsResponse) ProtoMessage()    {}
+func (*QueryExecutionHeadsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_77f6ff3ca6ecf992, []int{6}
+}
+func (m *QueryExecutionHeadsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryExecutionHeadsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryExecutionHeadsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryExecutionHeadsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryExecutionHeadsResponse.Merge(m, src)
+}
+func (m *QueryExecutionHeadsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryExecutionHeadsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryExecutionHeadsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryExecutionHeadsResponse proto.InternalMessageInfo
+
+func (m *QueryExecutionHeadsResponse) GetHeads() []ExecutionHeadInfo {
+	if m != nil {
+		return m.Heads
+	}
+	return nil
+}
+
+func (m *QueryExecutionHeadsResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+// QueryUbiPercentageRequest is the request type for the Query/UbiPercentage RPC method.
+type QueryUbiPercentageRequest struct {
+}
+
+func (m *QueryUbiPercentageRequest) Reset()         { *m = QueryUbiPercentageRequest{} }
+func (m *QueryUbiPercentageRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryUbiPercentageRequest) ProtoMessage()    {}
+func (*QueryUbiPercentageRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_77f6ff3ca6ecf992, []int{7}
+}
+func (m *QueryUbiPercentageRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryUbiPercentageRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryUbiPercentageRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryUbiPercentageRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryUbiPercentageRequest.Merge(m, src)
+}
+func (m *QueryUbiPercentageRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryUbiPercentageRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryUbiPercentageRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryUbiPercentageRequest proto.InternalMessageInfo
+
+// QueryUbiPercentageResponse is the response type for the Query/UbiPercentage RPC method.
+type QueryUbiPercentageResponse struct {
+	Percentage cosmossdk_io_math.LegacyDec `protobuf:"bytes,1,opt,name=percentage,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"percentage"`
+}
+
+func (m *QueryUbiPercentageResponse) Reset()         { *m = QueryUbiPercentageResponse{} }
+func (m *QueryUbiPercentageResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryUbiPercentageResponse) ProtoMessage()    {}
+func (*QueryUbiPercentageResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_77f6ff3ca6ecf992, []int{8}
+}
+func (m *QueryUbiPercentageResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryUbiPercentageResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryUbiPercentageResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryUbiPercentageResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryUbiPercentageResponse.Merge(m, src)
+}
+func (m *QueryUbiPercentageResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryUbiPercentageResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryUbiPercentageResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryUbiPercentageResponse proto.InternalMessageInfo
+
+// QueryUbiBalanceRequest is the request type for the Query/UbiBalance RPC method.
+type QueryUbiBalanceRequest struct {
+}
+
+func (m *QueryUbiBalanceRequest) Reset()         { *m = QueryUbiBalanceRequest{} }
+func (m *QueryUbiBalanceRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryUbiBalanceRequest) ProtoMessage()    {}
+func (*QueryUbiBalanceRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_77f6ff3ca6ecf992, []int{9}
+}
+func (m *QueryUbiBalanceRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryUbiBalanceRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryUbiBalanceRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryUbiBalanceRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryUbiBalanceRequest.Merge(m, src)
+}
+func (m *QueryUbiBalanceRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryUbiBalanceRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryUbiBalanceRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryUbiBalanceRequest proto.InternalMessageInfo
+
+// QueryUbiBalanceResponse is the response type for the Query/UbiBalance RPC method.
+type QueryUbiBalanceResponse struct {
+	Balance cosmossdk_io_math.Int `protobuf:"bytes,1,opt,name=balance,proto3,customtype=cosmossdk.io/math.Int" json:"balance"`
+}
+
+func (m *QueryUbiBalanceResponse) Reset()         { *m = QueryUbiBalanceResponse{} }
+func (m *QueryUbiBalanceResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryUbiBalanceResponse) ProtoMessage()    {}
+func (*QueryUbiBalanceResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_77f6ff3ca6ecf992, []int{10}
+}
+func (m *QueryUbiBalanceResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryUbiBalanceResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryUbiBalanceResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryUbiBalanceResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryUbiBalanceResponse.Merge(m, src)
+}
+func (m *QueryUbiBalanceResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryUbiBalanceResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryUbiBalanceResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryUbiBalanceResponse proto.InternalMessageInfo
+
+// QueryValidatorUbiRequest is the request type for the Query/ValidatorUbi RPC method.
+type QueryValidatorUbiRequest struct {
+	ValidatorPubkey string `protobuf:"bytes,1,opt,name=validator_pubkey,json=validatorPubkey,proto3" json:"validator_pubkey,omitempty"`
+}
+
+func (m *QueryValidatorUbiRequest) Reset()         { *m = QueryValidatorUbiRequest{} }
+func (m *QueryValidatorUbiRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryValidatorUbiRequest) ProtoMessage()    {}
+func (*QueryValidatorUbiRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_77f6ff3ca6ecf992, []int{11}
+}
+func (m *QueryValidatorUbiRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryValidatorUbiRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryValidatorUbiRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryValidatorUbiRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryValidatorUbiRequest.Merge(m, src)
+}
+func (m *QueryValidatorUbiRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryValidatorUbiRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryValidatorUbiRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryValidatorUbiRequest proto.InternalMessageInfo
+
+func (m *QueryValidatorUbiRequest) GetValidatorPubkey() string {
+	if m != nil {
+		return m.ValidatorPubkey
+	}
+	return ""
+}
+
+// QueryValidatorUbiResponse is the response type for the Query/ValidatorUbi RPC method.
+type QueryValidatorUbiResponse struct {
+	Accrued cosmossdk_io_math.Int `protobuf:"bytes,1,opt,name=accrued,proto3,customtype=cosmossdk.io/math.Int" json:"accrued"`
+	Claimed cosmossdk_io_math.Int `protobuf:"bytes,2,opt,name=claimed,proto3,customtype=cosmossdk.io/math.Int" json:"claimed"`
+}
+
+func (m *QueryValidatorUbiResponse) Reset()         { *m = QueryValidatorUbiResponse{} }
+func (m *QueryValidatorUbiResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryValidatorUbiResponse) ProtoMessage()    {}
+func (*QueryValidatorUbiResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_77f6ff3ca6ecf992, []int{12}
+}
+func (m *QueryValidatorUbiResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryValidatorUbiResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryValidatorUbiResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryValidatorUbiResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryValidatorUbiResponse.Merge(m, src)
+}
+func (m *QueryValidatorUbiResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryValidatorUbiResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryValidatorUbiResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryValidatorUbiResponse proto.InternalMessageInfo
+
+func init() {
+	proto.RegisterType((*QueryParamsRequest)(nil), "client.x.evmengine.types.QueryParamsRequest")
+	proto.RegisterType((*QueryParamsResponse)(nil), "client.x.evmengine.types.QueryParamsResponse")
+	proto.RegisterType((*ExecutionHeadInfo)(nil), "client.x.evmengine.types.ExecutionHeadInfo")
+	proto.RegisterType((*QueryExecutionHeadRequest)(nil), "client.x.evmengine.types.QueryExecutionHeadRequest")
+	proto.RegisterType((*QueryExecutionHeadResponse)(nil), "client.x.evmengine.types.QueryExecutionHeadResponse")
+	proto.RegisterType((*QueryExecutionHeadsRequest)(nil), "client.x.evmengine.types.QueryExecutionHeadsRequest")
+	proto.RegisterType((*QueryExecutionHeadsResponse)(nil), "client.x.evmengine.types.QueryExecutionHeadsResponse")
+	proto.RegisterType((*QueryUbiPercentageRequest)(nil), "client.x.evmengine.types.QueryUbiPercentageRequest")
+	proto.RegisterType((*QueryUbiPercentageResponse)(nil), "client.x.evmengine.types.QueryUbiPercentageResponse")
+	proto.RegisterType((*QueryUbiBalanceRequest)(nil), "client.x.evmengine.types.QueryUbiBalanceRequest")
+	proto.RegisterType((*QueryUbiBalanceResponse)(nil), "client.x.evmengine.types.QueryUbiBalanceResponse")
+	proto.RegisterType((*QueryValidatorUbiRequest)(nil), "client.x.evmengine.types.QueryValidatorUbiRequest")
+	proto.RegisterType((*QueryValidatorUbiResponse)(nil), "client.x.evmengine.types.QueryValidatorUbiResponse")
+}
+
+func init() {
+	proto.RegisterFile("client/x/evmengine/types/query.proto", fileDescriptor_77f6ff3ca6ecf992)
+}
+
+var fileDescriptor_77f6ff3ca6ecf992 = []byte{
+	// 865 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x96, 0x41, 0x8f, 0xdb, 0x44,
+	0x14, 0xc7, 0xe3, 0x34, 0x4d, 0xd5, 0xd7, 0xdd, 0x02, 0x43, 0x29, 0x5e, 0x87, 0xa6, 0xc1, 0xed,
+	0x96, 0x85, 0xdd, 0xda, 0x24, 0x59, 0x10, 0xa7, 0x1e, 0xa2, 0x2e, 0xed, 0x4a, 0x1c, 0x52, 0x8b,
+	0xe5, 0xc0, 0x25, 0x8c, 0xed, 0xc1, 0xb1, 0x36, 0xf1, 0xb8, 0xb1, 0x13, 0x6d, 0x54, 0xf5, 0xc2,
+	0x91, 0x13, 0x12, 0xc7, 0x7e, 0x00, 0x84, 0x38, 0xc0, 0x01, 0x89, 0xaf, 0xb0, 0xc7, 0x15, 0x5c,
+	0x10, 0x87, 0x15, 0xda, 0xe5, 0x3b, 0x70, 0x45, 0x9e, 0x79, 0x4e, 0x62, 0xe2, 0x28, 0x9b, 0xbd,
+	0x25, 0x6f, 0xde, 0x7f, 0xde, 0x6f, 0xde, 0xbc, 0xf9, 0xcb, 0x70, 0xdf, 0xe9, 0xf9, 0x2c, 0x88,
+	0xcd, 0x23, 0x93, 0x8d, 0xfa, 0x2c, 0xf0, 0xfc, 0x80, 0x99, 0xf1, 0x38, 0x64, 0x91, 0xf9, 0x7c,
+	0xc8, 0x06, 0x63, 0x23, 0x1c, 0xf0, 0x98, 0x13, 0x55, 0x66, 0x19, 0x47, 0xc6, 0x24, 0xcb, 0x10,
+	0x59, 0xda, 0x2d, 0x8f, 0x7b, 0x5c, 0x24, 0x99, 0xc9, 0x2f, 0x99, 0xaf, 0x6d, 0x38, 0x3c, 0xea,
+	0xf3, 0xa8, 0x23, 0x17, 0xe4, 0x1f, 0x5c, 0x7a, 0xc7, 0xe3, 0xdc, 0xeb, 0x31, 0x93, 0x86, 0xbe,
+	0x49, 0x83, 0x80, 0xc7, 0x34, 0xf6, 0x79, 0x90, 0xae, 0x7e, 0x20, 0x73, 0x4d, 0x9b, 0x46, 0x4c,
+	0x12, 0x98, 0xa3, 0xba, 0xcd, 0x62, 0x5a, 0x37, 0x43, 0xea, 0xf9, 0x81, 0x48, 0xc6, 0xdc, 0xcd,
+	0x85, 0xe8, 0x21, 0x1d, 0xd0, 0x3e, 0x6e, 0xa9, 0xdf, 0x02, 0xf2, 0x2c, 0xd9, 0xa8, 0x2d, 0x82,
+	0x16, 0x7b, 0x3e, 0x64, 0x51, 0xac, 0x1f, 0xc0, 0x9b, 0x99, 0x68, 0x14, 0xf2, 0x20, 0x62, 0xe4,
+	0x11, 0x94, 0xa5, 0x58, 0x55, 0x6a, 0xca, 0xd6, 0x8d, 0x46, 0xcd, 0x58, 0x74, 0x72, 0x43, 0x2a,
+	0x5b, 0xa5, 0xe3, 0xd3, 0xbb, 0x05, 0x0b, 0x55, 0xfa, 0x2b, 0x05, 0xde, 0xd8, 0x3b, 0x62, 0xce,
+	0x30, 0xe1, 0x7c, 0xca, 0xa8, 0xbb, 0x1f, 0x7c, 0xcd, 0xc9, 0x26, 0xdc, 0x74, 0x06, 0x8c, 0xc6,
+	0xcc, 0xed, 0x74, 0x99, 0xef, 0x75, 0x63, 0xb1, 0x7b, 0xc9, 0x5a, 0xc7, 0xe8, 0x53, 0x11, 0x24,
+	0xef, 0xc2, 0x9a, 0xdd, 0xe3, 0xce, 0x61, 0x9a, 0x54, 0x14, 0x49, 0x37, 0x44, 0x0c, 0x53, 0xee,
+	0x00, 0x60, 0x0a, 0x8d, 0xba, 0xea, 0x95, 0x9a, 0xb2, 0xb5, 0x66, 0x5d, 0x97, 0x09, 0x34, 0xea,
+	0x4e, 0x97, 0x63, 0xbf, 0xcf, 0xd4, 0x92, 0xd0, 0xcb, 0xe5, 0xcf, 0xfd, 0x3e, 0xd3, 0x9b, 0xb0,
+	0x21, 0x0e, 0x9d, 0x21, 0xc4, 0x8e, 0x90, 0xdb, 0x50, 0xce, 0xc0, 0xe1, 0x3f, 0xdd, 0x01, 0x2d,
+	0x4f, 0x84, 0x0d, 0xdb, 0x83, 0x52, 0x97, 0x51, 0x17, 0xdb, 0xb5, 0xbd, 0xb8, 0x5d, 0x73, 0x5d,
+	0xc1, 0xce, 0x09, 0xb9, 0xee, 0xe6, 0x15, 0x49, 0x2f, 0x8b, 0x7c, 0x0a, 0x30, 0xbd, 0x7d, 0x2c,
+	0xf5, 0xc0, 0xc0, 0xb1, 0x4a, 0x46, 0xc5, 0x90, 0xc3, 0x8a, 0xa3, 0x62, 0xb4, 0xa9, 0xc7, 0x50,
+	0x6b, 0xcd, 0x28, 0xf5, 0x9f, 0x15, 0xa8, 0xe4, 0x96, 0xc1, 0xc3, 0x3c, 0x81, 0xab, 0x09, 0x4d,
+	0x72, 0xf9, 0x57, 0x2e, 0x77, 0x1a, 0xa9, 0x27, 0x4f, 0x32, 0xc0, 0x45, 0x01, 0xfc, 0xde, 0x52,
+	0x60, 0x49, 0x91, 0x21, 0xae, 0xe0, 0x8d, 0x1d, 0xd8, 0x7e, 0x9b, 0x0d, 0x1c, 0x16, 0xc4, 0xd3,
+	0xa3, 0xe9, 0x1c, 0xb4, 0xbc, 0x45, 0x3c, 0xcc, 0x33, 0x80, 0x70, 0x12, 0x15, 0x4d, 0xbb, 0xde,
+	0xaa, 0x27, 0x90, 0x7f, 0x9d, 0xde, 0xad, 0x48, 0x94, 0xc8, 0x3d, 0x34, 0x7c, 0x6e, 0xf6, 0x69,
+	0xdc, 0x35, 0x3e, 0x63, 0x1e, 0x75, 0xc6, 0x8f, 0x99, 0xf3, 0xfb, 0xaf, 0x0f, 0x01, 0x49, 0x1f,
+	0x33, 0xc7, 0x9a, 0xd9, 0x44, 0x57, 0xe1, 0x76, 0x5a, 0xb0, 0x45, 0x7b, 0x34, 0x70, 0x26, 0x28,
+	0x5f, 0xc1, 0xdb, 0x73, 0x2b, 0x93, 0x09, 0xb9, 0x66, 0xcb, 0x10, 0x42, 0x6c, 0x23, 0xc4, 0x5b,
+	0xf3, 0x10, 0xfb, 0x41, 0x3c, 0x53, 0x7e, 0x3f, 0x88, 0xad, 0x54, 0xab, 0xef, 0x81, 0x2a, 0x2a,
+	0x7c, 0x41, 0x7b, 0xbe, 0x4b, 0x63, 0x3e, 0x38, 0xb0, 0xfd, 0x74, 0x3e, 0xde, 0x87, 0xd7, 0x47,
+	0x69, 0xb8, 0x13, 0x0e, 0xed, 0x43, 0x36, 0x96, 0xb5, 0xac, 0xd7, 0x26, 0xf1, 0xb6, 0x08, 0xeb,
+	0x3f, 0x2a, 0xb0, 0x91, 0xb3, 0xcf, 0x94, 0x95, 0x3a, 0xce, 0x60, 0xc8, 0xdc, 0x4b, 0xb1, 0xa2,
+	0x36, 0xd9, 0xc6, 0xe9, 0x51, 0xbf, 0xcf, 0x5c, 0xb5, 0x78, 0x89, 0x6d, 0x50, 0xdb, 0xf8, 0xf7,
+	0x1a, 0x5c, 0x15, 0xac, 0xe4, 0x5b, 0x05, 0xca, 0xd2, 0x6f, 0xc8, 0xce, 0xe2, 0xa1, 0x9c, 0xb7,
+	0x39, 0xed, 0xe1, 0x05, 0xb3, 0xe5, 0xf9, 0xf5, 0x7b, 0xdf, 0xfc, 0xf1, 0xcf, 0xf7, 0xc5, 0x3b,
+	0xa4, 0x62, 0xa2, 0xb7, 0x4e, 0x9d, 0x75, 0x54, 0x47, 0x5b, 0x25, 0xbf, 0x28, 0xb0, 0x9e, 0x99,
+	0x7f, 0xd2, 0x5c, 0x52, 0x25, 0xcf, 0x6f, 0xb4, 0xdd, 0xd5, 0x44, 0x48, 0xb8, 0x2b, 0x08, 0x0d,
+	0xb2, 0x93, 0x4b, 0xc8, 0x52, 0x4d, 0x27, 0x79, 0x87, 0xe6, 0x0b, 0x69, 0x61, 0x2f, 0xc9, 0x4f,
+	0x0a, 0xdc, 0xcc, 0xbe, 0x79, 0xb2, 0x52, 0xf9, 0x49, 0x3f, 0x3f, 0x5a, 0x51, 0x85, 0xd4, 0x3b,
+	0x82, 0xfa, 0x01, 0xb9, 0x7f, 0x01, 0xea, 0x88, 0xfc, 0xa0, 0xc0, 0x7a, 0xe6, 0x4d, 0x2f, 0x6d,
+	0x70, 0x9e, 0x3d, 0x68, 0xbb, 0xab, 0x89, 0x10, 0x75, 0x5b, 0xa0, 0x6e, 0x92, 0x7b, 0xb9, 0xa8,
+	0x43, 0xdb, 0x37, 0xa7, 0x86, 0x40, 0x5e, 0x29, 0x00, 0xd3, 0x27, 0x4f, 0x3e, 0x5c, 0x5e, 0x31,
+	0xeb, 0x1b, 0x5a, 0x7d, 0x05, 0x05, 0x02, 0x6e, 0x09, 0x40, 0x9d, 0xd4, 0x16, 0x02, 0xa2, 0x65,
+	0x90, 0xdf, 0x14, 0x58, 0x9b, 0x7d, 0xe6, 0xa4, 0xb1, 0xa4, 0x5a, 0x8e, 0xb7, 0x68, 0xcd, 0x95,
+	0x34, 0xc8, 0xf8, 0x48, 0x30, 0x7e, 0x42, 0x3e, 0x5e, 0xc8, 0x38, 0xf1, 0xa5, 0xc8, 0x7c, 0xf1,
+	0x7f, 0xef, 0x7a, 0xd9, 0x6a, 0x1c, 0x9f, 0x55, 0x95, 0x93, 0xb3, 0xaa, 0xf2, 0xf7, 0x59, 0x55,
+	0xf9, 0xee, 0xbc, 0x5a, 0x38, 0x39, 0xaf, 0x16, 0xfe, 0x3c, 0xaf, 0x16, 0xbe, 0x54, 0x17, 0x7d,
+	0xf4, 0xd8, 0x65, 0xf1, 0xb9, 0xd3, 0xfc, 0x6f, 0x00, 0x7c, 0x55, 0xc9, 0x95, 0xd2, 0x09, 0x00,
+	0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// QueryClient is the client API for Query service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type QueryClient interface {
+	// Params queries the parameters of the module.
+	Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error)
+	// ExecutionHead queries the execution block finalized at a consensus chain height.
+	ExecutionHead(ctx context.Context, in *QueryExecutionHeadRequest, opts ...grpc.CallOption) (*QueryExecutionHeadResponse, error)
+	// ExecutionHeads queries the retained execution head history, ordered by consensus chain height.
+	ExecutionHeads(ctx context.Context, in *QueryExecutionHeadsRequest, opts ...grpc.CallOption) (*QueryExecutionHeadsResponse, error)
+	// UbiPercentage queries the current UBI percentage of the block rewards.
+	UbiPercentage(ctx context.Context, in *QueryUbiPercentageRequest, opts ...grpc.CallOption) (*QueryUbiPercentageResponse, error)
+	// UbiBalance queries the UBI accrued in the distribution module that isn't withdrawn to the UBIPool yet.
+	UbiBalance(ctx context.Context, in *QueryUbiBalanceRequest, opts ...grpc.CallOption) (*QueryUbiBalanceResponse, error)
+	// ValidatorUbi queries the UBI distributed to and claimed by a validator on the UBIPool.
+	ValidatorUbi(ctx context.Context, in *QueryValidatorUbiRequest, opts ...grpc.CallOption) (*QueryValidatorUbiResponse, error)
+}
+
+type queryClient struct {
+	cc grpc1.ClientConn
+}
+
+func NewQueryClient(cc grpc1.ClientConn) QueryClient {
+	return &queryClient{cc}
+}
+
+func (c *queryClient) Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error) {
+	out := new(QueryParamsResponse)
+	err := c.cc.Invoke(ctx, "/client.x.evmengine.types.Query/Params", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) ExecutionHead(ctx context.Context, in *QueryExecutionHeadRequest, opts ...grpc.CallOption) (*QueryExecutionHeadResponse, error) {
+	out := new(QueryExecutionHeadResponse)
+	err := c.cc.Invoke(ctx, "/client.x.evmengine.types.Query/ExecutionHead", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) ExecutionHeads(ctx context.Context, in *QueryExecutionHeadsRequest, opts ...grpc.CallOption) (*QueryExecutionHeadsResponse, error) {
+	out := new(QueryExecutionHeadsResponse)
+	err := c.cc.Invoke(ctx, "/client.x.evmengine.types.Query/ExecutionHeads", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) UbiPercentage(ctx context.Context, in *QueryUbiPercentageRequest, opts ...grpc.CallOption) (*QueryUbiPercentageResponse, error) {
+	out := new(QueryUbiPercentageResponse)
+	err := c.cc.Invoke(ctx, "/client.x.evmengine.types.Query/UbiPercentage", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) UbiBalance(ctx context.Context, in *QueryUbiBalanceRequest, opts ...grpc.CallOption) (*QueryUbiBalanceResponse, error) {
+	out := new(QueryUbiBalanceResponse)
+	err := c.cc.Invoke(ctx, "/client.x.evmengine.types.Query/UbiBalance", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) ValidatorUbi(ctx context.Context, in *QueryValidatorUbiRequest, opts ...grpc.CallOption) (*QueryValidatorUbiResponse, error) {
+	out := new(QueryValidatorUbiResponse)
+	err := c.cc.Invoke(ctx, "/client.x.evmengine.types.Query/ValidatorUbi", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// QueryServer is the server API for Query service.
+type QueryServer interface {
+	// Params queries the parameters of the module.
+	Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error)
+	// ExecutionHead queries the execution block finalized at a consensus chain height.
+	ExecutionHead(context.Context, *QueryExecutionHeadRequest) (*QueryExecutionHeadResponse, error)
+	// ExecutionHeads queries the retained execution head history, ordered by consensus chain height.
+	ExecutionHeads(context.Context, *QueryExecutionHeadsRequest) (*QueryExecutionHeadsResponse, error)
+	// UbiPercentage queries the current UBI percentage of the block rewards.
+	UbiPercentage(context.Context, *QueryUbiPercentageRequest) (*QueryUbiPercentageResponse, error)
+	// UbiBalance queries the UBI accrued in the distribution module that isn't withdrawn to the UBIPool yet.
+	UbiBalance(context.Context, *QueryUbiBalanceRequest) (*QueryUbiBalanceResponse, error)
+	// ValidatorUbi queries the UBI distributed to and claimed by a validator on the UBIPool.
+	ValidatorUbi(context.Context, *QueryValidatorUbiRequest) (*QueryValidatorUbiResponse, error)
+}
+
+// UnimplementedQueryServer can be embedded to have forward compatible implementations.
+type UnimplementedQueryServer struct {
+}
+
+func (*UnimplementedQueryServer) Params(ctx context.Context, req *QueryParamsRequest) (*QueryParamsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Params not implemented")
+}
+func (*UnimplementedQueryServer) ExecutionHead(ctx context.Context, req *QueryExecutionHeadRequest) (*QueryExecutionHeadResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ExecutionHead not implemented")
+}
+func (*UnimplementedQueryServer) ExecutionHeads(ctx context.Context, req *QueryExecutionHeadsRequest) (*QueryExecutionHeadsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ExecutionHeads not implemented")
+}
+func (*UnimplementedQueryServer) UbiPercentage(ctx context.Context, req *QueryUbiPercentageRequest) (*QueryUbiPercentageResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UbiPercentage not implemented")
+}
+func (*UnimplementedQueryServer) UbiBalance(ctx context.Context, req *QueryUbiBalanceRequest) (*QueryUbiBalanceResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UbiBalance not implemented")
+}
+func (*UnimplementedQueryServer) ValidatorUbi(ctx context.Context, req *QueryValidatorUbiRequest) (*QueryValidatorUbiResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ValidatorUbi not implemented")
+}
+
+func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
+	s.RegisterService(&_Query_serviceDesc, srv)
+}
+
+func _Query_Params_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryParamsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Params(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/client.x.evmengine.types.Query/Params",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Params(ctx, req.(*QueryParamsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_ExecutionHead_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryExecutionHeadRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ExecutionHead(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/client.x.evmengine.types.Query/ExecutionHead",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ExecutionHead(ctx, req.(*QueryExecutionHeadRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_ExecutionHeads_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryExecutionHeadsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ExecutionHeads(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/client.x.evmengine.types.Query/ExecutionHeads",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ExecutionHeads(ctx, req.(*QueryExecutionHeadsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_UbiPercentage_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryUbiPercentageRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).UbiPercentage(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/client.x.evmengine.types.Query/UbiPercentage",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).UbiPercentage(ctx, req.(*QueryUbiPercentageRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_UbiBalance_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryUbiBalanceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).UbiBalance(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/client.x.evmengine.types.Query/UbiBalance",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).UbiBalance(ctx, req.(*QueryUbiBalanceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_ValidatorUbi_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryValidatorUbiRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ValidatorUbi(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/client.x.evmengine.types.Query/ValidatorUbi",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ValidatorUbi(ctx, req.(*QueryValidatorUbiRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _Query_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "client.x.evmengine.types.Query",
+	HandlerType: (*QueryServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Params",
+			Handler:    _Query_Params_Handler,
+		},
+		{
+			MethodName: "ExecutionHead",
+			Handler:    _Query_ExecutionHead_Handler,
+		},
+		{
+			MethodName: "ExecutionHeads",
+			Handler:    _Query_ExecutionHeads_Handler,
+		},
+		{
+			MethodName: "UbiPercentage",
+			Handler:    _Query_UbiPercentage_Handler,
+		},
+		{
+			MethodName: "UbiBalance",
+			Handler:    _Query_UbiBalance_Handler,
+		},
+		{
+			MethodName: "ValidatorUbi",
+			Handler:    _Query_ValidatorUbi_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "client/x/evmengine/types/query.proto",
+}
+
+func (m *QueryParamsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryParamsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryParamsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryParamsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryParamsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *ExecutionHeadInfo) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ExecutionHeadInfo) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ExecutionHeadInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.BlockTime != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.BlockTime))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.BlockHash) > 0 {
+		i -= len(m.BlockHash)
+		copy(dAtA[i:], m.BlockHash)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.BlockHash)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.BlockHeight != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.BlockHeight))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.CreatedHeight != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.CreatedHeight))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryExecutionHeadRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryExecutionHeadRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryExecutionHeadRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Height != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryExecutionHeadResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryExecutionHeadResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryExecutionHeadResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Head.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryExecutionHeadsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryExecutionHeadsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryExecutionHeadsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryExecutionHeadsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryExecutionHeadsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryExecutionHeadsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Heads) > 0 {
+		for iNdEx := len(m.Heads) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Heads[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryUbiPercentageRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryUbiPercentageRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryUbiPercentageRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryUbiPercentageResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryUbiPercentageResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryUbiPercentageResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.Percentage.Size()
+		i -= size
+		if _, err := m.Percentage.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryUbiBalanceRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryUbiBalanceRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryUbiBalanceRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryUbiBalanceResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryUbiBalanceResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryUbiBalanceResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.Balance.Size()
+		i -= size
+		if _, err := m.Balance.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryValidatorUbiRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryValidatorUbiRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryValidatorUbiRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ValidatorPubkey) > 0 {
+		i -= len(m.ValidatorPubkey)
+		copy(dAtA[i:], m.ValidatorPubkey)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ValidatorPubkey)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryValidatorUbiResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryValidatorUbiResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryValidatorUbiResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.Claimed.Size()
+		i -= size
+		if _, err := m.Claimed.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	{
+		size := m.Accrued.Size()
+		i -= size
+		if _, err := m.Accrued.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
+	offset -= sovQuery(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *QueryParamsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryParamsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Params.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *ExecutionHeadInfo) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.CreatedHeight != 0 {
+		n += 1 + sovQuery(uint64(m.CreatedHeight))
+	}
+	if m.BlockHeight != 0 {
+		n += 1 + sovQuery(uint64(m.BlockHeight))
+	}
+	l = len(m.BlockHash)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.BlockTime != 0 {
+		n += 1 + sovQuery(uint64(m.BlockTime))
+	}
+	return n
+}
+
+func (m *QueryExecutionHeadRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Height != 0 {
+		n += 1 + sovQuery(uint64(m.Height))
+	}
+	return n
+}
+
+func (m *QueryExecutionHeadResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Head.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryExecutionHeadsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryExecutionHeadsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Heads) > 0 {
+		for _, e := range m.Heads {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryUbiPercentageRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryUbiPercentageResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Percentage.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryUbiBalanceRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryUbiBalanceResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Balance.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryValidatorUbiRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ValidatorPubkey)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryValidatorUbiResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Accrued.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.Claimed.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func sovQuery(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozQuery(x uint64) (n int) {
+	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryParamsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryParamsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryParamsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ExecutionHeadInfo) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ExecutionHeadInfo: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ExecutionHeadInfo: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CreatedHeight", wireType)
+			}
+			m.CreatedHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CreatedHeight |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockHeight", wireType)
+			}
+			m.BlockHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BlockHeight |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockHash", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BlockHash = append(m.BlockHash[:0], dAtA[iNdEx:postIndex]...)
+			if m.BlockHash == nil {
+				m.BlockHash = []byte{}
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockTime", wireType)
+			}
+			m.BlockTime = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BlockTime |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryExecutionHeadRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryExecutionHeadRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryExecutionHeadRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryExecutionHeadResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryExecutionHeadResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryExecutionHeadResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Head", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Head.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryExecutionHeadsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryExecutionHeadsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryExecutionHeadsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryExecutionHeadsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryExecutionHeadsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryExecutionHeadsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Heads", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Heads = append(m.Heads, ExecutionHeadInfo{})
+			if err := m.Heads[len(m.Heads)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryUbiPercentageRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryUbiPercentageRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryUbiPercentageRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryUbiPercentageResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryUbiPercentageResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryUbiPercentageResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Percentage", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Percentage.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryUbiBalanceRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryUbiBalanceRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryUbiBalanceRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryUbiBalanceResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryUbiBalanceResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryUbiBalanceResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Balance", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Balance.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryValidatorUbiRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryValidatorUbiRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryValidatorUbiRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorPubkey", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ValidatorPubkey = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryValidatorUbiResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryValidatorUbiResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryValidatorUbiResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Accrued", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Accrued.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Claimed", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Claimed.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipQuery(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthQuery
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupQuery
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthQuery
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthQuery        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowQuery          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupQuery = fmt.Errorf("proto: unexpected end of group")
+)
@@ -1,6 +1,7 @@
 package types
 
 import (
+	"github.com/ethereum/go-ethereum/accounts/abi"
 	"github.com/ethereum/go-ethereum/common"
 	ethtypes "github.com/ethereum/go-ethereum/core/types"
 
@@ -27,9 +28,12 @@ func (l *EVMEvent) ToEthLog() (ethtypes.Log, error) {
 	}
 
 	return ethtypes.Log{
-		Address: addr,
-		Topics:  topics,
-		Data:    l.Data,
+		Address:   addr,
+		Topics:    topics,
+		Data:      l.Data,
+		BlockHash: common.BytesToHash(l.BlockHash),
+		TxHash:    common.BytesToHash(l.TxHash),
+		Index:     uint(l.LogIndex),
 	}, nil
 }
 
@@ -56,5 +60,48 @@ func (l *EVMEvent) Verify() error {
 		}
 	}
 
+	// The block and transaction hashes are optional, since they were only added later.
+	if len(l.BlockHash) != 0 && len(l.BlockHash) != len(common.Hash{}) {
+		return errors.New("invalid block hash length")
+	}
+
+	if len(l.TxHash) != 0 && len(l.TxHash) != len(common.Hash{}) {
+		return errors.New("invalid tx hash length")
+	}
+
+	return nil
+}
+
+// VerifyDecodable returns an error if the EVM log event can't be decoded as the ABI event,
+// i.e., if its topics or data don't match the event's indexed and non-indexed inputs.
+// Note it assumes that Verify has been called before.
+func (l *EVMEvent) VerifyDecodable(event abi.Event) error {
+	if common.BytesToHash(l.Topics[0]) != event.ID {
+		return errors.New("event id mismatch", "event", event.Name)
+	}
+
+	var indexed abi.Arguments
+	for _, input := range event.Inputs {
+		if input.Indexed {
+			indexed = append(indexed, input)
+		}
+	}
+	if len(l.Topics)-1 != len(indexed) {
+		return errors.New("invalid indexed topics count", "event", event.Name, "count", len(l.Topics)-1)
+	}
+
+	topics := make([]common.Hash, 0, len(indexed))
+	for _, t := range l.Topics[1:] {
+		topics = append(topics, common.BytesToHash(t))
+	}
+	if err := abi.ParseTopicsIntoMap(make(map[string]any), indexed, topics); err != nil {
+		return errors.Wrap(err, "decode indexed topics", "event", event.Name)
+	}
+
+	// Note that the data of events without non-indexed inputs isn't decoded, so it is ignored.
+	if _, err := event.Inputs.NonIndexed().Unpack(l.Data); err != nil {
+		return errors.Wrap(err, "decode data", "event", event.Name)
+	}
+
 	return nil
 }
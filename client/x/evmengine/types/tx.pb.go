@@ -7,6 +7,7 @@ import (
 	context "context"
 	fmt "fmt"
 	_ "github.com/cosmos/cosmos-sdk/types/msgservice"
+	_ "github.com/cosmos/gogoproto/gogoproto"
 	grpc1 "github.com/cosmos/gogoproto/grpc"
 	proto "github.com/cosmos/gogoproto/proto"
 	grpc "google.golang.org/grpc"
@@ -34,6 +35,7 @@ type MsgExecutionPayload struct {
 	Authority         string      `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
 	ExecutionPayload  []byte      `protobuf:"bytes,2,opt,name=execution_payload,json=executionPayload,proto3" json:"execution_payload,omitempty"`
 	PrevPayloadEvents []*EVMEvent `protobuf:"bytes,3,rep,name=prev_payload_events,json=prevPayloadEvents,proto3" json:"prev_payload_events,omitempty"`
+	ExecutionRequests [][]byte    `protobuf:"bytes,4,rep,name=execution_requests,json=executionRequests,proto3" json:"execution_requests,omitempty"`
 }
 
 func (m *MsgExecutionPayload) Reset()         { *m = MsgExecutionPayload{} }
@@ -90,6 +92,13 @@ func (m *MsgExecutionPayload) GetPrevPayloadEvents() []*EVMEvent {
 	return nil
 }
 
+func (m *MsgExecutionPayload) GetExecutionRequests() [][]byte {
+	if m != nil {
+		return m.ExecutionRequests
+	}
+	return nil
+}
+
 type ExecutionPayloadResponse struct {
 }
 
@@ -126,19 +135,210 @@ func (m *ExecutionPayloadResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_ExecutionPayloadResponse proto.InternalMessageInfo
 
+// MsgAddVotes defines the votes of the previous block's extended commit,
+// including the validators' vote extensions attesting to its execution block.
+type MsgAddVotes struct {
+	Authority string          `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
+	Round     int32           `protobuf:"varint,2,opt,name=round,proto3" json:"round,omitempty"`
+	Votes     []*ExtendedVote `protobuf:"bytes,3,rep,name=votes,proto3" json:"votes,omitempty"`
+}
+
+func (m *MsgAddVotes) Reset()         { *m = MsgAddVotes{} }
+func (m *MsgAddVotes) String() string { return proto.CompactTextString(m) }
+func (*MsgAddVotes) ProtoMessage()    {}
+func (*MsgAddVotes) Descriptor() ([]byte, []int) {
+	return fileDescriptor_fb28e9d5b0c8eb16, []int{2}
+}
+func (m *MsgAddVotes) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgAddVotes) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgAddVotes.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgAddVotes) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgAddVotes.Merge(m, src)
+}
+func (m *MsgAddVotes) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgAddVotes) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgAddVotes.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgAddVotes proto.InternalMessageInfo
+
+func (m *MsgAddVotes) GetAuthority() string {
+	if m != nil {
+		return m.Authority
+	}
+	return ""
+}
+
+func (m *MsgAddVotes) GetRound() int32 {
+	if m != nil {
+		return m.Round
+	}
+	return 0
+}
+
+func (m *MsgAddVotes) GetVotes() []*ExtendedVote {
+	if m != nil {
+		return m.Votes
+	}
+	return nil
+}
+
+type AddVotesResponse struct {
+}
+
+func (m *AddVotesResponse) Reset()         { *m = AddVotesResponse{} }
+func (m *AddVotesResponse) String() string { return proto.CompactTextString(m) }
+func (*AddVotesResponse) ProtoMessage()    {}
+func (*AddVotesResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_fb28e9d5b0c8eb16, []int{3}
+}
+func (m *AddVotesResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *AddVotesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_AddVotesResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *AddVotesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AddVotesResponse.Merge(m, src)
+}
+func (m *AddVotesResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *AddVotesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_AddVotesResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AddVotesResponse proto.InternalMessageInfo
+
+// MsgUpdateParams defines the new module parameters, submitted via x/gov.
+type MsgUpdateParams struct {
+	Authority string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
+	Params    Params `protobuf:"bytes,2,opt,name=params,proto3" json:"params"`
+}
+
+func (m *MsgUpdateParams) Reset()         { *m = MsgUpdateParams{} }
+func (m *MsgUpdateParams) String() string { return proto.CompactTextString(m) }
+func (*MsgUpdateParams) ProtoMessage()    {}
+func (*MsgUpdateParams) Descriptor() ([]byte, []int) {
+	return fileDescriptor_fb28e9d5b0c8eb16, []int{4}
+}
+func (m *MsgUpdateParams) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgUpdateParams) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUpdateParams.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgUpdateParams) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUpdateParams.Merge(m, src)
+}
+func (m *MsgUpdateParams) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUpdateParams) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUpdateParams.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgUpdateParams proto.InternalMessageInfo
+
+func (m *MsgUpdateParams) GetAuthority() string {
+	if m != nil {
+		return m.Authority
+	}
+	return ""
+}
+
+func (m *MsgUpdateParams) GetParams() Params {
+	if m != nil {
+		return m.Params
+	}
+	return Params{}
+}
+
+type MsgUpdateParamsResponse struct {
+}
+
+func (m *MsgUpdateParamsResponse) Reset()         { *m = MsgUpdateParamsResponse{} }
+func (m *MsgUpdateParamsResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgUpdateParamsResponse) ProtoMessage()    {}
+func (*MsgUpdateParamsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_fb28e9d5b0c8eb16, []int{5}
+}
+func (m *MsgUpdateParamsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgUpdateParamsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUpdateParamsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgUpdateParamsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUpdateParamsResponse.Merge(m, src)
+}
+func (m *MsgUpdateParamsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUpdateParamsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUpdateParamsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgUpdateParamsResponse proto.InternalMessageInfo
+
 // EVMEvent represents a contract log event.
-// Derived fields are not included in the protobuf.
+// Derived fields are not included in the protobuf, except the ones locating the log event in the execution chain,
+// which are only set once enabled by the evm_event_positions param.
 type EVMEvent struct {
-	Address []byte   `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
-	Topics  [][]byte `protobuf:"bytes,2,rep,name=topics,proto3" json:"topics,omitempty"`
-	Data    []byte   `protobuf:"bytes,3,opt,name=data,proto3" json:"data,omitempty"`
+	Address   []byte   `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	Topics    [][]byte `protobuf:"bytes,2,rep,name=topics,proto3" json:"topics,omitempty"`
+	Data      []byte   `protobuf:"bytes,3,opt,name=data,proto3" json:"data,omitempty"`
+	BlockHash []byte   `protobuf:"bytes,4,opt,name=block_hash,json=blockHash,proto3" json:"block_hash,omitempty"`
+	TxHash    []byte   `protobuf:"bytes,5,opt,name=tx_hash,json=txHash,proto3" json:"tx_hash,omitempty"`
+	LogIndex  uint64   `protobuf:"varint,6,opt,name=log_index,json=logIndex,proto3" json:"log_index,omitempty"`
 }
 
 func (m *EVMEvent) Reset()         { *m = EVMEvent{} }
 func (m *EVMEvent) String() string { return proto.CompactTextString(m) }
 func (*EVMEvent) ProtoMessage()    {}
 func (*EVMEvent) Descriptor() ([]byte, []int) {
-	return fileDescriptor_fb28e9d5b0c8eb16, []int{2}
+	return fileDescriptor_fb28e9d5b0c8eb16, []int{6}
 }
 func (m *EVMEvent) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -188,39 +388,79 @@ func (m *EVMEvent) GetData() []byte {
 	return nil
 }
 
+func (m *EVMEvent) GetBlockHash() []byte {
+	if m != nil {
+		return m.BlockHash
+	}
+	return nil
+}
+
+func (m *EVMEvent) GetTxHash() []byte {
+	if m != nil {
+		return m.TxHash
+	}
+	return nil
+}
+
+func (m *EVMEvent) GetLogIndex() uint64 {
+	if m != nil {
+		return m.LogIndex
+	}
+	return 0
+}
+
 func init() {
 	proto.RegisterType((*MsgExecutionPayload)(nil), "client.x.evmengine.types.MsgExecutionPayload")
 	proto.RegisterType((*ExecutionPayloadResponse)(nil), "client.x.evmengine.types.ExecutionPayloadResponse")
+	proto.RegisterType((*MsgAddVotes)(nil), "client.x.evmengine.types.MsgAddVotes")
+	proto.RegisterType((*AddVotesResponse)(nil), "client.x.evmengine.types.AddVotesResponse")
+	proto.RegisterType((*MsgUpdateParams)(nil), "client.x.evmengine.types.MsgUpdateParams")
+	proto.RegisterType((*MsgUpdateParamsResponse)(nil), "client.x.evmengine.types.MsgUpdateParamsResponse")
 	proto.RegisterType((*EVMEvent)(nil), "client.x.evmengine.types.EVMEvent")
 }
 
 func init() { proto.RegisterFile("client/x/evmengine/types/tx.proto", fileDescriptor_fb28e9d5b0c8eb16) }
 
 var fileDescriptor_fb28e9d5b0c8eb16 = []byte{
-	// 354 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x7c, 0x92, 0x31, 0x4b, 0xc3, 0x40,
-	0x14, 0xc7, 0x7b, 0x8d, 0x56, 0x7b, 0x16, 0x69, 0xaf, 0xa0, 0x47, 0x90, 0x10, 0x33, 0x85, 0x8a,
-	0x09, 0xc6, 0xcd, 0x51, 0xe8, 0x58, 0x28, 0x27, 0x38, 0xb8, 0x94, 0x98, 0x3c, 0x62, 0xa0, 0xcd,
-	0x85, 0xdc, 0x35, 0xa4, 0x9b, 0x38, 0x3a, 0xf9, 0x51, 0xfa, 0x21, 0x1c, 0x1c, 0x3b, 0x3a, 0x4a,
-	0x3b, 0xf4, 0x6b, 0x48, 0xd3, 0xc6, 0x42, 0x35, 0x4e, 0x77, 0xef, 0xf1, 0xfb, 0x3f, 0xde, 0xff,
-	0x7f, 0x87, 0xcf, 0xbd, 0x61, 0x08, 0x91, 0xb4, 0x33, 0x1b, 0xd2, 0x11, 0x44, 0x41, 0x18, 0x81,
-	0x2d, 0x27, 0x31, 0x08, 0x5b, 0x66, 0x56, 0x9c, 0x70, 0xc9, 0x09, 0x5d, 0x23, 0x56, 0x66, 0xfd,
-	0x20, 0x56, 0x8e, 0xa8, 0xa7, 0x1e, 0x17, 0x23, 0x2e, 0xec, 0x91, 0x08, 0xec, 0xf4, 0x6a, 0x75,
-	0xac, 0x25, 0xc6, 0x3b, 0xc2, 0xed, 0x9e, 0x08, 0xba, 0x19, 0x78, 0x63, 0x19, 0xf2, 0xa8, 0xef,
-	0x4e, 0x86, 0xdc, 0xf5, 0xc9, 0x19, 0xae, 0xbb, 0x63, 0xf9, 0xc4, 0x93, 0x50, 0x4e, 0x28, 0xd2,
-	0x91, 0x59, 0x67, 0xdb, 0x06, 0xb9, 0xc0, 0x2d, 0x28, 0x14, 0x83, 0x78, 0x2d, 0xa1, 0x55, 0x1d,
-	0x99, 0x0d, 0xd6, 0x84, 0xdd, 0x51, 0x0c, 0xb7, 0xe3, 0x04, 0xd2, 0x82, 0x1b, 0x40, 0x0a, 0x91,
-	0x14, 0x54, 0xd1, 0x15, 0xf3, 0xc8, 0x31, 0xac, 0xb2, 0x9d, 0xad, 0xee, 0x7d, 0xaf, 0xbb, 0x42,
-	0x59, 0x6b, 0x25, 0xdf, 0x4c, 0xcb, 0x3b, 0xe2, 0xe6, 0xf8, 0x65, 0x39, 0xed, 0x6c, 0x17, 0x32,
-	0x54, 0x4c, 0x77, 0x2d, 0x30, 0x10, 0x31, 0x8f, 0x04, 0x18, 0x7d, 0x7c, 0x58, 0x8c, 0x22, 0x14,
-	0x1f, 0xb8, 0xbe, 0x9f, 0x80, 0x10, 0xb9, 0xa9, 0x06, 0x2b, 0x4a, 0x72, 0x82, 0x6b, 0x92, 0xc7,
-	0xa1, 0x27, 0x68, 0x55, 0x57, 0xcc, 0x06, 0xdb, 0x54, 0x84, 0xe0, 0x3d, 0xdf, 0x95, 0x2e, 0x55,
-	0x72, 0x3c, 0xbf, 0x3b, 0xaf, 0x08, 0xe3, 0x9e, 0x08, 0xee, 0x20, 0x49, 0x43, 0x0f, 0xc8, 0x18,
-	0x37, 0x7f, 0xe5, 0x77, 0x59, 0xee, 0xeb, 0x8f, 0xb8, 0x55, 0xe7, 0x9f, 0x18, 0x4a, 0x7c, 0xa9,
-	0xfb, 0xcf, 0xcb, 0x69, 0x07, 0xdd, 0x3a, 0x1f, 0x73, 0x0d, 0xcd, 0xe6, 0x1a, 0xfa, 0x9a, 0x6b,
-	0xe8, 0x6d, 0xa1, 0x55, 0x66, 0x0b, 0xad, 0xf2, 0xb9, 0xd0, 0x2a, 0x0f, 0xb4, 0xec, 0xc7, 0x3c,
-	0xd6, 0xf2, 0xc7, 0xbf, 0xfe, 0x0e, 0x00, 0x00, 0xff, 0xff, 0x1c, 0x1e, 0x78, 0x3c, 0x54, 0x02,
-	0x00, 0x00,
+	// 603 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x94, 0xcb, 0x6e, 0xd3, 0x4c,
+	0x14, 0xc7, 0xe3, 0xe6, 0xd2, 0xe6, 0x34, 0xfa, 0xbe, 0x76, 0x5a, 0x51, 0x63, 0xc0, 0x98, 0x88,
+	0xa2, 0x10, 0x54, 0x5b, 0x0d, 0x3b, 0x84, 0x90, 0xa8, 0x14, 0x09, 0x16, 0x91, 0xaa, 0x41, 0x74,
+	0x81, 0x84, 0x22, 0xd7, 0x1e, 0x39, 0x16, 0x8e, 0xc7, 0x78, 0x26, 0x96, 0xb3, 0x02, 0xf1, 0x02,
+	0xf0, 0x12, 0xec, 0xfb, 0x18, 0x5d, 0x76, 0x07, 0x2b, 0x84, 0x92, 0x45, 0x1f, 0x80, 0x17, 0x40,
+	0x1e, 0xdb, 0x09, 0x24, 0x75, 0xca, 0xca, 0x33, 0x73, 0xfe, 0x67, 0xce, 0xef, 0x5c, 0x3c, 0x70,
+	0xcf, 0xf2, 0x5c, 0xe2, 0x73, 0x23, 0x36, 0x48, 0x34, 0x24, 0xbe, 0xe3, 0xfa, 0xc4, 0xe0, 0xe3,
+	0x80, 0x30, 0x83, 0xc7, 0x7a, 0x10, 0x52, 0x4e, 0x91, 0x9c, 0x4a, 0xf4, 0x58, 0x9f, 0x49, 0x74,
+	0x21, 0x51, 0xf6, 0x2c, 0xca, 0x86, 0x94, 0x19, 0x43, 0xe6, 0x18, 0xd1, 0x61, 0xf2, 0x49, 0x5d,
+	0x94, 0x5d, 0x87, 0x3a, 0x54, 0x2c, 0x8d, 0x64, 0x95, 0x9d, 0xee, 0x17, 0xc6, 0x0a, 0xcc, 0xd0,
+	0x1c, 0xb2, 0x4c, 0x76, 0xbf, 0x50, 0x16, 0x51, 0x4e, 0x32, 0x55, 0xf3, 0x97, 0x04, 0x3b, 0x3d,
+	0xe6, 0x74, 0x63, 0x62, 0x8d, 0xb8, 0x4b, 0xfd, 0x63, 0x73, 0xec, 0x51, 0xd3, 0x46, 0xb7, 0xa1,
+	0x6e, 0x8e, 0xf8, 0x80, 0x86, 0x2e, 0x1f, 0xcb, 0x92, 0x26, 0xb5, 0xea, 0x78, 0x7e, 0x80, 0x1e,
+	0xc1, 0x36, 0xc9, 0x3d, 0xfa, 0x41, 0xea, 0x22, 0xaf, 0x69, 0x52, 0xab, 0x81, 0xb7, 0xc8, 0xe2,
+	0x55, 0x18, 0x76, 0x82, 0x90, 0x44, 0xb9, 0xae, 0x4f, 0x22, 0xe2, 0x73, 0x26, 0x97, 0xb5, 0x72,
+	0x6b, 0xb3, 0xd3, 0xd4, 0x8b, 0xca, 0xa2, 0x77, 0x4f, 0x7a, 0xdd, 0x44, 0x8a, 0xb7, 0x13, 0xf7,
+	0xec, 0x36, 0x71, 0xc2, 0xd0, 0x01, 0xa0, 0x39, 0x40, 0x48, 0xde, 0x8f, 0x08, 0xe3, 0x4c, 0xae,
+	0x68, 0xe5, 0x56, 0x03, 0xcf, 0xd1, 0x70, 0x66, 0x78, 0xf2, 0xdf, 0xa7, 0xcb, 0xb3, 0xf6, 0x9c,
+	0xbf, 0xa9, 0x80, 0xbc, 0x98, 0x31, 0x26, 0x2c, 0xa0, 0x3e, 0x23, 0xcd, 0xcf, 0x12, 0x6c, 0xf6,
+	0x98, 0xf3, 0xdc, 0xb6, 0x4f, 0x92, 0x3a, 0x5d, 0x53, 0x89, 0x5d, 0xa8, 0x86, 0x74, 0xe4, 0xa7,
+	0xd9, 0x57, 0x71, 0xba, 0x41, 0x4f, 0xa1, 0x2a, 0x8a, 0x9c, 0x25, 0xf9, 0x60, 0x45, 0x92, 0x31,
+	0x27, 0xbe, 0x4d, 0x44, 0x2c, 0x9c, 0x3a, 0x2d, 0xd1, 0x22, 0xd8, 0xca, 0x69, 0x66, 0x94, 0x1f,
+	0xe0, 0xff, 0x1e, 0x73, 0x5e, 0x07, 0xb6, 0xc9, 0xc9, 0xb1, 0x68, 0xfb, 0x35, 0xa0, 0xcf, 0xa0,
+	0x96, 0x8e, 0x87, 0x20, 0xdd, 0xec, 0x68, 0xc5, 0x4c, 0xe9, 0x7d, 0x47, 0x95, 0xf3, 0x1f, 0x77,
+	0x4b, 0x38, 0xf3, 0x5a, 0x82, 0xba, 0x09, 0x7b, 0x0b, 0x00, 0x33, 0xb6, 0xaf, 0x12, 0x6c, 0xe4,
+	0xcd, 0x43, 0x32, 0xac, 0x9b, 0xb6, 0x1d, 0x12, 0xc6, 0x04, 0x53, 0x03, 0xe7, 0x5b, 0x74, 0x03,
+	0x6a, 0x9c, 0x06, 0xae, 0x95, 0x10, 0x25, 0x7d, 0xcb, 0x76, 0x08, 0x41, 0xc5, 0x36, 0xb9, 0x29,
+	0x97, 0x85, 0x5c, 0xac, 0xd1, 0x1d, 0x80, 0x53, 0x8f, 0x5a, 0xef, 0xfa, 0x03, 0x93, 0x0d, 0xe4,
+	0x8a, 0xb0, 0xd4, 0xc5, 0xc9, 0x0b, 0x93, 0x0d, 0xd0, 0x1e, 0xac, 0xf3, 0x38, 0xb5, 0x55, 0x85,
+	0xad, 0xc6, 0x63, 0x61, 0xb8, 0x05, 0x75, 0x8f, 0x3a, 0x7d, 0xd7, 0xb7, 0x49, 0x2c, 0xd7, 0x34,
+	0xa9, 0x55, 0xc1, 0x1b, 0x1e, 0x75, 0x5e, 0x26, 0xfb, 0xce, 0xb7, 0x35, 0x80, 0x1e, 0x73, 0x5e,
+	0x91, 0x30, 0x72, 0x2d, 0x82, 0x46, 0xb0, 0xb5, 0xf4, 0x1b, 0x1c, 0x14, 0x57, 0xe9, 0x8a, 0xbf,
+	0x46, 0xe9, 0xac, 0x6a, 0xf4, 0xd5, 0xf3, 0x86, 0xde, 0xc2, 0xc6, 0x6c, 0xd6, 0xf6, 0x57, 0x86,
+	0xcb, 0x65, 0x4a, 0xbb, 0x58, 0xb6, 0x38, 0x28, 0xc8, 0x83, 0xc6, 0x5f, 0x53, 0xf2, 0x70, 0x65,
+	0x88, 0x3f, 0xa5, 0xca, 0xe1, 0x3f, 0x4b, 0xf3, 0x68, 0x4a, 0xf5, 0xe3, 0xe5, 0x59, 0x5b, 0x3a,
+	0xea, 0x9c, 0x4f, 0x54, 0xe9, 0x62, 0xa2, 0x4a, 0x3f, 0x27, 0xaa, 0xf4, 0x65, 0xaa, 0x96, 0x2e,
+	0xa6, 0x6a, 0xe9, 0xfb, 0x54, 0x2d, 0xbd, 0x91, 0x8b, 0x5e, 0xa5, 0xd3, 0x9a, 0x78, 0x90, 0x1e,
+	0xff, 0x1e, 0x00, 0xd9, 0x23, 0x45, 0xd8, 0x4b, 0x05, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -237,6 +477,10 @@ const _ = grpc.SupportPackageIsVersion4
 type MsgServiceClient interface {
 	// ExecutionPayload submits a new execution payload from consensus to the StoryEVM.
 	ExecutionPayload(ctx context.Context, in *MsgExecutionPayload, opts ...grpc.CallOption) (*ExecutionPayloadResponse, error)
+	// AddVotes submits the vote extensions of the previous block, attesting to its execution block.
+	AddVotes(ctx context.Context, in *MsgAddVotes, opts ...grpc.CallOption) (*AddVotesResponse, error)
+	// UpdateParams updates the module parameters, it can only be executed by the module authority.
+	UpdateParams(ctx context.Context, in *MsgUpdateParams, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error)
 }
 
 type msgServiceClient struct {
@@ -256,10 +500,32 @@ func (c *msgServiceClient) ExecutionPayload(ctx context.Context, in *MsgExecutio
 	return out, nil
 }
 
+func (c *msgServiceClient) AddVotes(ctx context.Context, in *MsgAddVotes, opts ...grpc.CallOption) (*AddVotesResponse, error) {
+	out := new(AddVotesResponse)
+	err := c.cc.Invoke(ctx, "/client.x.evmengine.types.MsgService/AddVotes", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgServiceClient) UpdateParams(ctx context.Context, in *MsgUpdateParams, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error) {
+	out := new(MsgUpdateParamsResponse)
+	err := c.cc.Invoke(ctx, "/client.x.evmengine.types.MsgService/UpdateParams", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // MsgServiceServer is the server API for MsgService service.
 type MsgServiceServer interface {
 	// ExecutionPayload submits a new execution payload from consensus to the StoryEVM.
 	ExecutionPayload(context.Context, *MsgExecutionPayload) (*ExecutionPayloadResponse, error)
+	// AddVotes submits the vote extensions of the previous block, attesting to its execution block.
+	AddVotes(context.Context, *MsgAddVotes) (*AddVotesResponse, error)
+	// UpdateParams updates the module parameters, it can only be executed by the module authority.
+	UpdateParams(context.Context, *MsgUpdateParams) (*MsgUpdateParamsResponse, error)
 }
 
 // UnimplementedMsgServiceServer can be embedded to have forward compatible implementations.
@@ -269,6 +535,12 @@ type UnimplementedMsgServiceServer struct {
 func (*UnimplementedMsgServiceServer) ExecutionPayload(ctx context.Context, req *MsgExecutionPayload) (*ExecutionPayloadResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ExecutionPayload not implemented")
 }
+func (*UnimplementedMsgServiceServer) AddVotes(ctx context.Context, req *MsgAddVotes) (*AddVotesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddVotes not implemented")
+}
+func (*UnimplementedMsgServiceServer) UpdateParams(ctx context.Context, req *MsgUpdateParams) (*MsgUpdateParamsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateParams not implemented")
+}
 
 func RegisterMsgServiceServer(s grpc1.Server, srv MsgServiceServer) {
 	s.RegisterService(&_MsgService_serviceDesc, srv)
@@ -292,6 +564,42 @@ func _MsgService_ExecutionPayload_Handler(srv interface{}, ctx context.Context,
 	return interceptor(ctx, in, info, handler)
 }
 
+func _MsgService_AddVotes_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgAddVotes)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServiceServer).AddVotes(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/client.x.evmengine.types.MsgService/AddVotes",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServiceServer).AddVotes(ctx, req.(*MsgAddVotes))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MsgService_UpdateParams_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUpdateParams)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServiceServer).UpdateParams(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/client.x.evmengine.types.MsgService/UpdateParams",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServiceServer).UpdateParams(ctx, req.(*MsgUpdateParams))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _MsgService_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "client.x.evmengine.types.MsgService",
 	HandlerType: (*MsgServiceServer)(nil),
@@ -300,6 +608,14 @@ var _MsgService_serviceDesc = grpc.ServiceDesc{
 			MethodName: "ExecutionPayload",
 			Handler:    _MsgService_ExecutionPayload_Handler,
 		},
+		{
+			MethodName: "AddVotes",
+			Handler:    _MsgService_AddVotes_Handler,
+		},
+		{
+			MethodName: "UpdateParams",
+			Handler:    _MsgService_UpdateParams_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "client/x/evmengine/types/tx.proto",
@@ -325,6 +641,15 @@ func (m *MsgExecutionPayload) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.ExecutionRequests) > 0 {
+		for iNdEx := len(m.ExecutionRequests) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.ExecutionRequests[iNdEx])
+			copy(dAtA[i:], m.ExecutionRequests[iNdEx])
+			i = encodeVarintTx(dAtA, i, uint64(len(m.ExecutionRequests[iNdEx])))
+			i--
+			dAtA[i] = 0x22
+		}
+	}
 	if len(m.PrevPayloadEvents) > 0 {
 		for iNdEx := len(m.PrevPayloadEvents) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -379,7 +704,7 @@ func (m *ExecutionPayloadResponse) MarshalToSizedBuffer(dAtA []byte) (int, error
 	return len(dAtA) - i, nil
 }
 
-func (m *EVMEvent) Marshal() (dAtA []byte, err error) {
+func (m *MsgAddVotes) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -389,80 +714,295 @@ func (m *EVMEvent) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *EVMEvent) MarshalTo(dAtA []byte) (int, error) {
+func (m *MsgAddVotes) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *EVMEvent) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *MsgAddVotes) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.Data) > 0 {
-		i -= len(m.Data)
-		copy(dAtA[i:], m.Data)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Data)))
-		i--
-		dAtA[i] = 0x1a
-	}
-	if len(m.Topics) > 0 {
-		for iNdEx := len(m.Topics) - 1; iNdEx >= 0; iNdEx-- {
-			i -= len(m.Topics[iNdEx])
-			copy(dAtA[i:], m.Topics[iNdEx])
-			i = encodeVarintTx(dAtA, i, uint64(len(m.Topics[iNdEx])))
+	if len(m.Votes) > 0 {
+		for iNdEx := len(m.Votes) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Votes[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
 			i--
-			dAtA[i] = 0x12
+			dAtA[i] = 0x1a
 		}
 	}
-	if len(m.Address) > 0 {
-		i -= len(m.Address)
-		copy(dAtA[i:], m.Address)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Address)))
+	if m.Round != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Round))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
 		i--
 		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
-	offset -= sovTx(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *AddVotesResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *MsgExecutionPayload) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Authority)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = len(m.ExecutionPayload)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	if len(m.PrevPayloadEvents) > 0 {
-		for _, e := range m.PrevPayloadEvents {
-			l = e.Size()
-			n += 1 + l + sovTx(uint64(l))
-		}
-	}
-	return n
+
+func (m *AddVotesResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *ExecutionPayloadResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *AddVotesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUpdateParams) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateParams) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUpdateParamsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateParamsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *EVMEvent) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EVMEvent) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EVMEvent) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.LogIndex != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.LogIndex))
+		i--
+		dAtA[i] = 0x30
+	}
+	if len(m.TxHash) > 0 {
+		i -= len(m.TxHash)
+		copy(dAtA[i:], m.TxHash)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.TxHash)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.BlockHash) > 0 {
+		i -= len(m.BlockHash)
+		copy(dAtA[i:], m.BlockHash)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.BlockHash)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Data) > 0 {
+		i -= len(m.Data)
+		copy(dAtA[i:], m.Data)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Data)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Topics) > 0 {
+		for iNdEx := len(m.Topics) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Topics[iNdEx])
+			copy(dAtA[i:], m.Topics[iNdEx])
+			i = encodeVarintTx(dAtA, i, uint64(len(m.Topics[iNdEx])))
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
+	offset -= sovTx(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *MsgExecutionPayload) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.ExecutionPayload)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.PrevPayloadEvents) > 0 {
+		for _, e := range m.PrevPayloadEvents {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	if len(m.ExecutionRequests) > 0 {
+		for _, b := range m.ExecutionRequests {
+			l = len(b)
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *ExecutionPayloadResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgAddVotes) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.Round != 0 {
+		n += 1 + sovTx(uint64(m.Round))
+	}
+	if len(m.Votes) > 0 {
+		for _, e := range m.Votes {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *AddVotesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgUpdateParams) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.Params.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgUpdateParamsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
 	var l int
 	_ = l
 	return n
@@ -484,20 +1024,263 @@ func (m *EVMEvent) Size() (n int) {
 			n += 1 + l + sovTx(uint64(l))
 		}
 	}
-	l = len(m.Data)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	return n
-}
+	l = len(m.Data)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.BlockHash)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.TxHash)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.LogIndex != 0 {
+		n += 1 + sovTx(uint64(m.LogIndex))
+	}
+	return n
+}
+
+func sovTx(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozTx(x uint64) (n int) {
+	return sovTx(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *MsgExecutionPayload) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgExecutionPayload: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgExecutionPayload: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Authority = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExecutionPayload", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ExecutionPayload = append(m.ExecutionPayload[:0], dAtA[iNdEx:postIndex]...)
+			if m.ExecutionPayload == nil {
+				m.ExecutionPayload = []byte{}
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PrevPayloadEvents", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PrevPayloadEvents = append(m.PrevPayloadEvents, &EVMEvent{})
+			if err := m.PrevPayloadEvents[len(m.PrevPayloadEvents)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExecutionRequests", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ExecutionRequests = append(m.ExecutionRequests, make([]byte, postIndex-iNdEx))
+			copy(m.ExecutionRequests[len(m.ExecutionRequests)-1], dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ExecutionPayloadResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ExecutionPayloadResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ExecutionPayloadResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
 
-func sovTx(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozTx(x uint64) (n int) {
-	return sovTx(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
 }
-func (m *MsgExecutionPayload) Unmarshal(dAtA []byte) error {
+func (m *MsgAddVotes) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -520,10 +1303,10 @@ func (m *MsgExecutionPayload) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgExecutionPayload: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgAddVotes: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgExecutionPayload: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgAddVotes: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -559,10 +1342,29 @@ func (m *MsgExecutionPayload) Unmarshal(dAtA []byte) error {
 			m.Authority = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Round", wireType)
+			}
+			m.Round = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Round |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ExecutionPayload", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Votes", wireType)
 			}
-			var byteLen int
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -572,29 +1374,161 @@ func (m *MsgExecutionPayload) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ExecutionPayload = append(m.ExecutionPayload[:0], dAtA[iNdEx:postIndex]...)
-			if m.ExecutionPayload == nil {
-				m.ExecutionPayload = []byte{}
+			m.Votes = append(m.Votes, &ExtendedVote{})
+			if err := m.Votes[len(m.Votes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
 			iNdEx = postIndex
-		case 3:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *AddVotesResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AddVotesResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AddVotesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgUpdateParams) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpdateParams: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpdateParams: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PrevPayloadEvents", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Authority = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -621,8 +1555,7 @@ func (m *MsgExecutionPayload) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.PrevPayloadEvents = append(m.PrevPayloadEvents, &EVMEvent{})
-			if err := m.PrevPayloadEvents[len(m.PrevPayloadEvents)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -647,7 +1580,7 @@ func (m *MsgExecutionPayload) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ExecutionPayloadResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgUpdateParamsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -670,10 +1603,10 @@ func (m *ExecutionPayloadResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ExecutionPayloadResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgUpdateParamsResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ExecutionPayloadResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgUpdateParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -826,6 +1759,93 @@ func (m *EVMEvent) Unmarshal(dAtA []byte) error {
 				m.Data = []byte{}
 			}
 			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockHash", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BlockHash = append(m.BlockHash[:0], dAtA[iNdEx:postIndex]...)
+			if m.BlockHash == nil {
+				m.BlockHash = []byte{}
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TxHash", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TxHash = append(m.TxHash[:0], dAtA[iNdEx:postIndex]...)
+			if m.TxHash == nil {
+				m.TxHash = []byte{}
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LogIndex", wireType)
+			}
+			m.LogIndex = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LogIndex |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
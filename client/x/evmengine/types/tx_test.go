@@ -141,6 +141,24 @@ func TestEVMEvent_Verify(t *testing.T) {
 			},
 			expectedErr: "invalid topic length",
 		},
+		{
+			name: "fail: invalid block hash length",
+			evmEvent: &types.EVMEvent{
+				Address:   dummyContractAddress.Bytes(),
+				Topics:    [][]byte{types.SoftwareUpgradeEvent.ID.Bytes()},
+				BlockHash: []byte{0x01},
+			},
+			expectedErr: "invalid block hash length",
+		},
+		{
+			name: "fail: invalid tx hash length",
+			evmEvent: &types.EVMEvent{
+				Address: dummyContractAddress.Bytes(),
+				Topics:  [][]byte{types.SoftwareUpgradeEvent.ID.Bytes()},
+				TxHash:  []byte{0x01},
+			},
+			expectedErr: "invalid tx hash length",
+		},
 		{
 			name: "pass: valid log",
 			evmEvent: &types.EVMEvent{
@@ -149,6 +167,17 @@ func TestEVMEvent_Verify(t *testing.T) {
 				Data:    data,
 			},
 		},
+		{
+			name: "pass: valid log with location",
+			evmEvent: &types.EVMEvent{
+				Address:   dummyContractAddress.Bytes(),
+				Topics:    [][]byte{types.SoftwareUpgradeEvent.ID.Bytes()},
+				Data:      data,
+				BlockHash: common.HexToHash("0x01").Bytes(),
+				TxHash:    common.HexToHash("0x02").Bytes(),
+				LogIndex:  1,
+			},
+		},
 	}
 
 	for _, tc := range tcs {
@@ -3,6 +3,8 @@ package types
 import "github.com/piplabs/story/contracts/bindings"
 
 var (
-	ubiPoolABI            = mustGetABI(bindings.UBIPoolMetaData)
-	UBIPercentageSetEvent = mustGetEvent(ubiPoolABI, "UBIPercentageSet")
+	ubiPoolABI              = mustGetABI(bindings.UBIPoolMetaData)
+	UBIPercentageSetEvent   = mustGetEvent(ubiPoolABI, "UBIPercentageSet")
+	UBIDistributionSetEvent = mustGetEvent(ubiPoolABI, "UBIDistributionSet")
+	UBIClaimedEvent         = mustGetEvent(ubiPoolABI, "UBIClaimed")
 )
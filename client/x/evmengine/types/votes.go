@@ -0,0 +1,36 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/common"
+
+	"github.com/piplabs/story/lib/errors"
+)
+
+// Verify returns an error if the execution vote is malformed.
+func (v *ExecutionVote) Verify() error {
+	if v == nil {
+		return errors.New("nil vote")
+	}
+
+	if len(v.BlockHash) != len(common.Hash{}) {
+		return errors.New("invalid block hash length")
+	}
+
+	if len(v.ParentHash) != len(common.Hash{}) {
+		return errors.New("invalid parent hash length")
+	}
+
+	return nil
+}
+
+// VoteExtensionsEnabled returns true if the extended commit of the previous block includes vote extensions,
+// i.e. if vote extensions were enabled at the previous height. Proposals must then include MsgAddVotes.
+func VoteExtensionsEnabled(ctx sdk.Context) bool {
+	cp := ctx.ConsensusParams()
+	if cp.Abci == nil || cp.Abci.VoteExtensionsEnableHeight == 0 {
+		return false
+	}
+
+	return ctx.BlockHeight() > cp.Abci.VoteExtensionsEnableHeight
+}
@@ -20,6 +20,14 @@ func (k *Keeper) EndBlock(ctx context.Context) (abci.ValidatorUpdates, error) {
 	log.Debug(ctx, "EndBlock.evmstaking")
 	defer telemetry.ModuleMeasureSince(types.ModuleName, time.Now(), telemetry.MetricKeyEndBlocker)
 
+	if err := k.PruneFailedEvents(ctx); err != nil {
+		return nil, errors.Wrap(err, "prune failed events")
+	}
+
+	if err := k.PruneUbiWithdrawals(ctx); err != nil {
+		return nil, errors.Wrap(err, "prune ubi withdrawals")
+	}
+
 	isSingularity, err := k.IsSingularity(ctx)
 	if err != nil {
 		return nil, err
@@ -0,0 +1,151 @@
+package keeper
+
+import (
+	"context"
+	"math/big"
+
+	"github.com/piplabs/story/client/x/evmstaking/types"
+	"github.com/piplabs/story/contracts/bindings"
+	"github.com/piplabs/story/lib/errors"
+)
+
+// ProcessMinStakeAmountSet syncs the min stake amount of the IPTokenStaking contract, already converted to gwei.
+func (k Keeper) ProcessMinStakeAmountSet(ctx context.Context, ev *bindings.IPTokenStakingMinStakeAmountSet) error {
+	if !ev.MinStakeAmount.IsUint64() {
+		return errors.WrapErrWithCode(errors.InvalidRequest, errors.New("min stake amount overflows uint64"))
+	}
+
+	return k.updateParams(ctx, func(params *types.Params) {
+		params.MinStakeAmount = ev.MinStakeAmount.Uint64()
+	})
+}
+
+// ProcessMinUnstakeAmountSet syncs the min unstake amount of the IPTokenStaking contract, already converted to gwei.
+func (k Keeper) ProcessMinUnstakeAmountSet(ctx context.Context, ev *bindings.IPTokenStakingMinUnstakeAmountSet) error {
+	if !ev.MinUnstakeAmount.IsUint64() {
+		return errors.WrapErrWithCode(errors.InvalidRequest, errors.New("min unstake amount overflows uint64"))
+	}
+
+	return k.updateParams(ctx, func(params *types.Params) {
+		params.MinUnstakeAmount = ev.MinUnstakeAmount.Uint64()
+	})
+}
+
+// ProcessMinCommissionRateChanged syncs the min commission rate in bips of the IPTokenStaking contract.
+func (k Keeper) ProcessMinCommissionRateChanged(ctx context.Context, ev *bindings.IPTokenStakingMinCommissionRateChanged) error {
+	if ev.MinCommissionRate.Cmp(big.NewInt(int64(types.MaxCommissionRate))) > 0 {
+		return errors.WrapErrWithCode(errors.InvalidCommissionRate, errors.New("min commission rate exceeds 100%",
+			"min_commission_rate", ev.MinCommissionRate.String()))
+	}
+
+	return k.updateParams(ctx, func(params *types.Params) {
+		params.MinCommissionRate = uint32(ev.MinCommissionRate.Uint64())
+	})
+}
+
+// ProcessFeeSet syncs the fee of the IPTokenStaking contract, already converted to gwei.
+func (k Keeper) ProcessFeeSet(ctx context.Context, ev *bindings.IPTokenStakingFeeSet) error {
+	if !ev.NewFee.IsUint64() {
+		return errors.WrapErrWithCode(errors.InvalidRequest, errors.New("fee overflows uint64"))
+	}
+
+	return k.updateParams(ctx, func(params *types.Params) {
+		params.Fee = ev.NewFee.Uint64()
+	})
+}
+
+// SeedContractParams sets the params of the IPTokenStaking contract, and enables syncing them from its events.
+// Chains that didn't sync the params from genesis missed the events setting them, so they must be seeded
+// before being enforced. The amounts are in gwei, like the amounts of the events.
+func (k Keeper) SeedContractParams(ctx context.Context, minStakeAmount, minUnstakeAmount uint64, minCommissionRate uint32, fee uint64) error {
+	params, err := k.GetParams(ctx)
+	if err != nil {
+		return errors.Wrap(err, "get params")
+	}
+
+	params.MinStakeAmount = minStakeAmount
+	params.MinUnstakeAmount = minUnstakeAmount
+	params.MinCommissionRate = minCommissionRate
+	params.Fee = fee
+	params.SyncContractParams = true
+	if err := params.Validate(); err != nil {
+		return errors.Wrap(err, "validate params")
+	}
+
+	return k.SetParams(ctx, params)
+}
+
+// updateParams applies the update to the params, only setting them if they remain valid.
+// The update is skipped until the contract params are synced, see Params.SyncContractParams.
+func (k Keeper) updateParams(ctx context.Context, update func(params *types.Params)) error {
+	params, err := k.GetParams(ctx)
+	if err != nil {
+		return errors.Wrap(err, "get params")
+	} else if !params.GetSyncContractParams() {
+		return nil
+	}
+
+	update(&params)
+	if err := params.Validate(); err != nil {
+		return errors.Wrap(err, "validate params")
+	}
+
+	return k.SetParams(ctx, params)
+}
+
+// checkMinStakeAmount returns an error if the stake amount in gwei is below the min stake amount
+// of the IPTokenStaking contract. No min is enforced until the contract params are synced.
+func (k Keeper) checkMinStakeAmount(ctx context.Context, amount *big.Int) error {
+	params, err := k.GetParams(ctx)
+	if err != nil {
+		return errors.Wrap(err, "get params")
+	} else if !params.GetSyncContractParams() {
+		return nil
+	}
+	minStakeAmount := params.GetMinStakeAmount()
+
+	if amount.Cmp(new(big.Int).SetUint64(minStakeAmount)) < 0 {
+		return errors.WrapErrWithCode(errors.InvalidDelegationAmount, errors.New("stake amount under min",
+			"amount", amount.String(), "min_stake_amount", minStakeAmount))
+	}
+
+	return nil
+}
+
+// checkMinUnstakeAmount returns an error if the unstake amount in gwei is below the min unstake amount
+// of the IPTokenStaking contract. No min is enforced until the contract params are synced.
+func (k Keeper) checkMinUnstakeAmount(ctx context.Context, amount *big.Int) error {
+	params, err := k.GetParams(ctx)
+	if err != nil {
+		return errors.Wrap(err, "get params")
+	} else if !params.GetSyncContractParams() {
+		return nil
+	}
+	minUnstakeAmount := params.GetMinUnstakeAmount()
+
+	if amount.Cmp(new(big.Int).SetUint64(minUnstakeAmount)) < 0 {
+		return errors.WrapErrWithCode(errors.InvalidDelegationAmount, errors.New("unstake amount under min",
+			"amount", amount.String(), "min_unstake_amount", minUnstakeAmount))
+	}
+
+	return nil
+}
+
+// checkMinCommissionRate returns an error if the commission rate in bips is below the min commission rate
+// of the IPTokenStaking contract. No min is enforced until the contract params are synced.
+func (k Keeper) checkMinCommissionRate(ctx context.Context, commissionRate uint32) error {
+	params, err := k.GetParams(ctx)
+	if err != nil {
+		return errors.Wrap(err, "get params")
+	} else if !params.GetSyncContractParams() {
+		return nil
+	}
+	minCommissionRate := params.GetMinCommissionRate()
+
+	if commissionRate < minCommissionRate {
+		return errors.WrapErrWithCode(errors.InvalidCommissionRate, errors.New("commission rate under min",
+			"commission_rate", commissionRate, "min_commission_rate", minCommissionRate))
+	}
+
+	return nil
+}
@@ -0,0 +1,217 @@
+package keeper_test
+
+import (
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/common"
+
+	evmenginetypes "github.com/piplabs/story/client/x/evmengine/types"
+	"github.com/piplabs/story/client/x/evmstaking/types"
+	"github.com/piplabs/story/contracts/bindings"
+	"github.com/piplabs/story/lib/errors"
+
+	"go.uber.org/mock/gomock"
+)
+
+func (s *TestSuite) TestProcessStakingEvents_ContractParams() {
+	require := s.Require()
+	ctx, keeper := s.Ctx, s.EVMStakingKeeper
+
+	stakingAbi, err := bindings.IPTokenStakingMetaData.GetAbi()
+	require.NoError(err)
+
+	gwei := big.NewInt(1_000_000_000)
+	newLog := func(event string, value *big.Int) *evmenginetypes.EVMEvent {
+		data, err := stakingAbi.Events[event].Inputs.NonIndexed().Pack(value)
+		require.NoError(err)
+
+		return &evmenginetypes.EVMEvent{
+			Address: common.HexToAddress("0x1234").Bytes(),
+			Topics:  [][]byte{stakingAbi.Events[event].ID.Bytes()},
+			Data:    data,
+		}
+	}
+
+	// No limits are enforced until synced.
+	params, err := keeper.GetParams(ctx)
+	require.NoError(err)
+	require.Zero(params.MinStakeAmount)
+	require.Zero(params.MinUnstakeAmount)
+	require.Zero(params.MinCommissionRate)
+	require.Zero(params.Fee)
+
+	require.NoError(keeper.ProcessStakingEvents(ctx, 1, []*evmenginetypes.EVMEvent{
+		newLog("MinStakeAmountSet", new(big.Int).Mul(big.NewInt(1024), gwei)),
+		newLog("MinUnstakeAmountSet", new(big.Int).Mul(big.NewInt(512), gwei)),
+		newLog("MinCommissionRateChanged", big.NewInt(500)),
+		newLog("FeeSet", new(big.Int).Mul(big.NewInt(100), gwei)),
+		// A min commission rate above 100% is recorded as failed, keeping the synced one.
+		newLog("MinCommissionRateChanged", big.NewInt(10_001)),
+	}))
+
+	params, err = keeper.GetParams(ctx)
+	require.NoError(err)
+	require.Equal(uint64(1024), params.MinStakeAmount)
+	require.Equal(uint64(512), params.MinUnstakeAmount)
+	require.Equal(uint32(500), params.MinCommissionRate)
+	require.Equal(uint64(100), params.Fee)
+
+	failed, err := keeper.FailedEvents.Get(ctx, 0)
+	require.NoError(err)
+	require.Equal(types.MinCommissionRateChangedEvent.Name, failed.EventType)
+	require.Equal(errors.InvalidCommissionRate.String(), failed.ErrorCode)
+}
+
+func (s *TestSuite) TestContractParamLimits() {
+	require := s.Require()
+	ctx, keeper := s.Ctx, s.EVMStakingKeeper
+	require.NoError(keeper.WithdrawalQueue.Initialize(ctx))
+
+	// Withdrawals are only processed after the singularity.
+	singularityHeight, err := s.StakingKeeper.GetSingularityHeight(ctx)
+	require.NoError(err)
+	ctx = ctx.WithBlockHeight(int64(singularityHeight))
+
+	params, err := keeper.GetParams(ctx)
+	require.NoError(err)
+	params.MinStakeAmount = 1024
+	params.MinUnstakeAmount = 512
+	params.MinCommissionRate = 500
+	require.NoError(keeper.SetParams(ctx, params))
+
+	pubKeys, _, _ := createAddresses(2)
+	delPubKey, valPubKey := pubKeys[0].Bytes(), pubKeys[1].Bytes()
+
+	tcs := []struct {
+		name         string
+		process      func() error
+		expectedCode errors.ErrCode
+	}{
+		{
+			name: "deposit under min stake amount",
+			process: func() error {
+				return keeper.ProcessDeposit(ctx, &bindings.IPTokenStakingDeposit{
+					DelegatorUncmpPubkey: cmpToUncmp(delPubKey),
+					ValidatorUncmpPubkey: cmpToUncmp(valPubKey),
+					StakeAmount:          big.NewInt(1023),
+					StakingPeriod:        big.NewInt(0),
+					DelegationId:         big.NewInt(0),
+					OperatorAddress:      cmpToEVM(delPubKey),
+				})
+			},
+			expectedCode: errors.InvalidDelegationAmount,
+		},
+		{
+			name: "create validator under min stake amount",
+			process: func() error {
+				return keeper.ProcessCreateValidator(ctx, &bindings.IPTokenStakingCreateValidator{
+					ValidatorUncmpPubkey: cmpToUncmp(valPubKey),
+					StakeAmount:          big.NewInt(1023),
+					CommissionRate:       500,
+					OperatorAddress:      cmpToEVM(valPubKey),
+				})
+			},
+			expectedCode: errors.InvalidDelegationAmount,
+		},
+		{
+			name: "create validator under min commission rate",
+			process: func() error {
+				return keeper.ProcessCreateValidator(ctx, &bindings.IPTokenStakingCreateValidator{
+					ValidatorUncmpPubkey: cmpToUncmp(valPubKey),
+					StakeAmount:          big.NewInt(1024),
+					CommissionRate:       499,
+					OperatorAddress:      cmpToEVM(valPubKey),
+				})
+			},
+			expectedCode: errors.InvalidCommissionRate,
+		},
+		{
+			name: "withdraw under min unstake amount",
+			process: func() error {
+				return keeper.ProcessWithdraw(ctx, &bindings.IPTokenStakingWithdraw{
+					DelegatorUncmpPubkey: cmpToUncmp(delPubKey),
+					ValidatorUncmpPubkey: cmpToUncmp(valPubKey),
+					StakeAmount:          big.NewInt(511),
+					DelegationId:         big.NewInt(0),
+					OperatorAddress:      cmpToEVM(delPubKey),
+				})
+			},
+			expectedCode: errors.InvalidDelegationAmount,
+		},
+	}
+
+	for _, tc := range tcs {
+		s.Run(tc.name, func() {
+			err := tc.process()
+			require.Error(err)
+			require.Equal(tc.expectedCode, errors.UnwrapErrCode(err))
+		})
+	}
+}
+
+func (s *TestSuite) TestContractParams_NotSynced() {
+	require := s.Require()
+	ctx, keeper := s.Ctx, s.EVMStakingKeeper
+
+	stakingAbi, err := bindings.IPTokenStakingMetaData.GetAbi()
+	require.NoError(err)
+
+	params, err := keeper.GetParams(ctx)
+	require.NoError(err)
+	params.SyncContractParams = false
+	params.MinStakeAmount = 1024
+	require.NoError(keeper.SetParams(ctx, params))
+
+	// The events of the contract params are ignored.
+	data, err := stakingAbi.Events["MinStakeAmountSet"].Inputs.NonIndexed().Pack(big.NewInt(2048_000_000_000))
+	require.NoError(err)
+	require.NoError(keeper.ProcessStakingEvents(ctx, 1, []*evmenginetypes.EVMEvent{{
+		Address: common.HexToAddress("0x1234").Bytes(),
+		Topics:  [][]byte{stakingAbi.Events["MinStakeAmountSet"].ID.Bytes()},
+		Data:    data,
+	}}))
+
+	params, err = keeper.GetParams(ctx)
+	require.NoError(err)
+	require.Equal(uint64(1024), params.MinStakeAmount)
+
+	// The min stake amount isn't enforced, so the validator creation proceeds to minting the stake.
+	pubKeys, addrs, _ := createAddresses(1)
+	valPubKey := pubKeys[0].Bytes()
+	s.AccountKeeper.EXPECT().HasAccount(gomock.Any(), addrs[0]).Return(true)
+	s.BankKeeper.EXPECT().MintCoins(gomock.Any(), types.ModuleName, gomock.Any()).Return(errors.New("mint coins"))
+	err = keeper.ProcessCreateValidator(ctx, &bindings.IPTokenStakingCreateValidator{
+		ValidatorUncmpPubkey: cmpToUncmp(valPubKey),
+		StakeAmount:          big.NewInt(1023),
+		CommissionRate:       500,
+		OperatorAddress:      cmpToEVM(valPubKey),
+	})
+	require.ErrorContains(err, "mint coins")
+}
+
+func (s *TestSuite) TestSeedContractParams() {
+	require := s.Require()
+	ctx, keeper := s.Ctx, s.EVMStakingKeeper
+
+	params, err := keeper.GetParams(ctx)
+	require.NoError(err)
+	params.SyncContractParams = false
+	require.NoError(keeper.SetParams(ctx, params))
+
+	// A min commission rate above 100% isn't seeded.
+	require.Error(keeper.SeedContractParams(ctx, 1024, 512, 10_001, 1))
+
+	params, err = keeper.GetParams(ctx)
+	require.NoError(err)
+	require.False(params.SyncContractParams)
+
+	require.NoError(keeper.SeedContractParams(ctx, 1024, 512, 500, 1))
+
+	params, err = keeper.GetParams(ctx)
+	require.NoError(err)
+	require.True(params.SyncContractParams)
+	require.Equal(uint64(1024), params.MinStakeAmount)
+	require.Equal(uint64(512), params.MinUnstakeAmount)
+	require.Equal(uint32(500), params.MinCommissionRate)
+	require.Equal(uint64(1), params.Fee)
+}
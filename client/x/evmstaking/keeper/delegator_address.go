@@ -15,6 +15,9 @@ import (
 	"github.com/piplabs/story/lib/k1util"
 )
 
+// ProcessSetWithdrawalAddress sets the withdrawal address of the delegator.
+// Unlike the on behalf txns, it isn't scoped by the operator permissions: the IPTokenStaking contract
+// only lets the delegator itself set its addresses, and the event carries no sender to check.
 func (k Keeper) ProcessSetWithdrawalAddress(ctx context.Context, ev *bindings.IPTokenStakingSetWithdrawalAddress) (err error) {
 	sdkCtx := sdk.UnwrapSDKContext(ctx)
 	cachedCtx, writeCache := sdkCtx.CacheContext()
@@ -54,6 +57,9 @@ func (k Keeper) ProcessSetWithdrawalAddress(ctx context.Context, ev *bindings.IP
 	return nil
 }
 
+// ProcessSetRewardAddress sets the reward address of the delegator.
+// Unlike the on behalf txns, it isn't scoped by the operator permissions: the IPTokenStaking contract
+// only lets the delegator itself set its addresses, and the event carries no sender to check.
 func (k Keeper) ProcessSetRewardAddress(ctx context.Context, ev *bindings.IPTokenStakingSetRewardAddress) (err error) {
 	sdkCtx := sdk.UnwrapSDKContext(ctx)
 	cachedCtx, writeCache := sdkCtx.CacheContext()
@@ -124,11 +130,9 @@ func (k Keeper) ProcessAddOperator(ctx context.Context, ev *bindings.IPTokenStak
 
 	depositorAddr := sdk.AccAddress(depositorPubkey.Address().Bytes())
 
-	if err := k.DelegatorOperatorAddress.Set(cachedCtx, depositorAddr.String(), ev.Operator.String()); err != nil {
-		return errors.Wrap(err, "delegator operator address map set")
-	}
-
-	return nil
+	// The AddOperator event doesn't scope the operator, so it is granted all permissions.
+	// Delegators scope their operators with the SetOperatorPermissions event instead.
+	return k.SetDelegatorOperator(cachedCtx, depositorAddr, ev.Operator, types.OperatorPermissionAll)
 }
 
 func (k Keeper) ProcessRemoveOperator(ctx context.Context, ev *bindings.IPTokenStakingRemoveOperator) (err error) {
@@ -162,9 +166,40 @@ func (k Keeper) ProcessRemoveOperator(ctx context.Context, ev *bindings.IPTokenS
 
 	depositorAddr := sdk.AccAddress(depositorPubkey.Address().Bytes())
 
-	if err := k.DelegatorOperatorAddress.Remove(cachedCtx, depositorAddr.String()); err != nil {
-		return errors.Wrap(err, "delegator operator address map remove")
+	return k.RemoveDelegatorOperator(cachedCtx, depositorAddr, ev.Operator)
+}
+
+func (k Keeper) ProcessSetOperatorPermissions(ctx context.Context, ev *bindings.IPTokenStakingSetOperatorPermissions) (err error) {
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	cachedCtx, writeCache := sdkCtx.CacheContext()
+
+	defer func() {
+		if err == nil {
+			writeCache()
+			return
+		}
+		sdkCtx.EventManager().EmitEvents(sdk.Events{
+			sdk.NewEvent(
+				types.EventTypeSetOperatorPermissionsFailure,
+				sdk.NewAttribute(types.AttributeKeyBlockHeight, strconv.FormatInt(sdkCtx.BlockHeight(), 10)),
+				sdk.NewAttribute(types.AttributeKeyDelegatorUncmpPubKey, hex.EncodeToString(ev.UncmpPubkey)),
+				sdk.NewAttribute(types.AttributeKeyOperatorAddress, ev.Operator.Hex()),
+				sdk.NewAttribute(types.AttributeKeyOperatorPermissions, strconv.FormatUint(uint64(ev.Permissions), 10)),
+				sdk.NewAttribute(types.AttributeKeyStatusCode, errors.UnwrapErrCode(err).String()),
+			),
+		})
+	}()
+
+	delCmpPubkey, err := UncmpPubKeyToCmpPubKey(ev.UncmpPubkey)
+	if err != nil {
+		return errors.WrapErrWithCode(errors.InvalidUncmpPubKey, errors.Wrap(err, "compress delegator pubkey"))
+	}
+	depositorPubkey, err := k1util.PubKeyBytesToCosmos(delCmpPubkey)
+	if err != nil {
+		return errors.Wrap(err, "depositor pubkey to cosmos")
 	}
 
-	return nil
+	depositorAddr := sdk.AccAddress(depositorPubkey.Address().Bytes())
+
+	return k.SetDelegatorOperator(cachedCtx, depositorAddr, ev.Operator, ev.Permissions)
 }
@@ -40,6 +40,12 @@ func (k Keeper) ProcessDeposit(ctx context.Context, ev *bindings.IPTokenStakingD
 				sdk.NewAttribute(types.AttributeKeyStatusCode, errors.UnwrapErrCode(err).String()),
 			),
 		})
+
+		// Refund the staked amount to the delegator, as it is already taken on the EL side.
+		refundAddr := refundAddress(ev.DelegatorUncmpPubkey, ev.OperatorAddress)
+		if rerr := k.RefundFailedStake(sdkCtx, refundAddr, ev.StakeAmount, errors.UnwrapErrCode(err)); rerr != nil {
+			log.Error(sdkCtx, "Failed to refund failed deposit", rerr, "evm_addr", refundAddr.String())
+		}
 	}()
 
 	delCmpPubkey, err := UncmpPubKeyToCmpPubKey(ev.DelegatorUncmpPubkey)
@@ -72,6 +78,10 @@ func (k Keeper) ProcessDeposit(ctx context.Context, ev *bindings.IPTokenStakingD
 		return errors.Wrap(err, "delegator pubkey to evm address")
 	}
 
+	if err := k.checkMinStakeAmount(cachedCtx, ev.StakeAmount); err != nil {
+		return err
+	}
+
 	amountCoin, amountCoins := IPTokenToBondCoin(ev.StakeAmount)
 
 	// Create account if not exists
@@ -0,0 +1,219 @@
+package keeper
+
+import (
+	"context"
+	"encoding/json"
+
+	"cosmossdk.io/collections"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	evmenginetypes "github.com/piplabs/story/client/x/evmengine/types"
+	"github.com/piplabs/story/client/x/evmstaking/types"
+	"github.com/piplabs/story/contracts/bindings"
+	"github.com/piplabs/story/lib/errors"
+	"github.com/piplabs/story/lib/k1util"
+	clog "github.com/piplabs/story/lib/log"
+)
+
+// RecordFailedEvent records an EVM log event that failed to be processed, so it can be queried for auditing.
+// The decoded event is nil if decoding the log failed. It only logs if recording fails, since the audit
+// record must not halt the processing of the other events.
+func (k Keeper) RecordFailedEvent(ctx context.Context, evmLog *evmenginetypes.EVMEvent, eventType string, ev any, procErr error) {
+	if err := k.recordFailedEvent(ctx, evmLog, eventType, ev, procErr); err != nil {
+		clog.Error(ctx, "Failed to record failed event", err, "event_type", eventType)
+	}
+}
+
+// recordFailedEvent records the failed EVM log event if enabled by the record failed events param.
+func (k Keeper) recordFailedEvent(ctx context.Context, evmLog *evmenginetypes.EVMEvent, eventType string, ev any, procErr error) error {
+	params, err := k.GetParams(ctx)
+	if err != nil {
+		return errors.Wrap(err, "get params")
+	} else if !params.GetRecordFailedEvents() {
+		return nil
+	}
+
+	id, err := k.NextFailedEventID.Next(ctx)
+	if err != nil {
+		return errors.Wrap(err, "next failed event id")
+	}
+
+	failed := types.FailedEvent{
+		Id:        id,
+		Height:    uint64(sdk.UnwrapSDKContext(ctx).BlockHeight()),
+		BlockHash: evmLog.GetBlockHash(),
+		TxHash:    evmLog.GetTxHash(),
+		LogIndex:  evmLog.GetLogIndex(),
+		EventType: eventType,
+		ErrorCode: errors.UnwrapErrCode(procErr).String(),
+	}
+
+	if ev != nil {
+		if failed.Payload, err = failedEventPayload(ev); err != nil {
+			return errors.Wrap(err, "failed event payload")
+		}
+		failed.DelegatorAddress, failed.ValidatorAddresses = failedEventParties(ev)
+	}
+
+	return k.setFailedEvent(ctx, failed)
+}
+
+// setFailedEvent stores the failed event and indexes it by its delegator and validator addresses.
+func (k Keeper) setFailedEvent(ctx context.Context, failed types.FailedEvent) error {
+	if err := k.FailedEvents.Set(ctx, failed.Id, failed); err != nil {
+		return errors.Wrap(err, "set failed event")
+	}
+
+	if failed.DelegatorAddress != "" {
+		if err := k.FailedEventsByDelegator.Set(ctx, collections.Join(failed.DelegatorAddress, failed.Id)); err != nil {
+			return errors.Wrap(err, "set failed event by delegator")
+		}
+	}
+
+	for _, valAddr := range failed.ValidatorAddresses {
+		if err := k.FailedEventsByValidator.Set(ctx, collections.Join(valAddr, failed.Id)); err != nil {
+			return errors.Wrap(err, "set failed event by validator")
+		}
+	}
+
+	return nil
+}
+
+// PruneFailedEvents deletes the failed events older than the failed event retention param,
+// i.e. it only keeps the failed events of the last `retention` consensus chain heights.
+func (k Keeper) PruneFailedEvents(ctx context.Context) error {
+	params, err := k.GetParams(ctx)
+	if err != nil {
+		return errors.Wrap(err, "get params")
+	}
+
+	height := uint64(sdk.UnwrapSDKContext(ctx).BlockHeight())
+	retention := params.GetFailedEventRetention()
+	if retention == 0 || height < retention {
+		return nil
+	}
+
+	// Failed events are recorded with increasing IDs and heights, so the oldest come first.
+	var pruned []types.FailedEvent
+	err = k.FailedEvents.Walk(ctx, nil, func(_ uint64, failed types.FailedEvent) (bool, error) {
+		if failed.Height > height-retention {
+			return true, nil
+		}
+		pruned = append(pruned, failed)
+
+		return false, nil
+	})
+	if err != nil {
+		return errors.Wrap(err, "walk failed events")
+	}
+
+	for _, failed := range pruned {
+		if err := k.FailedEvents.Remove(ctx, failed.Id); err != nil {
+			return errors.Wrap(err, "remove failed event")
+		}
+		if failed.DelegatorAddress != "" {
+			if err := k.FailedEventsByDelegator.Remove(ctx, collections.Join(failed.DelegatorAddress, failed.Id)); err != nil {
+				return errors.Wrap(err, "remove failed event by delegator")
+			}
+		}
+		for _, valAddr := range failed.ValidatorAddresses {
+			if err := k.FailedEventsByValidator.Remove(ctx, collections.Join(valAddr, failed.Id)); err != nil {
+				return errors.Wrap(err, "remove failed event by validator")
+			}
+		}
+	}
+
+	return nil
+}
+
+// failedEventPayload returns the JSON encoded decoded event, without the raw log.
+func failedEventPayload(ev any) (string, error) {
+	bz, err := json.Marshal(ev)
+	if err != nil {
+		return "", errors.Wrap(err, "marshal event")
+	}
+
+	// Maps are marshaled with sorted keys, so the payload is deterministic.
+	var fields map[string]json.RawMessage
+	if err := json.Unmarshal(bz, &fields); err != nil {
+		return "", errors.Wrap(err, "unmarshal event fields")
+	}
+	delete(fields, "Raw")
+
+	bz, err = json.Marshal(fields)
+	if err != nil {
+		return "", errors.Wrap(err, "marshal event fields")
+	}
+
+	return string(bz), nil
+}
+
+// failedEventParties returns the delegator and validator addresses involved in the decoded event.
+// Invalid public keys are skipped, since they are a common cause of failed events.
+func failedEventParties(ev any) (delegator string, validators []string) {
+	addValidator := func(uncmpPubKey []byte) {
+		if _, valAddr, ok := uncmpPubKeyToAddresses(uncmpPubKey); ok {
+			validators = append(validators, valAddr)
+		}
+	}
+	setDelegator := func(uncmpPubKey []byte) {
+		if delAddr, _, ok := uncmpPubKeyToAddresses(uncmpPubKey); ok {
+			delegator = delAddr
+		}
+	}
+
+	switch e := ev.(type) {
+	case *bindings.IPTokenStakingCreateValidator:
+		setDelegator(e.ValidatorUncmpPubkey) // Self-delegation of the validator.
+		addValidator(e.ValidatorUncmpPubkey)
+	case *bindings.IPTokenStakingDeposit:
+		setDelegator(e.DelegatorUncmpPubkey)
+		addValidator(e.ValidatorUncmpPubkey)
+	case *bindings.IPTokenStakingWithdraw:
+		setDelegator(e.DelegatorUncmpPubkey)
+		addValidator(e.ValidatorUncmpPubkey)
+	case *bindings.IPTokenStakingRedelegate:
+		setDelegator(e.DelegatorUncmpPubkey)
+		addValidator(e.ValidatorUncmpSrcPubkey)
+		addValidator(e.ValidatorUncmpDstPubkey)
+	case *bindings.IPTokenStakingSetWithdrawalAddress:
+		setDelegator(e.DelegatorUncmpPubkey)
+	case *bindings.IPTokenStakingSetRewardAddress:
+		setDelegator(e.DelegatorUncmpPubkey)
+	case *bindings.IPTokenStakingAddOperator:
+		setDelegator(e.UncmpPubkey)
+	case *bindings.IPTokenStakingRemoveOperator:
+		setDelegator(e.UncmpPubkey)
+	case *bindings.IPTokenStakingSetOperatorPermissions:
+		setDelegator(e.UncmpPubkey)
+	case *bindings.IPTokenStakingUpdateValidatorCommssion:
+		addValidator(e.ValidatorUncmpPubkey)
+	case *bindings.IPTokenStakingSetFeeRecipient:
+		addValidator(e.ValidatorUncmpPubkey)
+	case *bindings.IPTokenStakingUnjail:
+		addValidator(e.ValidatorUncmpPubkey)
+	case *bindings.UBIPoolUBIDistributionSet:
+		for _, uncmpPubKey := range e.ValidatorUncmpPubKeys {
+			addValidator(uncmpPubKey)
+		}
+	case *bindings.UBIPoolUBIClaimed:
+		addValidator(e.ValidatorUncmpPubkey)
+	}
+
+	return delegator, validators
+}
+
+// uncmpPubKeyToAddresses returns the delegator and validator addresses of the uncompressed public key.
+func uncmpPubKeyToAddresses(uncmpPubKey []byte) (delAddr string, valAddr string, ok bool) {
+	cmpPubKey, err := UncmpPubKeyToCmpPubKey(uncmpPubKey)
+	if err != nil {
+		return "", "", false
+	}
+	pubKey, err := k1util.PubKeyBytesToCosmos(cmpPubKey)
+	if err != nil {
+		return "", "", false
+	}
+
+	return sdk.AccAddress(pubKey.Address().Bytes()).String(), sdk.ValAddress(pubKey.Address().Bytes()).String(), true
+}
@@ -0,0 +1,216 @@
+package keeper_test
+
+import (
+	"context"
+	"math/big"
+
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"github.com/ethereum/go-ethereum/common"
+
+	evmenginetypes "github.com/piplabs/story/client/x/evmengine/types"
+	"github.com/piplabs/story/client/x/evmstaking/types"
+	"github.com/piplabs/story/contracts/bindings"
+	"github.com/piplabs/story/lib/errors"
+)
+
+func (s *TestSuite) TestRecordFailedEvent() {
+	require := s.Require()
+	ctx, keeper := s.Ctx, s.EVMStakingKeeper
+
+	pubKeys, accAddrs, valAddrs := createAddresses(3)
+	evmLog := &evmenginetypes.EVMEvent{
+		BlockHash: common.HexToHash("0x01").Bytes(),
+		TxHash:    common.HexToHash("0x02").Bytes(),
+		LogIndex:  3,
+	}
+
+	deposit := &bindings.IPTokenStakingDeposit{
+		DelegatorUncmpPubkey: cmpToUncmp(pubKeys[0].Bytes()),
+		ValidatorUncmpPubkey: cmpToUncmp(pubKeys[1].Bytes()),
+		StakeAmount:          big.NewInt(100),
+		StakingPeriod:        big.NewInt(0),
+		DelegationId:         big.NewInt(0),
+	}
+	redelegate := &bindings.IPTokenStakingRedelegate{
+		DelegatorUncmpPubkey:    cmpToUncmp(pubKeys[0].Bytes()),
+		ValidatorUncmpSrcPubkey: cmpToUncmp(pubKeys[1].Bytes()),
+		ValidatorUncmpDstPubkey: cmpToUncmp(pubKeys[2].Bytes()),
+		DelegationId:            big.NewInt(0),
+		Amount:                  big.NewInt(100),
+	}
+	procErr := errors.WrapErrWithCode(errors.InvalidUncmpPubKey, errors.New("invalid pubkey"))
+
+	keeper.RecordFailedEvent(ctx, evmLog, types.DepositEvent.Name, deposit, procErr)
+	keeper.RecordFailedEvent(ctx, evmLog, types.RedelegateEvent.Name, redelegate, procErr)
+	keeper.RecordFailedEvent(ctx, evmLog, types.WithdrawEvent.Name, nil, errors.New("parse"))
+
+	// All failed events are returned ordered by ID.
+	res, err := s.queryClient.GetFailedEvents(context.Background(), &types.QueryGetFailedEventsRequest{})
+	require.NoError(err)
+	require.Len(res.FailedEvents, 3)
+	failed := res.FailedEvents[0]
+	require.Equal(uint64(0), failed.Id)
+	require.Equal(evmLog.BlockHash, failed.BlockHash)
+	require.Equal(evmLog.TxHash, failed.TxHash)
+	require.Equal(uint64(3), failed.LogIndex)
+	require.Equal(types.DepositEvent.Name, failed.EventType)
+	require.Equal(errors.InvalidUncmpPubKey.String(), failed.ErrorCode)
+	require.Contains(failed.Payload, `"StakeAmount":100`)
+	require.NotContains(failed.Payload, "Raw")
+	require.Equal(accAddrs[0].String(), failed.DelegatorAddress)
+	require.Equal([]string{valAddrs[1].String()}, failed.ValidatorAddresses)
+
+	// The undecoded event has no payload nor parties.
+	require.Equal(types.WithdrawEvent.Name, res.FailedEvents[2].EventType)
+	require.Empty(res.FailedEvents[2].Payload)
+	require.Empty(res.FailedEvents[2].DelegatorAddress)
+	require.Empty(res.FailedEvents[2].ValidatorAddresses)
+
+	tcs := []struct {
+		name        string
+		req         *types.QueryGetFailedEventsRequest
+		expectedIDs []uint64
+		expectedErr string
+	}{
+		{
+			name:        "fail: both filters",
+			req:         &types.QueryGetFailedEventsRequest{DelegatorAddress: accAddrs[0].String(), ValidatorAddress: valAddrs[1].String()},
+			expectedErr: "only one of delegator and validator address can be set",
+		},
+		{
+			name:        "pass: by delegator",
+			req:         &types.QueryGetFailedEventsRequest{DelegatorAddress: accAddrs[0].String()},
+			expectedIDs: []uint64{0, 1},
+		},
+		{
+			name:        "pass: by source validator",
+			req:         &types.QueryGetFailedEventsRequest{ValidatorAddress: valAddrs[1].String()},
+			expectedIDs: []uint64{0, 1},
+		},
+		{
+			name:        "pass: by destination validator",
+			req:         &types.QueryGetFailedEventsRequest{ValidatorAddress: valAddrs[2].String()},
+			expectedIDs: []uint64{1},
+		},
+		{
+			name:        "pass: by validator with pagination",
+			req:         &types.QueryGetFailedEventsRequest{ValidatorAddress: valAddrs[1].String(), Pagination: &query.PageRequest{Offset: 1}},
+			expectedIDs: []uint64{1},
+		},
+		{
+			name: "pass: unknown delegator",
+			req:  &types.QueryGetFailedEventsRequest{DelegatorAddress: accAddrs[2].String()},
+		},
+	}
+
+	for _, tc := range tcs {
+		s.Run(tc.name, func() {
+			res, err := s.queryClient.GetFailedEvents(context.Background(), tc.req)
+			if tc.expectedErr != "" {
+				require.ErrorContains(err, tc.expectedErr)
+				return
+			}
+			require.NoError(err)
+
+			var ids []uint64
+			for _, failed := range res.FailedEvents {
+				ids = append(ids, failed.Id)
+			}
+			require.Equal(tc.expectedIDs, ids)
+		})
+	}
+}
+
+func (s *TestSuite) TestProcessStakingEvents_RecordFailedEvent() {
+	require := s.Require()
+	ctx, keeper := s.Ctx, s.EVMStakingKeeper
+
+	stakingAbi, err := bindings.IPTokenStakingMetaData.GetAbi()
+	require.NoError(err)
+
+	// A deposit with an invalid delegator pubkey fails, it is recorded without halting the processing.
+	pubKeys, _, valAddrs := createAddresses(1)
+	data, err := stakingAbi.Events["Deposit"].Inputs.NonIndexed().Pack(
+		[]byte("invalid pubkey"), cmpToUncmp(pubKeys[0].Bytes()), big.NewInt(100), big.NewInt(0), big.NewInt(0), common.Address{}, []byte{})
+	require.NoError(err)
+	evmLog := &evmenginetypes.EVMEvent{
+		Address:   common.HexToAddress("0x1234").Bytes(),
+		Topics:    [][]byte{types.DepositEvent.ID.Bytes()},
+		Data:      data,
+		BlockHash: common.HexToHash("0x01").Bytes(),
+		TxHash:    common.HexToHash("0x02").Bytes(),
+		LogIndex:  1,
+	}
+	require.NoError(keeper.ProcessStakingEvents(ctx, 1, []*evmenginetypes.EVMEvent{evmLog}))
+
+	failed, err := keeper.FailedEvents.Get(ctx, 0)
+	require.NoError(err)
+	require.Equal(types.DepositEvent.Name, failed.EventType)
+	require.Equal(evmLog.TxHash, failed.TxHash)
+	require.Equal(uint64(1), failed.LogIndex)
+	require.Equal(errors.InvalidUncmpPubKey.String(), failed.ErrorCode)
+	require.Empty(failed.DelegatorAddress)
+	require.Equal([]string{valAddrs[0].String()}, failed.ValidatorAddresses)
+}
+
+func (s *TestSuite) TestRecordFailedEvent_Disabled() {
+	require := s.Require()
+	ctx, keeper := s.Ctx, s.EVMStakingKeeper
+
+	// Failed events aren't recorded before the record failed events param is enabled.
+	params, err := keeper.GetParams(ctx)
+	require.NoError(err)
+	params.RecordFailedEvents = false
+	require.NoError(keeper.SetParams(ctx, params))
+
+	keeper.RecordFailedEvent(ctx, &evmenginetypes.EVMEvent{}, types.WithdrawEvent.Name, nil, errors.New("failed"))
+
+	res, err := s.queryClient.GetFailedEvents(context.Background(), &types.QueryGetFailedEventsRequest{})
+	require.NoError(err)
+	require.Empty(res.FailedEvents)
+	nextID, err := keeper.NextFailedEventID.Peek(ctx)
+	require.NoError(err)
+	require.Zero(nextID)
+}
+
+func (s *TestSuite) TestPruneFailedEvents() {
+	require := s.Require()
+	ctx, keeper := s.Ctx, s.EVMStakingKeeper
+
+	params, err := keeper.GetParams(ctx)
+	require.NoError(err)
+	params.FailedEventRetention = 10
+	require.NoError(keeper.SetParams(ctx, params))
+
+	pubKeys, accAddrs, valAddrs := createAddresses(2)
+	deposit := &bindings.IPTokenStakingDeposit{
+		DelegatorUncmpPubkey: cmpToUncmp(pubKeys[0].Bytes()),
+		ValidatorUncmpPubkey: cmpToUncmp(pubKeys[1].Bytes()),
+		StakeAmount:          big.NewInt(100),
+		StakingPeriod:        big.NewInt(0),
+		DelegationId:         big.NewInt(0),
+	}
+	for _, height := range []int64{1, 5, 12} {
+		ctx := ctx.WithBlockHeight(height)
+		keeper.RecordFailedEvent(ctx, &evmenginetypes.EVMEvent{}, types.DepositEvent.Name, deposit, errors.New("failed"))
+	}
+
+	// Nothing is pruned before the retention.
+	require.NoError(keeper.PruneFailedEvents(ctx.WithBlockHeight(9)))
+	res, err := s.queryClient.GetFailedEvents(context.Background(), &types.QueryGetFailedEventsRequest{})
+	require.NoError(err)
+	require.Len(res.FailedEvents, 3)
+
+	// Only the failed events of the last 10 heights are kept, including their indexes.
+	require.NoError(keeper.PruneFailedEvents(ctx.WithBlockHeight(15)))
+	for _, req := range []*types.QueryGetFailedEventsRequest{
+		{},
+		{DelegatorAddress: accAddrs[0].String()},
+		{ValidatorAddress: valAddrs[1].String()},
+	} {
+		res, err := s.queryClient.GetFailedEvents(context.Background(), req)
+		require.NoError(err)
+		require.Len(res.FailedEvents, 1)
+		require.Equal(uint64(12), res.FailedEvents[0].Height)
+	}
+}
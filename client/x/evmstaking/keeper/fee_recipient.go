@@ -0,0 +1,79 @@
+//nolint:contextcheck // use cached context
+package keeper
+
+import (
+	"context"
+	"encoding/hex"
+	"strconv"
+
+	"cosmossdk.io/collections"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/common"
+
+	"github.com/piplabs/story/client/x/evmstaking/types"
+	"github.com/piplabs/story/contracts/bindings"
+	"github.com/piplabs/story/lib/errors"
+	"github.com/piplabs/story/lib/k1util"
+)
+
+// ProcessSetFeeRecipient registers the fee recipient of the execution blocks proposed by the validator.
+// The fee recipient is keyed by the execution address derived from the validator's consensus pubkey.
+func (k Keeper) ProcessSetFeeRecipient(ctx context.Context, ev *bindings.IPTokenStakingSetFeeRecipient) (err error) {
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	cachedCtx, writeCache := sdkCtx.CacheContext()
+
+	defer func() {
+		if err == nil {
+			writeCache()
+			return
+		}
+		sdkCtx.EventManager().EmitEvents(sdk.Events{
+			sdk.NewEvent(
+				types.EventTypeSetFeeRecipientFailure,
+				sdk.NewAttribute(types.AttributeKeyBlockHeight, strconv.FormatInt(sdkCtx.BlockHeight(), 10)),
+				sdk.NewAttribute(types.AttributeKeyValidatorUncmpPubKey, hex.EncodeToString(ev.ValidatorUncmpPubkey)),
+				sdk.NewAttribute(types.AttributeKeyFeeRecipient, ev.FeeRecipient.Hex()),
+				sdk.NewAttribute(types.AttributeKeyStatusCode, errors.UnwrapErrCode(err).String()),
+			),
+		})
+	}()
+
+	valCmpPubkey, err := UncmpPubKeyToCmpPubKey(ev.ValidatorUncmpPubkey)
+	if err != nil {
+		return errors.WrapErrWithCode(errors.InvalidUncmpPubKey, errors.Wrap(err, "compress validator pubkey"))
+	}
+	validatorPubkey, err := k1util.PubKeyBytesToCosmos(valCmpPubkey)
+	if err != nil {
+		return errors.Wrap(err, "validator pubkey to cosmos")
+	}
+
+	validatorAddr := sdk.ValAddress(validatorPubkey.Address().Bytes())
+	if _, err := k.stakingKeeper.GetValidator(cachedCtx, validatorAddr); err != nil {
+		return errors.Wrap(err, "get validator")
+	}
+
+	validatorEvmAddr, err := k1util.CosmosPubkeyToEVMAddress(valCmpPubkey)
+	if err != nil {
+		return errors.Wrap(err, "validator pubkey to evm address")
+	}
+
+	if err := k.ValidatorFeeRecipient.Set(cachedCtx, validatorEvmAddr.String(), ev.FeeRecipient.String()); err != nil {
+		return errors.Wrap(err, "validator fee recipient map set")
+	}
+
+	return nil
+}
+
+// GetValidatorFeeRecipient returns the fee recipient registered by the validator with the given
+// derived execution address and true, or false if the validator didn't register one.
+func (k Keeper) GetValidatorFeeRecipient(ctx context.Context, validatorEvmAddr common.Address) (common.Address, bool, error) {
+	feeRecipient, err := k.ValidatorFeeRecipient.Get(ctx, validatorEvmAddr.String())
+	if errors.Is(err, collections.ErrNotFound) {
+		return common.Address{}, false, nil
+	} else if err != nil {
+		return common.Address{}, false, errors.Wrap(err, "validator fee recipient map get")
+	}
+
+	return common.HexToAddress(feeRecipient), true, nil
+}
@@ -0,0 +1,77 @@
+package keeper_test
+
+import (
+	"github.com/cosmos/cosmos-sdk/x/staking/testutil"
+	"github.com/ethereum/go-ethereum/common"
+
+	"github.com/piplabs/story/client/x/evmstaking/keeper"
+	"github.com/piplabs/story/contracts/bindings"
+	"github.com/piplabs/story/lib/k1util"
+)
+
+func (s *TestSuite) TestProcessSetFeeRecipient() {
+	require := s.Require()
+	ctx, eskeeper := s.Ctx, s.EVMStakingKeeper
+	pubKeys, _, valAddrs := createAddresses(2)
+
+	valPubKey, valAddr := pubKeys[0], valAddrs[0]
+	valCosmosPubKey, err := k1util.PubKeyToCosmos(valPubKey)
+	require.NoError(err)
+	require.NoError(s.StakingKeeper.SetValidator(ctx, testutil.NewValidator(s.T(), valAddr, valCosmosPubKey)))
+	valEvmAddr, err := keeper.CmpPubKeyToEVMAddress(valPubKey.Bytes())
+	require.NoError(err)
+	unknownEvmAddr, err := keeper.CmpPubKeyToEVMAddress(pubKeys[1].Bytes())
+	require.NoError(err)
+	feeRecipient := common.HexToAddress("0x1234")
+
+	tcs := []struct {
+		name        string
+		ev          *bindings.IPTokenStakingSetFeeRecipient
+		evmAddr     common.Address
+		expectedErr string
+	}{
+		{
+			name: "pass: valid set fee recipient event",
+			ev: &bindings.IPTokenStakingSetFeeRecipient{
+				ValidatorUncmpPubkey: cmpToUncmp(valPubKey.Bytes()),
+				FeeRecipient:         feeRecipient,
+			},
+			evmAddr: valEvmAddr,
+		},
+		{
+			name: "fail: invalid validator pubkey",
+			ev: &bindings.IPTokenStakingSetFeeRecipient{
+				ValidatorUncmpPubkey: cmpToUncmp(valPubKey.Bytes())[10:],
+				FeeRecipient:         feeRecipient,
+			},
+			evmAddr:     valEvmAddr,
+			expectedErr: "invalid uncompressed public key length or format",
+		},
+		{
+			name: "fail: validator not found",
+			ev: &bindings.IPTokenStakingSetFeeRecipient{
+				ValidatorUncmpPubkey: cmpToUncmp(pubKeys[1].Bytes()),
+				FeeRecipient:         feeRecipient,
+			},
+			evmAddr:     unknownEvmAddr,
+			expectedErr: "validator does not exist",
+		},
+	}
+
+	for _, tc := range tcs {
+		s.Run(tc.name, func() {
+			cachedCtx, _ := ctx.CacheContext()
+			err := eskeeper.ProcessSetFeeRecipient(cachedCtx, tc.ev)
+			got, ok, getErr := eskeeper.GetValidatorFeeRecipient(cachedCtx, tc.evmAddr)
+			require.NoError(getErr)
+			if tc.expectedErr != "" {
+				require.ErrorContains(err, tc.expectedErr)
+				require.False(ok)
+			} else {
+				require.NoError(err)
+				require.True(ok)
+				require.Equal(tc.ev.FeeRecipient, got)
+			}
+		})
+	}
+}
@@ -3,9 +3,13 @@ package keeper
 import (
 	"context"
 
+	"cosmossdk.io/collections"
+
 	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/common"
 
+	addcollections "github.com/piplabs/story/client/collections"
 	"github.com/piplabs/story/client/x/evmstaking/types"
 	"github.com/piplabs/story/lib/errors"
 	"github.com/piplabs/story/lib/k1util"
@@ -25,14 +29,56 @@ func (k Keeper) InitGenesis(ctx context.Context, gs *types.GenesisState) error {
 		return err
 	}
 
-	if err := k.WithdrawalQueue.Initialize(ctx); err != nil {
+	if err := k.WithdrawalQueue.Import(ctx, gs.WithdrawalQueue.Front, gs.WithdrawalQueue.Withdrawals); err != nil {
 		log.Error(ctx, "InitGenesis.evmstaking withdrawal queue not initialized", err)
 		return err
 	}
-	if err := k.RewardWithdrawalQueue.Initialize(ctx); err != nil {
+	if err := k.RewardWithdrawalQueue.Import(ctx, gs.RewardWithdrawalQueue.Front, gs.RewardWithdrawalQueue.Withdrawals); err != nil {
 		log.Error(ctx, "InitGenesis.evmstaking reward withdrawal queue not initialized", err)
 		return err
 	}
+	if err := k.SetNextWithdrawalIndex(ctx, gs.NextWithdrawalIndex); err != nil {
+		return err
+	}
+
+	for _, m := range []struct {
+		name     string
+		addrMap  collections.Map[string, string]
+		mappings []types.AddressMapping
+	}{
+		{"delegator withdraw address", k.DelegatorWithdrawAddress, gs.DelegatorWithdrawAddresses},
+		{"delegator reward address", k.DelegatorRewardAddress, gs.DelegatorRewardAddresses},
+		{"validator fee recipient", k.ValidatorFeeRecipient, gs.ValidatorFeeRecipients},
+	} {
+		for _, mapping := range m.mappings {
+			if err := m.addrMap.Set(ctx, mapping.Key, mapping.Address); err != nil {
+				return errors.Wrap(err, "set "+m.name+" map")
+			}
+		}
+	}
+
+	for _, o := range gs.DelegatorOperators {
+		key := collections.Join(o.DelegatorAddress, common.HexToAddress(o.OperatorAddress).String())
+		if err := k.DelegatorOperators.Set(ctx, key, o.Permissions); err != nil {
+			return errors.Wrap(err, "set delegator operator")
+		}
+	}
+
+	for _, w := range gs.UbiWithdrawals {
+		if err := k.UbiWithdrawals.Set(ctx, w.CreationHeight, w); err != nil {
+			return errors.Wrap(err, "set ubi withdrawal")
+		}
+	}
+
+	for _, failed := range gs.FailedEvents {
+		if err := k.setFailedEvent(ctx, failed); err != nil {
+			return err
+		}
+	}
+	if err := k.NextFailedEventID.Set(ctx, gs.NextFailedEventId); err != nil {
+		return errors.Wrap(err, "set next failed event id")
+	}
+
 	vals, err := k.stakingKeeper.GetAllValidators(ctx)
 	if err != nil {
 		return err
@@ -62,10 +108,11 @@ func (k Keeper) InitGenesis(ctx context.Context, gs *types.GenesisState) error {
 			"del_addr", delAddr.String(),
 		)
 
-		if err = k.DelegatorWithdrawAddress.Set(ctx, delAddr.String(), evmAddr.String()); err != nil {
+		// The validators default to their own EVM address, unless the genesis state sets one.
+		if err := setIfAbsent(ctx, k.DelegatorWithdrawAddress, delAddr.String(), evmAddr.String()); err != nil {
 			return errors.Wrap(err, "set delegator withdraw address map")
 		}
-		if err = k.DelegatorRewardAddress.Set(ctx, delAddr.String(), evmAddr.String()); err != nil {
+		if err := setIfAbsent(ctx, k.DelegatorRewardAddress, delAddr.String(), evmAddr.String()); err != nil {
 			return errors.Wrap(err, "set delegator reward address map")
 		}
 	}
@@ -85,17 +132,186 @@ func (k Keeper) ExportGenesis(ctx sdk.Context) *types.GenesisState {
 		panic(err)
 	}
 
+	nextWithdrawalIndex, err := k.GetNextWithdrawalIndex(ctx)
+	if err != nil {
+		panic(err)
+	}
+
+	nextFailedEventID, err := k.NextFailedEventID.Peek(ctx)
+	if err != nil {
+		panic(err)
+	}
+
 	return &types.GenesisState{
-		Params:              params,
-		ValidatorSweepIndex: validatorSweepIndex,
+		Params:                     params,
+		ValidatorSweepIndex:        validatorSweepIndex,
+		WithdrawalQueue:            mustExportQueue(ctx, k.WithdrawalQueue),
+		RewardWithdrawalQueue:      mustExportQueue(ctx, k.RewardWithdrawalQueue),
+		NextWithdrawalIndex:        nextWithdrawalIndex,
+		DelegatorWithdrawAddresses: mustExportAddressMap(ctx, k.DelegatorWithdrawAddress),
+		DelegatorRewardAddresses:   mustExportAddressMap(ctx, k.DelegatorRewardAddress),
+		DelegatorOperators:         mustExportDelegatorOperators(ctx, k.DelegatorOperators),
+		ValidatorFeeRecipients:     mustExportAddressMap(ctx, k.ValidatorFeeRecipient),
+		UbiWithdrawals:             mustExportValues(ctx, k.UbiWithdrawals),
+		FailedEvents:               mustExportValues(ctx, k.FailedEvents),
+		NextFailedEventId:          nextFailedEventID,
 	}
 }
 
-//nolint:revive // TODO: validate genesis
-func (k Keeper) ValidateGenesis(gs *types.GenesisState) error {
+// PrepForZeroHeightGenesis resets the state referencing consensus chain heights, so it can be
+// exported for a chain restarting at height zero. The ubi withdrawal history is dropped since it
+// is keyed by height, and the failed events are kept at height zero until they are pruned.
+func (k Keeper) PrepForZeroHeightGenesis(ctx context.Context) error {
+	if err := k.UbiWithdrawals.Clear(ctx, nil); err != nil {
+		return errors.Wrap(err, "clear ubi withdrawals")
+	}
+
+	iter, err := k.FailedEvents.Iterate(ctx, nil)
+	if err != nil {
+		return errors.Wrap(err, "iterate failed events")
+	}
+	failedEvents, err := iter.Values()
+	if err != nil {
+		return errors.Wrap(err, "get failed events")
+	}
+	for _, failed := range failedEvents {
+		failed.Height = 0
+		if err := k.FailedEvents.Set(ctx, failed.Id, failed); err != nil {
+			return errors.Wrap(err, "set failed event")
+		}
+	}
+
+	return nil
+}
+
+// ValidateGenesis returns an error if the genesis state is invalid.
+func (Keeper) ValidateGenesis(gs *types.GenesisState) error {
 	if err := gs.Params.Validate(); err != nil {
 		return errors.Wrap(err, "validate genesis state params")
 	}
 
+	if err := gs.WithdrawalQueue.Validate(); err != nil {
+		return errors.Wrap(err, "validate withdrawal queue")
+	}
+	if err := gs.RewardWithdrawalQueue.Validate(); err != nil {
+		return errors.Wrap(err, "validate reward withdrawal queue")
+	}
+
+	if err := types.ValidateDelegatorAddressMappings(gs.DelegatorWithdrawAddresses); err != nil {
+		return errors.Wrap(err, "validate delegator withdraw addresses")
+	}
+	if err := types.ValidateDelegatorAddressMappings(gs.DelegatorRewardAddresses); err != nil {
+		return errors.Wrap(err, "validate delegator reward addresses")
+	}
+	if err := types.ValidateDelegatorOperators(gs.DelegatorOperators); err != nil {
+		return errors.Wrap(err, "validate delegator operators")
+	}
+	if err := types.ValidateEVMAddressMappings(gs.ValidatorFeeRecipients); err != nil {
+		return errors.Wrap(err, "validate validator fee recipients")
+	}
+
+	ubiHeights := make(map[uint64]bool)
+	for _, w := range gs.UbiWithdrawals {
+		if ubiHeights[w.CreationHeight] {
+			return errors.New("duplicate ubi withdrawal height", "height", w.CreationHeight)
+		}
+		ubiHeights[w.CreationHeight] = true
+	}
+
+	failedIDs := make(map[uint64]bool)
+	for _, failed := range gs.FailedEvents {
+		if failedIDs[failed.Id] {
+			return errors.New("duplicate failed event id", "id", failed.Id)
+		} else if failed.Id >= gs.NextFailedEventId {
+			return errors.New("failed event id not below next failed event id", "id", failed.Id, "next", gs.NextFailedEventId)
+		}
+		failedIDs[failed.Id] = true
+	}
+
 	return nil
 }
+
+// setIfAbsent sets the value of the key in the map, only if the key isn't set yet.
+func setIfAbsent(ctx context.Context, m collections.Map[string, string], key, value string) error {
+	if ok, err := m.Has(ctx, key); err != nil {
+		return errors.Wrap(err, "has key")
+	} else if ok {
+		return nil
+	}
+
+	return m.Set(ctx, key, value)
+}
+
+// mustExportQueue returns the positions and withdrawals of the withdrawal queue.
+// It panics on error.
+func mustExportQueue(ctx context.Context, q addcollections.Queue[types.Withdrawal]) types.WithdrawalQueue {
+	front, err := q.Front(ctx)
+	if err != nil {
+		panic(err)
+	}
+	rear, err := q.Rear(ctx)
+	if err != nil {
+		panic(err)
+	}
+
+	iter, err := q.Iterate(ctx)
+	if err != nil {
+		panic(err)
+	}
+	withdrawals, err := iter.Values()
+	if err != nil {
+		panic(err)
+	}
+
+	return types.WithdrawalQueue{Front: front, Rear: rear, Withdrawals: withdrawals}
+}
+
+// mustExportAddressMap returns the entries of the address map, ordered by key.
+// It panics on error.
+func mustExportAddressMap(ctx context.Context, m collections.Map[string, string]) []types.AddressMapping {
+	var mappings []types.AddressMapping
+	err := m.Walk(ctx, nil, func(key, addr string) (bool, error) {
+		mappings = append(mappings, types.AddressMapping{Key: key, Address: addr})
+		return false, nil
+	})
+	if err != nil {
+		panic(err)
+	}
+
+	return mappings
+}
+
+// mustExportDelegatorOperators returns the delegator operators, ordered by delegator and operator address.
+// It panics on error.
+func mustExportDelegatorOperators(ctx context.Context, m collections.Map[collections.Pair[string, string], uint32]) []types.DelegatorOperator {
+	var operators []types.DelegatorOperator
+	err := m.Walk(ctx, nil, func(key collections.Pair[string, string], permissions uint32) (bool, error) {
+		operators = append(operators, types.DelegatorOperator{
+			DelegatorAddress: key.K1(),
+			OperatorAddress:  key.K2(),
+			Permissions:      permissions,
+		})
+
+		return false, nil
+	})
+	if err != nil {
+		panic(err)
+	}
+
+	return operators
+}
+
+// mustExportValues returns the values of the map, ordered by key.
+// It panics on error.
+func mustExportValues[V any](ctx context.Context, m collections.Map[uint64, V]) []V {
+	iter, err := m.Iterate(ctx, nil)
+	if err != nil {
+		panic(err)
+	}
+	values, err := iter.Values()
+	if err != nil {
+		panic(err)
+	}
+
+	return values
+}
@@ -1,5 +1,249 @@
 package keeper_test
 
+import (
+	"math/big"
+	"strings"
+
+	"github.com/ethereum/go-ethereum/common"
+
+	evmenginetypes "github.com/piplabs/story/client/x/evmengine/types"
+	"github.com/piplabs/story/client/x/evmstaking/types"
+	"github.com/piplabs/story/contracts/bindings"
+	"github.com/piplabs/story/lib/errors"
+)
+
+func (s *TestSuite) TestGenesisRoundTrip() {
+	require := s.Require()
+	ctx, keeper := s.Ctx, s.EVMStakingKeeper
+
+	pubKeys, accAddrs, _ := createAddresses(2)
+	evmAddrs := []string{cmpToEVM(pubKeys[0].Bytes()).String(), cmpToEVM(pubKeys[1].Bytes()).String()}
+
+	// Populate every collection, with the withdrawal queue front moved by a dequeue.
+	params := types.DefaultParams()
+	params.MaxWithdrawalPerBlock += 10
+	require.NoError(keeper.SetParams(ctx, params))
+	require.NoError(keeper.SetValidatorSweepIndex(ctx, types.NewValidatorSweepIndex(1, 2)))
+	require.NoError(keeper.WithdrawalQueue.Initialize(ctx))
+	require.NoError(keeper.RewardWithdrawalQueue.Initialize(ctx))
+	for i := range 3 {
+		require.NoError(keeper.WithdrawalQueue.Enqueue(ctx, types.NewWithdrawal(uint64(i), evmAddrs[0], 100)))
+	}
+	_, err := keeper.WithdrawalQueue.Dequeue(ctx)
+	require.NoError(err)
+	require.NoError(keeper.RewardWithdrawalQueue.Enqueue(ctx, types.NewRefundWithdrawal(4, evmAddrs[1], 200, errors.InvalidUncmpPubKey)))
+	require.NoError(keeper.SetNextWithdrawalIndex(ctx, 5))
+	for i, accAddr := range accAddrs {
+		require.NoError(keeper.DelegatorWithdrawAddress.Set(ctx, accAddr.String(), evmAddrs[i]))
+		require.NoError(keeper.DelegatorRewardAddress.Set(ctx, accAddr.String(), evmAddrs[1-i]))
+	}
+	require.NoError(keeper.SetDelegatorOperator(ctx, accAddrs[0], common.HexToAddress(evmAddrs[0]), types.OperatorPermissionUnstake))
+	require.NoError(keeper.SetDelegatorOperator(ctx, accAddrs[0], common.HexToAddress(evmAddrs[1]), types.OperatorPermissionAll))
+	require.NoError(keeper.ValidatorFeeRecipient.Set(ctx, evmAddrs[0], evmAddrs[1]))
+	require.NoError(keeper.UbiWithdrawals.Set(ctx, 7, types.NewWithdrawal(7, evmAddrs[0], 300)))
+	deposit := &bindings.IPTokenStakingDeposit{
+		DelegatorUncmpPubkey: cmpToUncmp(pubKeys[0].Bytes()),
+		ValidatorUncmpPubkey: cmpToUncmp(pubKeys[1].Bytes()),
+		StakeAmount:          big.NewInt(100),
+		StakingPeriod:        big.NewInt(0),
+		DelegationId:         big.NewInt(0),
+	}
+	evmLog := &evmenginetypes.EVMEvent{TxHash: common.HexToHash("0x01").Bytes()}
+	keeper.RecordFailedEvent(ctx, evmLog, types.DepositEvent.Name, deposit, errors.New("failed"))
+	keeper.RecordFailedEvent(ctx, evmLog, types.WithdrawEvent.Name, nil, errors.New("failed"))
+
+	exported := keeper.ExportGenesis(ctx)
+	require.NoError(keeper.ValidateGenesis(exported))
+	require.Equal(types.WithdrawalQueue{
+		Front: 1,
+		Rear:  3,
+		Withdrawals: []types.Withdrawal{
+			types.NewWithdrawal(1, evmAddrs[0], 100),
+			types.NewWithdrawal(2, evmAddrs[0], 100),
+		},
+	}, exported.WithdrawalQueue)
+	require.Len(exported.DelegatorWithdrawAddresses, 2)
+	require.Len(exported.DelegatorOperators, 2)
+	require.Len(exported.FailedEvents, 2)
+	require.Equal(uint64(2), exported.NextFailedEventId)
+
+	// Import the exported genesis, as JSON, into a fresh store and export it again.
+	bz, err := s.encCfg.Codec.MarshalJSON(exported)
+	require.NoError(err)
+	var imported types.GenesisState
+	require.NoError(s.encCfg.Codec.UnmarshalJSON(bz, &imported))
+
+	s.SetupTest()
+	ctx, keeper = s.Ctx, s.EVMStakingKeeper
+	require.NoError(keeper.InitGenesis(ctx, &imported))
+	require.Equal(exported, keeper.ExportGenesis(ctx))
+
+	// The imported queue continues at its rear, and the failed events at their next ID.
+	require.NoError(keeper.WithdrawalQueue.Enqueue(ctx, types.NewWithdrawal(8, evmAddrs[0], 100)))
+	rear, err := keeper.WithdrawalQueue.Rear(ctx)
+	require.NoError(err)
+	require.Equal(uint64(4), rear)
+	keeper.RecordFailedEvent(ctx, evmLog, types.WithdrawEvent.Name, nil, errors.New("failed"))
+	require.True(keeper.FailedEvents.Has(ctx, 2))
+
+	// The failed event indexes are rebuilt.
+	res, err := s.queryClient.GetFailedEvents(ctx, &types.QueryGetFailedEventsRequest{DelegatorAddress: accAddrs[0].String()})
+	require.NoError(err)
+	require.Len(res.FailedEvents, 1)
+	require.Equal(types.DepositEvent.Name, res.FailedEvents[0].EventType)
+}
+
+func (s *TestSuite) TestPrepForZeroHeightGenesis() {
+	require := s.Require()
+	ctx, keeper := s.Ctx.WithBlockHeight(10), s.EVMStakingKeeper
+
+	pubKeys, accAddrs, _ := createAddresses(1)
+	evmAddr := cmpToEVM(pubKeys[0].Bytes()).String()
+	require.NoError(keeper.WithdrawalQueue.Initialize(ctx))
+	require.NoError(keeper.RewardWithdrawalQueue.Initialize(ctx))
+	require.NoError(keeper.UbiWithdrawals.Set(ctx, 7, types.NewWithdrawal(7, evmAddr, 300)))
+	deposit := &bindings.IPTokenStakingDeposit{
+		DelegatorUncmpPubkey: cmpToUncmp(pubKeys[0].Bytes()),
+		ValidatorUncmpPubkey: cmpToUncmp(pubKeys[0].Bytes()),
+		StakeAmount:          big.NewInt(100),
+		StakingPeriod:        big.NewInt(0),
+		DelegationId:         big.NewInt(0),
+	}
+	keeper.RecordFailedEvent(ctx, &evmenginetypes.EVMEvent{}, types.DepositEvent.Name, deposit, errors.New("failed"))
+
+	require.NoError(keeper.PrepForZeroHeightGenesis(ctx))
+
+	exported := keeper.ExportGenesis(ctx)
+	require.NoError(keeper.ValidateGenesis(exported))
+	require.Empty(exported.UbiWithdrawals)
+	require.Len(exported.FailedEvents, 1)
+	require.Equal(uint64(0), exported.FailedEvents[0].Height)
+	require.Equal(accAddrs[0].String(), exported.FailedEvents[0].DelegatorAddress)
+}
+
+func (s *TestSuite) TestValidateGenesis() {
+	require := s.Require()
+	keeper := s.EVMStakingKeeper
+
+	_, accAddrs, _ := createAddresses(1)
+	evmAddr := common.HexToAddress("0x1234").String()
+
+	tcs := []struct {
+		name        string
+		modify      func(gs *types.GenesisState)
+		expectedErr string
+	}{
+		{
+			name:   "pass: default",
+			modify: func(*types.GenesisState) {},
+		},
+		{
+			name: "fail: queue rear before front",
+			modify: func(gs *types.GenesisState) {
+				gs.WithdrawalQueue = types.WithdrawalQueue{Front: 2, Rear: 1}
+			},
+			expectedErr: "queue rear before front",
+		},
+		{
+			name: "fail: queue length mismatch",
+			modify: func(gs *types.GenesisState) {
+				gs.RewardWithdrawalQueue = types.WithdrawalQueue{Front: 1, Rear: 3, Withdrawals: []types.Withdrawal{types.NewWithdrawal(1, evmAddr, 1)}}
+			},
+			expectedErr: "queue length mismatch",
+		},
+		{
+			name: "fail: invalid withdrawal address",
+			modify: func(gs *types.GenesisState) {
+				gs.WithdrawalQueue = types.WithdrawalQueue{Front: 0, Rear: 1, Withdrawals: []types.Withdrawal{types.NewWithdrawal(1, "invalid", 1)}}
+			},
+			expectedErr: "invalid withdrawal",
+		},
+		{
+			name: "fail: invalid delegator address",
+			modify: func(gs *types.GenesisState) {
+				gs.DelegatorWithdrawAddresses = []types.AddressMapping{{Key: "invalid", Address: evmAddr}}
+			},
+			expectedErr: "validate delegator withdraw addresses: invalid key",
+		},
+		{
+			name: "fail: invalid mapped address",
+			modify: func(gs *types.GenesisState) {
+				gs.DelegatorRewardAddresses = []types.AddressMapping{{Key: accAddrs[0].String(), Address: "invalid"}}
+			},
+			expectedErr: "validate delegator reward addresses: invalid address",
+		},
+		{
+			name: "fail: invalid operator address",
+			modify: func(gs *types.GenesisState) {
+				gs.DelegatorOperators = []types.DelegatorOperator{{DelegatorAddress: accAddrs[0].String(), OperatorAddress: "invalid", Permissions: types.OperatorPermissionAll}}
+			},
+			expectedErr: "validate delegator operators: invalid operator address",
+		},
+		{
+			name: "fail: unknown operator permissions",
+			modify: func(gs *types.GenesisState) {
+				gs.DelegatorOperators = []types.DelegatorOperator{{DelegatorAddress: accAddrs[0].String(), OperatorAddress: evmAddr, Permissions: types.OperatorPermissionAll + 1}}
+			},
+			expectedErr: "validate delegator operators: invalid operator permissions",
+		},
+		{
+			name: "fail: duplicate delegator operator",
+			modify: func(gs *types.GenesisState) {
+				gs.DelegatorOperators = []types.DelegatorOperator{
+					{DelegatorAddress: accAddrs[0].String(), OperatorAddress: evmAddr, Permissions: types.OperatorPermissionUnstake},
+					{DelegatorAddress: accAddrs[0].String(), OperatorAddress: strings.ToLower(evmAddr), Permissions: types.OperatorPermissionRedelegate},
+				}
+			},
+			expectedErr: "validate delegator operators: duplicate delegator operator",
+		},
+		{
+			name: "fail: duplicate delegator address",
+			modify: func(gs *types.GenesisState) {
+				gs.DelegatorRewardAddresses = []types.AddressMapping{
+					{Key: accAddrs[0].String(), Address: evmAddr},
+					{Key: accAddrs[0].String(), Address: evmAddr},
+				}
+			},
+			expectedErr: "duplicate key",
+		},
+		{
+			name: "fail: invalid fee recipient validator",
+			modify: func(gs *types.GenesisState) {
+				gs.ValidatorFeeRecipients = []types.AddressMapping{{Key: accAddrs[0].String(), Address: evmAddr}}
+			},
+			expectedErr: "validate validator fee recipients: invalid key",
+		},
+		{
+			name: "fail: duplicate ubi withdrawal height",
+			modify: func(gs *types.GenesisState) {
+				gs.UbiWithdrawals = []types.Withdrawal{types.NewWithdrawal(1, evmAddr, 1), types.NewWithdrawal(1, evmAddr, 2)}
+			},
+			expectedErr: "duplicate ubi withdrawal height",
+		},
+		{
+			name: "fail: failed event id not below next id",
+			modify: func(gs *types.GenesisState) {
+				gs.FailedEvents = []types.FailedEvent{{Id: 0}, {Id: 1}}
+				gs.NextFailedEventId = 1
+			},
+			expectedErr: "failed event id not below next failed event id",
+		},
+	}
+
+	for _, tc := range tcs {
+		s.Run(tc.name, func() {
+			gs := types.DefaultGenesisState()
+			tc.modify(gs)
+			err := keeper.ValidateGenesis(gs)
+			if tc.expectedErr != "" {
+				require.ErrorContains(err, tc.expectedErr)
+			} else {
+				require.NoError(err)
+			}
+		})
+	}
+}
+
 /*
 import (
 	"context"
@@ -3,13 +3,17 @@ package keeper
 import (
 	"context"
 
+	sdkcollections "cosmossdk.io/collections"
 	"cosmossdk.io/store/prefix"
 
 	"github.com/cosmos/cosmos-sdk/runtime"
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/query"
+	"github.com/ethereum/go-ethereum/common"
 
 	"github.com/piplabs/story/client/collections"
 	"github.com/piplabs/story/client/x/evmstaking/types"
+	"github.com/piplabs/story/lib/errors"
 
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
@@ -30,33 +34,222 @@ func (k Keeper) Params(ctx context.Context, request *types.QueryParamsRequest) (
 	return &types.QueryParamsResponse{Params: params}, nil
 }
 
-// GetWithdrawalQueue returns the withdrawal queue in pagination.
-// TODO: GetWithdrawalQueue tests.
+// GetWithdrawalQueue returns the withdrawal queue in pagination, optionally filtered by EVM address.
 func (k Keeper) GetWithdrawalQueue(ctx context.Context, request *types.QueryGetWithdrawalQueueRequest) (*types.QueryGetWithdrawalQueueResponse, error) {
 	if request == nil {
 		return nil, status.Error(codes.InvalidArgument, "empty request")
 	}
 
+	withdrawals, pageResp, err := k.paginateWithdrawalQueue(ctx, types.WithdrawalQueueKey, request.EvmAddress, request.Pagination)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryGetWithdrawalQueueResponse{Withdrawals: withdrawals, Pagination: pageResp}, nil
+}
+
+// GetRewardWithdrawalQueue returns the reward withdrawal queue in pagination, optionally filtered by EVM address.
+func (k Keeper) GetRewardWithdrawalQueue(ctx context.Context, request *types.QueryGetRewardWithdrawalQueueRequest) (*types.QueryGetRewardWithdrawalQueueResponse, error) {
+	if request == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	withdrawals, pageResp, err := k.paginateWithdrawalQueue(ctx, types.RewardWithdrawalQueueKey, request.EvmAddress, request.Pagination)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryGetRewardWithdrawalQueueResponse{Withdrawals: withdrawals, Pagination: pageResp}, nil
+}
+
+// paginateWithdrawalQueue returns the withdrawals of the queue with the given key in pagination, from front to rear.
+// The withdrawals are only those to the EVM address, unless it is empty.
+func (k Keeper) paginateWithdrawalQueue(ctx context.Context, queueKey []byte, evmAddress string, pagination *query.PageRequest,
+) ([]*types.Withdrawal, *query.PageResponse, error) {
+	var filterAddr *common.Address
+	if evmAddress != "" {
+		if !common.IsHexAddress(evmAddress) {
+			return nil, nil, status.Error(codes.InvalidArgument, "invalid evm address")
+		}
+		addr := common.HexToAddress(evmAddress)
+		filterAddr = &addr
+	}
+
 	store := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
-	wqStore := prefix.NewStore(store, append(types.WithdrawalQueueKey, collections.QueueElementsPrefixSuffix)) // withdrawal queue store
+	queueStore := prefix.NewStore(store, append(queueKey, collections.QueueElementsPrefixSuffix))
 
-	withdrawals, pageResp, err := query.GenericFilteredPaginate(k.cdc, wqStore, request.Pagination, func(_ []byte, wit *types.Withdrawal) (*types.Withdrawal, error) {
-		return wit, nil
+	withdrawals, pageResp, err := query.GenericFilteredPaginate(k.cdc, queueStore, pagination, func(_ []byte, w *types.Withdrawal) (*types.Withdrawal, error) {
+		if filterAddr != nil && common.HexToAddress(w.ExecutionAddress) != *filterAddr {
+			return nil, nil // Skip the withdrawals to other addresses.
+		}
+
+		return w, nil
 	}, func() *types.Withdrawal {
 		return &types.Withdrawal{}
 	})
+	if err != nil {
+		return nil, nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return withdrawals, pageResp, nil
+}
+
+// GetDelegatorWithdrawAddress returns the EVM address the unstaked tokens of the delegator are withdrawn to.
+func (k Keeper) GetDelegatorWithdrawAddress(ctx context.Context, request *types.QueryGetDelegatorWithdrawAddressRequest) (*types.QueryGetDelegatorWithdrawAddressResponse, error) {
+	if request == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	withdrawAddr, err := getDelegatorAddress(ctx, k.DelegatorWithdrawAddress, request.DelegatorAddress)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryGetDelegatorWithdrawAddressResponse{WithdrawAddress: withdrawAddr}, nil
+}
+
+// GetDelegatorRewardAddress returns the EVM address the rewards of the delegator are withdrawn to.
+func (k Keeper) GetDelegatorRewardAddress(ctx context.Context, request *types.QueryGetDelegatorRewardAddressRequest) (*types.QueryGetDelegatorRewardAddressResponse, error) {
+	if request == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	rewardAddr, err := getDelegatorAddress(ctx, k.DelegatorRewardAddress, request.DelegatorAddress)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryGetDelegatorRewardAddressResponse{RewardAddress: rewardAddr}, nil
+}
+
+// GetDelegatorOperatorAddress returns the EVM address of the first operator of the delegator, ordered by
+// address, that is granted all permissions, i.e., an operator as unscoped as the single operator before
+// the operator permissions.
+//
+// Deprecated: Delegators can have several scoped operators, use GetDelegatorOperators instead.
+func (k Keeper) GetDelegatorOperatorAddress(ctx context.Context, request *types.QueryGetDelegatorOperatorAddressRequest) (*types.QueryGetDelegatorOperatorAddressResponse, error) {
+	if request == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	if _, err := sdk.AccAddressFromBech32(request.DelegatorAddress); err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid delegator address")
+	}
+
+	iter, err := k.DelegatorOperators.Iterate(ctx, sdkcollections.NewPrefixedPairRange[string, string](request.DelegatorAddress))
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	defer iter.Close()
+
+	for ; iter.Valid(); iter.Next() {
+		kv, err := iter.KeyValue()
+		if err != nil {
+			return nil, status.Error(codes.Internal, err.Error())
+		}
+
+		if (types.DelegatorOperator{Permissions: kv.Value}).HasPermission(types.OperatorPermissionAll) {
+			return &types.QueryGetDelegatorOperatorAddressResponse{OperatorAddress: kv.Key.K2()}, nil
+		}
+	}
+
+	return nil, status.Error(codes.NotFound, "delegator operator address not found")
+}
+
+// GetDelegatorOperators returns the operators of the delegator and their permissions in pagination,
+// ordered by operator address. A delegator without operators has none.
+func (k Keeper) GetDelegatorOperators(ctx context.Context, request *types.QueryGetDelegatorOperatorsRequest) (*types.QueryGetDelegatorOperatorsResponse, error) {
+	if request == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	if _, err := sdk.AccAddressFromBech32(request.DelegatorAddress); err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid delegator address")
+	}
+
+	operators, pageResp, err := query.CollectionPaginate(ctx, k.DelegatorOperators, request.Pagination,
+		func(key sdkcollections.Pair[string, string], permissions uint32) (types.DelegatorOperator, error) {
+			return types.DelegatorOperator{
+				DelegatorAddress: key.K1(),
+				OperatorAddress:  key.K2(),
+				Permissions:      permissions,
+			}, nil
+		},
+		query.WithCollectionPaginationPairPrefix[string, string](request.DelegatorAddress))
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryGetDelegatorOperatorsResponse{Operators: operators, Pagination: pageResp}, nil
+}
+
+// getDelegatorAddress returns the EVM address the delegator is mapped to in the address map.
+func getDelegatorAddress(ctx context.Context, m sdkcollections.Map[string, string], delegatorAddress string) (string, error) {
+	if _, err := sdk.AccAddressFromBech32(delegatorAddress); err != nil {
+		return "", status.Error(codes.InvalidArgument, "invalid delegator address")
+	}
+
+	evmAddr, err := m.Get(ctx, delegatorAddress)
+	if errors.Is(err, sdkcollections.ErrNotFound) {
+		return "", status.Error(codes.NotFound, "delegator address not found")
+	} else if err != nil {
+		return "", status.Error(codes.Internal, err.Error())
+	}
+
+	return evmAddr, nil
+}
+
+// GetUbiWithdrawals returns the history of ubi withdrawals to the ubi withdraw address in pagination,
+// ordered by creation height. Only the ubi withdrawals within the ubi withdrawal retention are kept.
+func (k Keeper) GetUbiWithdrawals(ctx context.Context, request *types.QueryGetUbiWithdrawalsRequest) (*types.QueryGetUbiWithdrawalsResponse, error) {
+	if request == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	withdrawals, pageResp, err := query.CollectionPaginate(ctx, k.UbiWithdrawals, request.Pagination, func(_ uint64, w types.Withdrawal) (*types.Withdrawal, error) {
+		return &w, nil
+	})
 	if err != nil {
 		return nil, status.Error(codes.Internal, err.Error())
 	}
 
-	var ws []*types.Withdrawal
-	for _, w := range withdrawals {
-		ws = append(ws, &types.Withdrawal{
-			CreationHeight:   w.CreationHeight,
-			ExecutionAddress: w.ExecutionAddress,
-			Amount:           w.Amount,
+	return &types.QueryGetUbiWithdrawalsResponse{Withdrawals: withdrawals, Pagination: pageResp}, nil
+}
+
+// GetFailedEvents returns the EVM events that failed to be processed in pagination, ordered by ID.
+// The failed events are optionally filtered by delegator or validator address.
+func (k Keeper) GetFailedEvents(ctx context.Context, request *types.QueryGetFailedEventsRequest) (*types.QueryGetFailedEventsResponse, error) {
+	if request == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	var (
+		failedEvents []types.FailedEvent
+		pageResp     *query.PageResponse
+		err          error
+	)
+
+	getFailedEvent := func(key sdkcollections.Pair[string, uint64], _ sdkcollections.NoValue) (types.FailedEvent, error) {
+		return k.FailedEvents.Get(ctx, key.K2())
+	}
+
+	switch {
+	case request.DelegatorAddress != "" && request.ValidatorAddress != "":
+		return nil, status.Error(codes.InvalidArgument, "only one of delegator and validator address can be set")
+	case request.DelegatorAddress != "":
+		failedEvents, pageResp, err = query.CollectionPaginate(ctx, k.FailedEventsByDelegator, request.Pagination, getFailedEvent,
+			query.WithCollectionPaginationPairPrefix[string, uint64](request.DelegatorAddress))
+	case request.ValidatorAddress != "":
+		failedEvents, pageResp, err = query.CollectionPaginate(ctx, k.FailedEventsByValidator, request.Pagination, getFailedEvent,
+			query.WithCollectionPaginationPairPrefix[string, uint64](request.ValidatorAddress))
+	default:
+		failedEvents, pageResp, err = query.CollectionPaginate(ctx, k.FailedEvents, request.Pagination, func(_ uint64, failed types.FailedEvent) (types.FailedEvent, error) {
+			return failed, nil
 		})
 	}
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
 
-	return &types.QueryGetWithdrawalQueueResponse{Withdrawals: ws, Pagination: pageResp}, nil
+	return &types.QueryGetFailedEventsResponse{FailedEvents: failedEvents, Pagination: pageResp}, nil
 }
@@ -61,3 +61,256 @@ func (s *TestSuite) TestGetWithdrawalQueue() {
 	require.NoError(err)
 	require.Equal(1, len(res.Withdrawals), "expected 1 withdrawal after second page query, but found %d", len(res.Withdrawals))
 }
+
+func (s *TestSuite) TestGetWithdrawalQueues_ByEVMAddress() {
+	require := s.Require()
+	ctx, keeper, queryClient := s.Ctx, s.EVMStakingKeeper, s.queryClient
+	require.NoError(keeper.WithdrawalQueue.Initialize(ctx))
+	require.NoError(keeper.RewardWithdrawalQueue.Initialize(ctx))
+
+	addr1 := common.HexToAddress("0x131D25EDE18178BAc9275b312001a63C081722d2")
+	addr2 := common.HexToAddress("0x4C47fE7bC6e9A8D64E7e1E28CE4fAd1ca5C0bE9e")
+	for i, addr := range []common.Address{addr1, addr2, addr1} {
+		require.NoError(keeper.AddWithdrawalToQueue(ctx, types.NewWithdrawal(uint64(i), addr.String(), 100)))
+		require.NoError(keeper.AddRewardWithdrawalToQueue(ctx, types.NewWithdrawal(uint64(i), addr.String(), 200)))
+	}
+
+	tcs := []struct {
+		name           string
+		evmAddress     string
+		pagination     *query.PageRequest
+		expectedHeight []uint64
+		expectedErr    string
+	}{
+		{
+			name:           "pass: all withdrawals",
+			expectedHeight: []uint64{0, 1, 2},
+		},
+		{
+			name:           "pass: by evm address",
+			evmAddress:     addr1.String(),
+			expectedHeight: []uint64{0, 2},
+		},
+		{
+			name:           "pass: by lowercase evm address",
+			evmAddress:     "0x4c47fe7bc6e9a8d64e7e1e28ce4fad1ca5c0be9e",
+			expectedHeight: []uint64{1},
+		},
+		{
+			name:           "pass: by evm address with pagination",
+			evmAddress:     addr1.String(),
+			pagination:     &query.PageRequest{Offset: 1},
+			expectedHeight: []uint64{2},
+		},
+		{
+			name:       "pass: unknown evm address",
+			evmAddress: common.HexToAddress("0x01").String(),
+		},
+		{
+			name:        "fail: invalid evm address",
+			evmAddress:  "invalid",
+			expectedErr: "invalid evm address",
+		},
+	}
+
+	heights := func(withdrawals []*types.Withdrawal) []uint64 {
+		var hs []uint64
+		for _, w := range withdrawals {
+			hs = append(hs, w.CreationHeight)
+		}
+
+		return hs
+	}
+
+	for _, tc := range tcs {
+		s.Run(tc.name, func() {
+			res, err := queryClient.GetWithdrawalQueue(context.Background(), &types.QueryGetWithdrawalQueueRequest{
+				EvmAddress: tc.evmAddress,
+				Pagination: tc.pagination,
+			})
+			if tc.expectedErr != "" {
+				require.ErrorContains(err, tc.expectedErr)
+			} else {
+				require.NoError(err)
+				require.Equal(tc.expectedHeight, heights(res.Withdrawals))
+			}
+
+			rewardRes, err := queryClient.GetRewardWithdrawalQueue(context.Background(), &types.QueryGetRewardWithdrawalQueueRequest{
+				EvmAddress: tc.evmAddress,
+				Pagination: tc.pagination,
+			})
+			if tc.expectedErr != "" {
+				require.ErrorContains(err, tc.expectedErr)
+			} else {
+				require.NoError(err)
+				require.Equal(tc.expectedHeight, heights(rewardRes.Withdrawals))
+				for _, w := range rewardRes.Withdrawals {
+					require.Equal(uint64(200), w.Amount)
+				}
+			}
+		})
+	}
+}
+
+func (s *TestSuite) TestGetDelegatorAddresses() {
+	require := s.Require()
+	ctx, keeper, queryClient := s.Ctx, s.EVMStakingKeeper, s.queryClient
+
+	pubKeys, accAddrs, _ := createAddresses(2)
+	withdrawAddr := cmpToEVM(pubKeys[0].Bytes()).String()
+	rewardAddr := cmpToEVM(pubKeys[1].Bytes()).String()
+	require.NoError(keeper.DelegatorWithdrawAddress.Set(ctx, accAddrs[0].String(), withdrawAddr))
+	require.NoError(keeper.DelegatorRewardAddress.Set(ctx, accAddrs[0].String(), rewardAddr))
+
+	tcs := []struct {
+		name        string
+		delAddr     string
+		expectedErr string
+	}{
+		{
+			name:    "pass",
+			delAddr: accAddrs[0].String(),
+		},
+		{
+			name:        "fail: unknown delegator",
+			delAddr:     accAddrs[1].String(),
+			expectedErr: "delegator address not found",
+		},
+		{
+			name:        "fail: invalid delegator address",
+			delAddr:     "invalid",
+			expectedErr: "invalid delegator address",
+		},
+	}
+
+	for _, tc := range tcs {
+		s.Run(tc.name, func() {
+			withdrawRes, err := queryClient.GetDelegatorWithdrawAddress(context.Background(), &types.QueryGetDelegatorWithdrawAddressRequest{DelegatorAddress: tc.delAddr})
+			if tc.expectedErr != "" {
+				require.ErrorContains(err, tc.expectedErr)
+			} else {
+				require.NoError(err)
+				require.Equal(withdrawAddr, withdrawRes.WithdrawAddress)
+			}
+
+			rewardRes, err := queryClient.GetDelegatorRewardAddress(context.Background(), &types.QueryGetDelegatorRewardAddressRequest{DelegatorAddress: tc.delAddr})
+			if tc.expectedErr != "" {
+				require.ErrorContains(err, tc.expectedErr)
+			} else {
+				require.NoError(err)
+				require.Equal(rewardAddr, rewardRes.RewardAddress)
+			}
+		})
+	}
+}
+
+func (s *TestSuite) TestGetDelegatorOperators() {
+	require := s.Require()
+	ctx, keeper, queryClient := s.Ctx, s.EVMStakingKeeper, s.queryClient
+
+	_, accAddrs, _ := createAddresses(2)
+	operators := []common.Address{common.HexToAddress("0x01"), common.HexToAddress("0x02")}
+	require.NoError(keeper.SetDelegatorOperator(ctx, accAddrs[0], operators[0], types.OperatorPermissionUnstake))
+	require.NoError(keeper.SetDelegatorOperator(ctx, accAddrs[0], operators[1], types.OperatorPermissionAll))
+
+	tcs := []struct {
+		name        string
+		req         *types.QueryGetDelegatorOperatorsRequest
+		expected    []types.DelegatorOperator
+		expectedErr string
+	}{
+		{
+			name: "pass",
+			req:  &types.QueryGetDelegatorOperatorsRequest{DelegatorAddress: accAddrs[0].String()},
+			expected: []types.DelegatorOperator{
+				{DelegatorAddress: accAddrs[0].String(), OperatorAddress: operators[0].String(), Permissions: types.OperatorPermissionUnstake},
+				{DelegatorAddress: accAddrs[0].String(), OperatorAddress: operators[1].String(), Permissions: types.OperatorPermissionAll},
+			},
+		},
+		{
+			name: "pass: paginated",
+			req: &types.QueryGetDelegatorOperatorsRequest{
+				DelegatorAddress: accAddrs[0].String(),
+				Pagination:       &query.PageRequest{Limit: 1},
+			},
+			expected: []types.DelegatorOperator{
+				{DelegatorAddress: accAddrs[0].String(), OperatorAddress: operators[0].String(), Permissions: types.OperatorPermissionUnstake},
+			},
+		},
+		{
+			name:     "pass: no operators",
+			req:      &types.QueryGetDelegatorOperatorsRequest{DelegatorAddress: accAddrs[1].String()},
+			expected: []types.DelegatorOperator{},
+		},
+		{
+			name:        "fail: invalid delegator address",
+			req:         &types.QueryGetDelegatorOperatorsRequest{DelegatorAddress: "invalid"},
+			expectedErr: "invalid delegator address",
+		},
+	}
+
+	for _, tc := range tcs {
+		s.Run(tc.name, func() {
+			res, err := queryClient.GetDelegatorOperators(context.Background(), tc.req)
+			if tc.expectedErr != "" {
+				require.ErrorContains(err, tc.expectedErr)
+			} else {
+				require.NoError(err)
+				require.ElementsMatch(tc.expected, res.Operators)
+			}
+		})
+	}
+}
+
+func (s *TestSuite) TestGetDelegatorOperatorAddress() {
+	require := s.Require()
+	ctx, keeper, queryClient := s.Ctx, s.EVMStakingKeeper, s.queryClient
+
+	_, accAddrs, _ := createAddresses(3)
+	operators := []common.Address{common.HexToAddress("0x01"), common.HexToAddress("0x02"), common.HexToAddress("0x03")}
+	require.NoError(keeper.SetDelegatorOperator(ctx, accAddrs[0], operators[0], types.OperatorPermissionUnstake))
+	require.NoError(keeper.SetDelegatorOperator(ctx, accAddrs[0], operators[1], types.OperatorPermissionAll))
+	require.NoError(keeper.SetDelegatorOperator(ctx, accAddrs[0], operators[2], types.OperatorPermissionAll))
+	require.NoError(keeper.SetDelegatorOperator(ctx, accAddrs[1], operators[0], types.OperatorPermissionUnjail))
+
+	tcs := []struct {
+		name        string
+		req         *types.QueryGetDelegatorOperatorAddressRequest
+		expected    string
+		expectedErr string
+	}{
+		{
+			name:     "pass: first operator granted all permissions",
+			req:      &types.QueryGetDelegatorOperatorAddressRequest{DelegatorAddress: accAddrs[0].String()},
+			expected: operators[1].String(),
+		},
+		{
+			name:        "fail: only scoped operators",
+			req:         &types.QueryGetDelegatorOperatorAddressRequest{DelegatorAddress: accAddrs[1].String()},
+			expectedErr: "delegator operator address not found",
+		},
+		{
+			name:        "fail: no operators",
+			req:         &types.QueryGetDelegatorOperatorAddressRequest{DelegatorAddress: accAddrs[2].String()},
+			expectedErr: "delegator operator address not found",
+		},
+		{
+			name:        "fail: invalid delegator address",
+			req:         &types.QueryGetDelegatorOperatorAddressRequest{DelegatorAddress: "invalid"},
+			expectedErr: "invalid delegator address",
+		},
+	}
+
+	for _, tc := range tcs {
+		s.Run(tc.name, func() {
+			//nolint:staticcheck // Tests the deprecated query.
+			res, err := queryClient.GetDelegatorOperatorAddress(context.Background(), tc.req)
+			if tc.expectedErr != "" {
+				require.ErrorContains(err, tc.expectedErr)
+			} else {
+				require.NoError(err)
+				require.Equal(tc.expected, res.OperatorAddress)
+			}
+		})
+	}
+}
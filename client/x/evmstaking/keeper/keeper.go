@@ -12,6 +12,7 @@ import (
 
 	"github.com/cosmos/cosmos-sdk/codec"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/accounts/abi"
 	"github.com/ethereum/go-ethereum/common"
 
 	addcollections "github.com/piplabs/story/client/collections"
@@ -43,7 +44,17 @@ type Keeper struct {
 	RewardWithdrawalQueue    addcollections.Queue[types.Withdrawal]
 	DelegatorWithdrawAddress collections.Map[string, string]
 	DelegatorRewardAddress   collections.Map[string, string]
-	DelegatorOperatorAddress collections.Map[string, string]
+	DelegatorOperators       collections.Map[collections.Pair[string, string], uint32]
+	ValidatorFeeRecipient    collections.Map[string, string]
+	UbiWithdrawals           collections.Map[uint64, types.Withdrawal]
+	FailedEvents             collections.Map[uint64, types.FailedEvent]
+	FailedEventsByDelegator  collections.KeySet[collections.Pair[string, uint64]]
+	FailedEventsByValidator  collections.KeySet[collections.Pair[string, uint64]]
+	NextFailedEventID        collections.Sequence
+	NextWithdrawalIndex      collections.Item[uint64]
+
+	// legacyDelegatorOperatorAddress is the single operator per delegator map, only kept for the migration to DelegatorOperators.
+	legacyDelegatorOperatorAddress collections.Map[string, string]
 }
 
 // NewKeeper creates a new evmstaking Keeper instance.
@@ -91,7 +102,16 @@ func NewKeeper(
 		RewardWithdrawalQueue:    addcollections.NewQueue(sb, types.RewardWithdrawalQueueKey, "reward_withdrawal_queue", codec.CollValue[types.Withdrawal](cdc)),
 		DelegatorWithdrawAddress: collections.NewMap(sb, types.DelegatorWithdrawAddressMapKey, "delegator_withdraw_address_map", collections.StringKey, collections.StringValue),
 		DelegatorRewardAddress:   collections.NewMap(sb, types.DelegatorRewardAddressMapKey, "delegator_reward_address_map", collections.StringKey, collections.StringValue),
-		DelegatorOperatorAddress: collections.NewMap(sb, types.DelegatorOperatorAddressMapKey, "delegator_operator_address_map", collections.StringKey, collections.StringValue),
+		DelegatorOperators:       collections.NewMap(sb, types.DelegatorOperatorsKey, "delegator_operators", collections.PairKeyCodec(collections.StringKey, collections.StringKey), collections.Uint32Value),
+		ValidatorFeeRecipient:    collections.NewMap(sb, types.ValidatorFeeRecipientMapKey, "validator_fee_recipient_map", collections.StringKey, collections.StringValue),
+		UbiWithdrawals:           collections.NewMap(sb, types.UbiWithdrawalsMapKey, "ubi_withdrawals_map", collections.Uint64Key, codec.CollValue[types.Withdrawal](cdc)),
+		FailedEvents:             collections.NewMap(sb, types.FailedEventsMapKey, "failed_events_map", collections.Uint64Key, codec.CollValue[types.FailedEvent](cdc)),
+		FailedEventsByDelegator:  collections.NewKeySet(sb, types.FailedEventsByDelegatorKey, "failed_events_by_delegator", collections.PairKeyCodec(collections.StringKey, collections.Uint64Key)),
+		FailedEventsByValidator:  collections.NewKeySet(sb, types.FailedEventsByValidatorKey, "failed_events_by_validator", collections.PairKeyCodec(collections.StringKey, collections.Uint64Key)),
+		NextFailedEventID:        collections.NewSequence(sb, types.NextFailedEventIDKey, "next_failed_event_id"),
+		NextWithdrawalIndex:      collections.NewItem(sb, types.NextWithdrawalIndexKey, "next_withdrawal_index", collections.Uint64Value),
+
+		legacyDelegatorOperatorAddress: collections.NewMap(sb, types.DelegatorOperatorAddressMapKey, "delegator_operator_address_map", collections.StringKey, collections.StringValue),
 	}
 }
 
@@ -109,6 +129,50 @@ func (k Keeper) ValidatorAddressCodec() addresscodec.Codec {
 	return k.validatorAddressCodec
 }
 
+var _ evmenginetypes.EvmEventProcessor = Keeper{}
+
+// Name returns the name of the staking EVM event processor.
+func (Keeper) Name() string {
+	return types.ModuleName
+}
+
+// Order returns the delivery order of the staking EVM event processor.
+func (Keeper) Order() uint32 {
+	return types.EvmEventProcessorOrder
+}
+
+// Addresses returns the IPTokenStaking contract address, whose EVM events are delivered to the keeper.
+func (Keeper) Addresses() []common.Address {
+	return []common.Address{common.HexToAddress(predeploys.IPTokenStaking)}
+}
+
+// Events returns the IPTokenStaking contract EVM events processed by the keeper.
+func (Keeper) Events() []abi.Event {
+	return []abi.Event{
+		types.UpdateValidatorCommission,
+		types.SetFeeRecipient,
+		types.SetWithdrawalAddress,
+		types.SetRewardAddress,
+		types.AddOperator,
+		types.RemoveOperator,
+		types.SetOperatorPermissions,
+		types.CreateValidatorEvent,
+		types.DepositEvent,
+		types.RedelegateEvent,
+		types.WithdrawEvent,
+		types.UnjailEvent,
+		types.MinStakeAmountSetEvent,
+		types.MinUnstakeAmountSetEvent,
+		types.MinCommissionRateChangedEvent,
+		types.FeeSetEvent,
+	}
+}
+
+// Deliver processes the IPTokenStaking contract EVM events, see ProcessStakingEvents.
+func (k Keeper) Deliver(ctx context.Context, height uint64, events []*evmenginetypes.EVMEvent) error {
+	return k.ProcessStakingEvents(ctx, height, events)
+}
+
 //nolint:gocyclo // TODO
 func (k Keeper) ProcessStakingEvents(ctx context.Context, height uint64, logs []*evmenginetypes.EVMEvent) error {
 	gwei, exp := big.NewInt(10), big.NewInt(9)
@@ -123,8 +187,8 @@ func (k Keeper) ProcessStakingEvents(ctx context.Context, height uint64, logs []
 			return err
 		}
 
-		// TODO: handle when each event processing fails.
-
+		// Undecodable events are skipped by x/evmengine before delivery, so failing to parse an event fails the block.
+		// Events that fail to be processed are recorded for auditing, without halting the processing of the other events.
 		// Convert the amount from wei to gwei (Eth2 spec withdrawal is specified in gwei) by dividing by 10^9.
 		// TODO: consider rounding and decimal precision when dividing bigint.
 
@@ -132,105 +196,168 @@ func (k Keeper) ProcessStakingEvents(ctx context.Context, height uint64, logs []
 		case types.UpdateValidatorCommission.ID:
 			ev, err := k.ipTokenStakingContract.ParseUpdateValidatorCommssion(ethlog)
 			if err != nil {
-				clog.Error(ctx, "Failed to parse UpdateValidatorCommission log", err)
-				continue
+				return errors.Wrap(err, "parse UpdateValidatorCommission log")
 			}
 			if err = k.ProcessUpdateValidatorCommission(ctx, ev); err != nil {
 				clog.Error(ctx, "Failed to process update validator commission", err)
+				k.RecordFailedEvent(ctx, evmLog, types.UpdateValidatorCommission.Name, ev, err)
+				continue
+			}
+		case types.SetFeeRecipient.ID:
+			ev, err := k.ipTokenStakingContract.ParseSetFeeRecipient(ethlog)
+			if err != nil {
+				return errors.Wrap(err, "parse SetFeeRecipient log")
+			}
+			if err = k.ProcessSetFeeRecipient(ctx, ev); err != nil {
+				clog.Error(ctx, "Failed to process set fee recipient", err)
+				k.RecordFailedEvent(ctx, evmLog, types.SetFeeRecipient.Name, ev, err)
 				continue
 			}
 		case types.SetWithdrawalAddress.ID:
 			ev, err := k.ipTokenStakingContract.ParseSetWithdrawalAddress(ethlog)
 			if err != nil {
-				clog.Error(ctx, "Failed to parse SetWithdrawalAddress log", err)
-				continue
+				return errors.Wrap(err, "parse SetWithdrawalAddress log")
 			}
 			if err = k.ProcessSetWithdrawalAddress(ctx, ev); err != nil {
 				clog.Error(ctx, "Failed to process set withdrawal address", err)
+				k.RecordFailedEvent(ctx, evmLog, types.SetWithdrawalAddress.Name, ev, err)
 				continue
 			}
 		case types.SetRewardAddress.ID:
 			ev, err := k.ipTokenStakingContract.ParseSetRewardAddress(ethlog)
 			if err != nil {
-				clog.Error(ctx, "Failed to parse SetRewardAddress log", err)
-				continue
+				return errors.Wrap(err, "parse SetRewardAddress log")
 			}
 			if err = k.ProcessSetRewardAddress(ctx, ev); err != nil {
 				clog.Error(ctx, "Failed to process set reward address", err)
+				k.RecordFailedEvent(ctx, evmLog, types.SetRewardAddress.Name, ev, err)
 				continue
 			}
 		case types.AddOperator.ID:
 			ev, err := k.ipTokenStakingContract.ParseAddOperator(ethlog)
 			if err != nil {
-				clog.Error(ctx, "Failed to parse SetRewardAddress log", err)
-				continue
+				return errors.Wrap(err, "parse AddOperator log")
 			}
 			if err = k.ProcessAddOperator(ctx, ev); err != nil {
 				clog.Error(ctx, "Failed to process add operator", err)
+				k.RecordFailedEvent(ctx, evmLog, types.AddOperator.Name, ev, err)
 				continue
 			}
 		case types.RemoveOperator.ID:
 			ev, err := k.ipTokenStakingContract.ParseRemoveOperator(ethlog)
 			if err != nil {
-				clog.Error(ctx, "Failed to parse SetRewardAddress log", err)
-				continue
+				return errors.Wrap(err, "parse RemoveOperator log")
 			}
 			if err = k.ProcessRemoveOperator(ctx, ev); err != nil {
 				clog.Error(ctx, "Failed to process add operator", err)
+				k.RecordFailedEvent(ctx, evmLog, types.RemoveOperator.Name, ev, err)
+				continue
+			}
+		case types.SetOperatorPermissions.ID:
+			ev, err := k.ipTokenStakingContract.ParseSetOperatorPermissions(ethlog)
+			if err != nil {
+				return errors.Wrap(err, "parse SetOperatorPermissions log")
+			}
+			if err = k.ProcessSetOperatorPermissions(ctx, ev); err != nil {
+				clog.Error(ctx, "Failed to process set operator permissions", err)
+				k.RecordFailedEvent(ctx, evmLog, types.SetOperatorPermissions.Name, ev, err)
 				continue
 			}
 		case types.CreateValidatorEvent.ID:
 			ev, err := k.ParseCreateValidatorLog(ethlog)
 			if err != nil {
-				clog.Error(ctx, "Failed to parse CreateValidator log", err)
-				continue
+				return errors.Wrap(err, "parse CreateValidator log")
 			}
 			ev.StakeAmount.Div(ev.StakeAmount, gwei)
 			if err = k.ProcessCreateValidator(ctx, ev); err != nil {
 				clog.Error(ctx, "Failed to process create validator", err)
+				k.RecordFailedEvent(ctx, evmLog, types.CreateValidatorEvent.Name, ev, err)
 				continue
 			}
 		case types.DepositEvent.ID:
 			ev, err := k.ParseDepositLog(ethlog)
 			if err != nil {
-				clog.Error(ctx, "Failed to parse Deposit log", err)
-				continue
+				return errors.Wrap(err, "parse Deposit log")
 			}
 			ev.StakeAmount.Div(ev.StakeAmount, gwei)
 			if err = k.ProcessDeposit(ctx, ev); err != nil {
 				clog.Error(ctx, "Failed to process deposit", err)
+				k.RecordFailedEvent(ctx, evmLog, types.DepositEvent.Name, ev, err)
 				continue
 			}
 		case types.RedelegateEvent.ID:
 			ev, err := k.ParseRedelegateLog(ethlog)
 			if err != nil {
-				clog.Error(ctx, "Failed to parse Redelegate log", err)
-				continue
+				return errors.Wrap(err, "parse Redelegate log")
 			}
 			ev.Amount.Div(ev.Amount, gwei)
 			if err = k.ProcessRedelegate(ctx, ev); err != nil {
 				clog.Error(ctx, "Failed to process redelegate", err)
+				k.RecordFailedEvent(ctx, evmLog, types.RedelegateEvent.Name, ev, err)
 				continue
 			}
 		case types.WithdrawEvent.ID:
 			ev, err := k.ParseWithdrawLog(ethlog)
 			if err != nil {
-				clog.Error(ctx, "Failed to parse Withdraw log", err)
-				continue
+				return errors.Wrap(err, "parse Withdraw log")
 			}
 			ev.StakeAmount.Div(ev.StakeAmount, gwei)
 			if err = k.ProcessWithdraw(ctx, ev); err != nil {
 				clog.Error(ctx, "Failed to process withdraw", err)
+				k.RecordFailedEvent(ctx, evmLog, types.WithdrawEvent.Name, ev, err)
 				continue
 			}
 		case types.UnjailEvent.ID:
 			ev, err := k.ParseUnjailLog(ethlog)
 			if err != nil {
-				clog.Error(ctx, "Failed to parse Unjail log", err)
-				continue
+				return errors.Wrap(err, "parse Unjail log")
 			}
 			if err = k.ProcessUnjail(ctx, ev); err != nil {
 				clog.Error(ctx, "Failed to process unjail", err)
+				k.RecordFailedEvent(ctx, evmLog, types.UnjailEvent.Name, ev, err)
+				continue
+			}
+		case types.MinStakeAmountSetEvent.ID:
+			ev, err := k.ipTokenStakingContract.ParseMinStakeAmountSet(ethlog)
+			if err != nil {
+				return errors.Wrap(err, "parse MinStakeAmountSet log")
+			}
+			ev.MinStakeAmount.Div(ev.MinStakeAmount, gwei)
+			if err = k.ProcessMinStakeAmountSet(ctx, ev); err != nil {
+				clog.Error(ctx, "Failed to process min stake amount set", err)
+				k.RecordFailedEvent(ctx, evmLog, types.MinStakeAmountSetEvent.Name, ev, err)
+				continue
+			}
+		case types.MinUnstakeAmountSetEvent.ID:
+			ev, err := k.ipTokenStakingContract.ParseMinUnstakeAmountSet(ethlog)
+			if err != nil {
+				return errors.Wrap(err, "parse MinUnstakeAmountSet log")
+			}
+			ev.MinUnstakeAmount.Div(ev.MinUnstakeAmount, gwei)
+			if err = k.ProcessMinUnstakeAmountSet(ctx, ev); err != nil {
+				clog.Error(ctx, "Failed to process min unstake amount set", err)
+				k.RecordFailedEvent(ctx, evmLog, types.MinUnstakeAmountSetEvent.Name, ev, err)
+				continue
+			}
+		case types.MinCommissionRateChangedEvent.ID:
+			ev, err := k.ipTokenStakingContract.ParseMinCommissionRateChanged(ethlog)
+			if err != nil {
+				return errors.Wrap(err, "parse MinCommissionRateChanged log")
+			}
+			if err = k.ProcessMinCommissionRateChanged(ctx, ev); err != nil {
+				clog.Error(ctx, "Failed to process min commission rate changed", err)
+				k.RecordFailedEvent(ctx, evmLog, types.MinCommissionRateChangedEvent.Name, ev, err)
+				continue
+			}
+		case types.FeeSetEvent.ID:
+			ev, err := k.ipTokenStakingContract.ParseFeeSet(ethlog)
+			if err != nil {
+				return errors.Wrap(err, "parse FeeSet log")
+			}
+			ev.NewFee.Div(ev.NewFee, gwei)
+			if err = k.ProcessFeeSet(ctx, ev); err != nil {
+				clog.Error(ctx, "Failed to process fee set", err)
+				k.RecordFailedEvent(ctx, evmLog, types.FeeSetEvent.Name, ev, err)
 				continue
 			}
 		}
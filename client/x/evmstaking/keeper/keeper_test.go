@@ -87,12 +87,14 @@ type TestSuite struct {
 	EVMStakingKeeper *keeper.Keeper
 	queryClient      types.QueryClient
 
-	encCfg moduletestutil.TestEncodingConfig
+	encCfg   moduletestutil.TestEncodingConfig
+	storeKey *storetypes.KVStoreKey
 }
 
 func (s *TestSuite) SetupTest() {
 	s.encCfg = moduletestutil.MakeTestEncodingConfig(module.AppModuleBasic{})
 	evmstakingKey := storetypes.NewKVStoreKey(types.StoreKey)
+	s.storeKey = evmstakingKey
 	stakingKey := storetypes.NewKVStoreKey(stypes.StoreKey)
 	storeService := runtime.NewKVStoreService(evmstakingKey)
 	stakingStoreService := runtime.NewKVStoreService(stakingKey)
@@ -258,9 +260,74 @@ func (s *TestSuite) TestProcessStakingEvents() {
 			},
 			expectedError: "verify log [BUG]",
 		},
-		// INVALID LOGS but PASS Cases because currently we are handling it as a continued
+		// Undecodable logs fail, since they are rejected in proposals.
 		{
-			name: "pass(continue): invalid SetWithdrawalEvent log",
+			name: "fail: invalid UpdateValidatorCommissionEvent log",
+			evmEvents: func() ([]*evmenginetypes.EVMEvent, error) {
+				logs := []ethtypes.Log{{Topics: []common.Hash{types.UpdateValidatorCommission.ID, dummyHash}}}
+				evmEvents, err := ethLogsToEvmEvents(logs)
+				if err != nil {
+					return nil, err
+				}
+
+				return evmEvents, nil
+			},
+			expectedError: "parse UpdateValidatorCommission log",
+		},
+		{
+			name: "fail: invalid SetFeeRecipientEvent log",
+			evmEvents: func() ([]*evmenginetypes.EVMEvent, error) {
+				logs := []ethtypes.Log{{Topics: []common.Hash{types.SetFeeRecipient.ID, dummyHash}}}
+				evmEvents, err := ethLogsToEvmEvents(logs)
+				if err != nil {
+					return nil, err
+				}
+
+				return evmEvents, nil
+			},
+			expectedError: "parse SetFeeRecipient log",
+		},
+		{
+			name: "fail: invalid SetRewardAddressEvent log",
+			evmEvents: func() ([]*evmenginetypes.EVMEvent, error) {
+				logs := []ethtypes.Log{{Topics: []common.Hash{types.SetRewardAddress.ID, dummyHash}}}
+				evmEvents, err := ethLogsToEvmEvents(logs)
+				if err != nil {
+					return nil, err
+				}
+
+				return evmEvents, nil
+			},
+			expectedError: "parse SetRewardAddress log",
+		},
+		{
+			name: "fail: invalid AddOperatorEvent log",
+			evmEvents: func() ([]*evmenginetypes.EVMEvent, error) {
+				logs := []ethtypes.Log{{Topics: []common.Hash{types.AddOperator.ID, dummyHash}}}
+				evmEvents, err := ethLogsToEvmEvents(logs)
+				if err != nil {
+					return nil, err
+				}
+
+				return evmEvents, nil
+			},
+			expectedError: "parse AddOperator log",
+		},
+		{
+			name: "fail: invalid RemoveOperatorEvent log",
+			evmEvents: func() ([]*evmenginetypes.EVMEvent, error) {
+				logs := []ethtypes.Log{{Topics: []common.Hash{types.RemoveOperator.ID, dummyHash}}}
+				evmEvents, err := ethLogsToEvmEvents(logs)
+				if err != nil {
+					return nil, err
+				}
+
+				return evmEvents, nil
+			},
+			expectedError: "parse RemoveOperator log",
+		},
+		{
+			name: "fail: invalid SetWithdrawalEvent log",
 			evmEvents: func() ([]*evmenginetypes.EVMEvent, error) {
 				logs := []ethtypes.Log{{Topics: []common.Hash{types.SetWithdrawalAddress.ID, dummyHash}}}
 				evmEvents, err := ethLogsToEvmEvents(logs)
@@ -270,9 +337,10 @@ func (s *TestSuite) TestProcessStakingEvents() {
 
 				return evmEvents, nil
 			},
+			expectedError: "parse SetWithdrawalAddress log",
 		},
 		{
-			name: "pass(continue): invalid CreateValidatorEvent log",
+			name: "fail: invalid CreateValidatorEvent log",
 			evmEvents: func() ([]*evmenginetypes.EVMEvent, error) {
 				logs := []ethtypes.Log{{Topics: []common.Hash{types.CreateValidatorEvent.ID, dummyHash}}}
 				evmEvents, err := ethLogsToEvmEvents(logs)
@@ -282,9 +350,10 @@ func (s *TestSuite) TestProcessStakingEvents() {
 
 				return evmEvents, nil
 			},
+			expectedError: "parse CreateValidator log",
 		},
 		{
-			name: "pass(continue): invalid DepositEvent log",
+			name: "fail: invalid DepositEvent log",
 			evmEvents: func() ([]*evmenginetypes.EVMEvent, error) {
 				logs := []ethtypes.Log{{Topics: []common.Hash{types.DepositEvent.ID, dummyHash}}}
 				evmEvents, err := ethLogsToEvmEvents(logs)
@@ -294,9 +363,10 @@ func (s *TestSuite) TestProcessStakingEvents() {
 
 				return evmEvents, nil
 			},
+			expectedError: "parse Deposit log",
 		},
 		{
-			name: "pass(continue): invalid RedelegateEvent log",
+			name: "fail: invalid RedelegateEvent log",
 			evmEvents: func() ([]*evmenginetypes.EVMEvent, error) {
 				logs := []ethtypes.Log{{Topics: []common.Hash{types.RedelegateEvent.ID, dummyHash}}}
 				evmEvents, err := ethLogsToEvmEvents(logs)
@@ -306,9 +376,10 @@ func (s *TestSuite) TestProcessStakingEvents() {
 
 				return evmEvents, nil
 			},
+			expectedError: "parse Redelegate log",
 		},
 		{
-			name: "pass(continue): invalid WithdrawEvent log",
+			name: "fail: invalid WithdrawEvent log",
 			evmEvents: func() ([]*evmenginetypes.EVMEvent, error) {
 				logs := []ethtypes.Log{{Topics: []common.Hash{types.WithdrawEvent.ID, dummyHash}}}
 				evmEvents, err := ethLogsToEvmEvents(logs)
@@ -318,9 +389,10 @@ func (s *TestSuite) TestProcessStakingEvents() {
 
 				return evmEvents, nil
 			},
+			expectedError: "parse Withdraw log",
 		},
 		{
-			name: "pass(continue): invalid UnjailEvent log",
+			name: "fail: invalid UnjailEvent log",
 			evmEvents: func() ([]*evmenginetypes.EVMEvent, error) {
 				logs := []ethtypes.Log{{Topics: []common.Hash{types.UnjailEvent.ID, dummyHash, dummyHash}}}
 				evmEvents, err := ethLogsToEvmEvents(logs)
@@ -330,6 +402,7 @@ func (s *TestSuite) TestProcessStakingEvents() {
 
 				return evmEvents, nil
 			},
+			expectedError: "parse Unjail log",
 		},
 		// FAIL TO PROCESS but PASS Cases because currently we are handling it as a continued.
 		// Only basic failure cases are validated. Various failure and success scenarios that may occur during the actual process
@@ -450,7 +523,7 @@ func (s *TestSuite) TestProcessStakingEvents() {
 					[]byte{},
 				)
 				require.NoError(err)
-				logs := []ethtypes.Log{{Topics: []common.Hash{types.UnjailEvent.ID, common.BytesToHash(delEvmAddr.Bytes())}, Data: data}}
+				logs := []ethtypes.Log{{Topics: []common.Hash{types.UnjailEvent.ID}, Data: data}}
 				evmEvents, err := ethLogsToEvmEvents(logs)
 				if err != nil {
 					return nil, err
@@ -645,7 +718,7 @@ func (s *TestSuite) TestProcessStakingEvents() {
 					evmEvents: func() ([]*evmenginetypes.EVMEvent, error) {
 						data, err := stakingAbi.Events["Unjail"].Inputs.NonIndexed().Pack(valPubKey1.Bytes())
 						require.NoError(err)
-						logs := []ethtypes.Log{{Topics: []common.Hash{types.UnjailEvent.ID, common.BytesToHash(delEvmAddr.Bytes())}, Data: data}}
+						logs := []ethtypes.Log{{Topics: []common.Hash{types.UnjailEvent.ID}, Data: data}}
 						evmEvents, err := ethLogsToEvmEvents(logs)
 						if err != nil {
 							return nil, err
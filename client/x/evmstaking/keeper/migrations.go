@@ -0,0 +1,78 @@
+package keeper
+
+import (
+	"cosmossdk.io/collections"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/common"
+
+	"github.com/piplabs/story/client/x/evmstaking/types"
+	"github.com/piplabs/story/lib/errors"
+	"github.com/piplabs/story/lib/log"
+)
+
+// Migrator is a struct for handling in-place store migrations.
+type Migrator struct {
+	keeper *Keeper
+}
+
+// NewMigrator returns a new Migrator.
+func NewMigrator(keeper *Keeper) Migrator {
+	return Migrator{keeper: keeper}
+}
+
+// Migrate1to2 migrates from version 1 to 2. It seeds the global withdrawal index from the fronts of
+// the withdrawal and reward withdrawal queues, i.e. the total number of withdrawals dequeued so far,
+// so that the indexes assigned from now on are greater than any index previously assigned per queue.
+func (m Migrator) Migrate1to2(ctx sdk.Context) error {
+	front, err := m.keeper.WithdrawalQueue.Front(ctx)
+	if err != nil {
+		return errors.Wrap(err, "get withdrawal queue front")
+	}
+
+	rewardFront, err := m.keeper.RewardWithdrawalQueue.Front(ctx)
+	if err != nil {
+		return errors.Wrap(err, "get reward withdrawal queue front")
+	}
+
+	nextIndex := front + rewardFront
+	if err := m.keeper.SetNextWithdrawalIndex(ctx, nextIndex); err != nil {
+		return err
+	}
+
+	log.Info(ctx, "Seeded global withdrawal index",
+		"withdrawal_queue_front", front,
+		"reward_withdrawal_queue_front", rewardFront,
+		"next_withdrawal_index", nextIndex,
+	)
+
+	return nil
+}
+
+// Migrate2to3 migrates from version 2 to 3. It moves the single operator of each delegator to the
+// delegator operators, granting it all permissions as before, and clears the legacy operator map.
+func (m Migrator) Migrate2to3(ctx sdk.Context) error {
+	iter, err := m.keeper.legacyDelegatorOperatorAddress.Iterate(ctx, nil)
+	if err != nil {
+		return errors.Wrap(err, "iterate legacy delegator operator addresses")
+	}
+	kvs, err := iter.KeyValues()
+	if err != nil {
+		return errors.Wrap(err, "get legacy delegator operator addresses")
+	}
+
+	for _, kv := range kvs {
+		key := collections.Join(kv.Key, common.HexToAddress(kv.Value).String())
+		if err := m.keeper.DelegatorOperators.Set(ctx, key, types.OperatorPermissionAll); err != nil {
+			return errors.Wrap(err, "set delegator operator")
+		}
+	}
+
+	if err := m.keeper.legacyDelegatorOperatorAddress.Clear(ctx, nil); err != nil {
+		return errors.Wrap(err, "clear legacy delegator operator addresses")
+	}
+
+	log.Info(ctx, "Migrated delegator operators", "count", len(kvs))
+
+	return nil
+}
@@ -0,0 +1,66 @@
+package keeper_test
+
+import (
+	"strings"
+
+	"cosmossdk.io/collections"
+
+	"github.com/cosmos/cosmos-sdk/runtime"
+	"github.com/ethereum/go-ethereum/common"
+
+	"github.com/piplabs/story/client/x/evmstaking/keeper"
+	"github.com/piplabs/story/client/x/evmstaking/types"
+)
+
+func (s *TestSuite) TestMigrate1to2() {
+	require := s.Require()
+	ctx, esk := s.Ctx, s.EVMStakingKeeper
+	s.initQueue()
+	require.NoError(esk.RewardWithdrawalQueue.Initialize(ctx))
+
+	// Dequeue some withdrawals and reward withdrawals to move the fronts of the queues
+	s.addWithdrawals(withdrawals)
+	for _, w := range withdrawals {
+		require.NoError(esk.AddRewardWithdrawalToQueue(ctx, w))
+	}
+	_, err := esk.WithdrawalQueue.Dequeue(ctx)
+	require.NoError(err)
+	_, err = esk.WithdrawalQueue.Dequeue(ctx)
+	require.NoError(err)
+	_, err = esk.RewardWithdrawalQueue.Dequeue(ctx)
+	require.NoError(err)
+
+	require.NoError(keeper.NewMigrator(esk).Migrate1to2(ctx))
+
+	nextIndex, err := esk.GetNextWithdrawalIndex(ctx)
+	require.NoError(err)
+	require.Equal(uint64(3), nextIndex)
+}
+
+func (s *TestSuite) TestMigrate2to3() {
+	require := s.Require()
+	ctx, esk := s.Ctx, s.EVMStakingKeeper
+
+	// Set single operators in the legacy map, with lowercase addresses as they could be stored
+	_, accAddrs, _ := createAddresses(2)
+	operators := []common.Address{common.HexToAddress("0xabcd"), common.HexToAddress("0x1234")}
+	sb := collections.NewSchemaBuilder(runtime.NewKVStoreService(s.storeKey))
+	legacy := collections.NewMap(sb, types.DelegatorOperatorAddressMapKey, "delegator_operator_address_map", collections.StringKey, collections.StringValue)
+	for i, operator := range operators {
+		require.NoError(legacy.Set(ctx, accAddrs[i].String(), strings.ToLower(operator.Hex())))
+	}
+
+	require.NoError(keeper.NewMigrator(esk).Migrate2to3(ctx))
+
+	for i, operator := range operators {
+		permissions, err := esk.DelegatorOperators.Get(ctx, collections.Join(accAddrs[i].String(), operator.String()))
+		require.NoError(err)
+		require.Equal(types.OperatorPermissionAll, permissions)
+	}
+
+	iter, err := legacy.Iterate(ctx, nil)
+	require.NoError(err)
+	keys, err := iter.Keys()
+	require.NoError(err)
+	require.Empty(keys)
+}
@@ -0,0 +1,59 @@
+package keeper
+
+import (
+	"context"
+
+	"cosmossdk.io/collections"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/common"
+
+	"github.com/piplabs/story/client/x/evmstaking/types"
+	"github.com/piplabs/story/lib/errors"
+)
+
+// SetDelegatorOperator sets the permissions of the operator of the delegator, replacing its previous
+// permissions. A delegator can have several operators with different permissions.
+func (k Keeper) SetDelegatorOperator(ctx context.Context, delegatorAddr sdk.AccAddress, operator common.Address, permissions uint32) error {
+	if permissions == 0 || permissions&^types.OperatorPermissionAll != 0 {
+		return errors.WrapErrWithCode(errors.InvalidRequest, errors.New("invalid operator permissions", "permissions", permissions))
+	}
+
+	if err := k.DelegatorOperators.Set(ctx, collections.Join(delegatorAddr.String(), operator.String()), permissions); err != nil {
+		return errors.Wrap(err, "delegator operators map set")
+	}
+
+	return nil
+}
+
+// RemoveDelegatorOperator removes the operator of the delegator, keeping its other operators.
+func (k Keeper) RemoveDelegatorOperator(ctx context.Context, delegatorAddr sdk.AccAddress, operator common.Address) error {
+	if err := k.DelegatorOperators.Remove(ctx, collections.Join(delegatorAddr.String(), operator.String())); err != nil {
+		return errors.Wrap(err, "delegator operators map remove")
+	}
+
+	return nil
+}
+
+// checkOperatorPermission returns an InvalidOperator error if the sender of an on behalf txn
+// isn't an operator of the delegator granted the permission.
+func (k Keeper) checkOperatorPermission(ctx context.Context, delegatorAddr sdk.AccAddress, sender common.Address, permission uint32, txn string) error {
+	permissions, err := k.DelegatorOperators.Get(ctx, collections.Join(delegatorAddr.String(), sender.String()))
+	if errors.Is(err, collections.ErrNotFound) {
+		return errors.WrapErrWithCode(
+			errors.InvalidOperator,
+			errors.New("invalid "+txn+" txn, not from operator"),
+		)
+	} else if err != nil {
+		return errors.Wrap(err, "get delegator's operator permissions failed")
+	}
+
+	if !(types.DelegatorOperator{Permissions: permissions}).HasPermission(permission) {
+		return errors.WrapErrWithCode(
+			errors.InvalidOperator,
+			errors.New("invalid "+txn+" txn, operator not permitted", "permissions", permissions),
+		)
+	}
+
+	return nil
+}
@@ -0,0 +1,181 @@
+package keeper_test
+
+import (
+	"math/big"
+
+	"cosmossdk.io/collections"
+
+	"github.com/ethereum/go-ethereum/common"
+
+	"github.com/piplabs/story/client/x/evmstaking/types"
+	"github.com/piplabs/story/contracts/bindings"
+	"github.com/piplabs/story/lib/errors"
+)
+
+func (s *TestSuite) TestProcessAddRemoveOperator() {
+	require := s.Require()
+	ctx, keeper := s.Ctx, s.EVMStakingKeeper
+
+	pubKeys, accAddrs, _ := createAddresses(1)
+	delUncmpPubKey := cmpToUncmp(pubKeys[0].Bytes())
+	operators := []common.Address{common.HexToAddress("0x01"), common.HexToAddress("0x02")}
+
+	// Adding an operator keeps the previous ones.
+	for _, operator := range operators {
+		require.NoError(keeper.ProcessAddOperator(ctx, &bindings.IPTokenStakingAddOperator{
+			UncmpPubkey: delUncmpPubKey,
+			Operator:    operator,
+		}))
+	}
+	for _, operator := range operators {
+		permissions, err := keeper.DelegatorOperators.Get(ctx, collections.Join(accAddrs[0].String(), operator.String()))
+		require.NoError(err)
+		require.Equal(types.OperatorPermissionAll, permissions)
+	}
+
+	// Removing an operator keeps the other ones.
+	require.NoError(keeper.ProcessRemoveOperator(ctx, &bindings.IPTokenStakingRemoveOperator{
+		UncmpPubkey: delUncmpPubKey,
+		Operator:    operators[0],
+	}))
+	has, err := keeper.DelegatorOperators.Has(ctx, collections.Join(accAddrs[0].String(), operators[0].String()))
+	require.NoError(err)
+	require.False(has)
+	has, err = keeper.DelegatorOperators.Has(ctx, collections.Join(accAddrs[0].String(), operators[1].String()))
+	require.NoError(err)
+	require.True(has)
+}
+
+func (s *TestSuite) TestProcessSetOperatorPermissions() {
+	require := s.Require()
+	ctx, keeper := s.Ctx, s.EVMStakingKeeper
+
+	pubKeys, accAddrs, _ := createAddresses(1)
+	delUncmpPubKey := cmpToUncmp(pubKeys[0].Bytes())
+	operator := common.HexToAddress("0x01")
+
+	// Setting the permissions of a new operator adds it with the permissions.
+	require.NoError(keeper.ProcessSetOperatorPermissions(ctx, &bindings.IPTokenStakingSetOperatorPermissions{
+		UncmpPubkey: delUncmpPubKey,
+		Operator:    operator,
+		Permissions: types.OperatorPermissionUnstake,
+	}))
+	permissions, err := keeper.DelegatorOperators.Get(ctx, collections.Join(accAddrs[0].String(), operator.String()))
+	require.NoError(err)
+	require.Equal(types.OperatorPermissionUnstake, permissions)
+
+	// Setting the permissions of an added operator scopes it.
+	require.NoError(keeper.ProcessAddOperator(ctx, &bindings.IPTokenStakingAddOperator{
+		UncmpPubkey: delUncmpPubKey,
+		Operator:    operator,
+	}))
+	require.NoError(keeper.ProcessSetOperatorPermissions(ctx, &bindings.IPTokenStakingSetOperatorPermissions{
+		UncmpPubkey: delUncmpPubKey,
+		Operator:    operator,
+		Permissions: types.OperatorPermissionRedelegate | types.OperatorPermissionUnjail,
+	}))
+	permissions, err = keeper.DelegatorOperators.Get(ctx, collections.Join(accAddrs[0].String(), operator.String()))
+	require.NoError(err)
+	require.Equal(types.OperatorPermissionRedelegate|types.OperatorPermissionUnjail, permissions)
+
+	// Unknown permissions fail and keep the previous ones.
+	err = keeper.ProcessSetOperatorPermissions(ctx, &bindings.IPTokenStakingSetOperatorPermissions{
+		UncmpPubkey: delUncmpPubKey,
+		Operator:    operator,
+		Permissions: types.OperatorPermissionAll + 1,
+	})
+	require.ErrorIs(err, errors.ErrInvalidRequest)
+	permissions, err = keeper.DelegatorOperators.Get(ctx, collections.Join(accAddrs[0].String(), operator.String()))
+	require.NoError(err)
+	require.Equal(types.OperatorPermissionRedelegate|types.OperatorPermissionUnjail, permissions)
+
+	// Invalid delegator pubkey fails.
+	err = keeper.ProcessSetOperatorPermissions(ctx, &bindings.IPTokenStakingSetOperatorPermissions{
+		UncmpPubkey: delUncmpPubKey[:10],
+		Operator:    operator,
+		Permissions: types.OperatorPermissionUnstake,
+	})
+	require.ErrorIs(err, errors.ErrInvalidUncmpPubKey)
+}
+
+func (s *TestSuite) TestSetDelegatorOperator() {
+	require := s.Require()
+	ctx, keeper := s.Ctx, s.EVMStakingKeeper
+
+	_, accAddrs, _ := createAddresses(1)
+	operator := common.HexToAddress("0x01")
+
+	require.ErrorContains(keeper.SetDelegatorOperator(ctx, accAddrs[0], operator, 0), "invalid operator permissions")
+	require.ErrorContains(keeper.SetDelegatorOperator(ctx, accAddrs[0], operator, types.OperatorPermissionAll+1), "invalid operator permissions")
+
+	// Setting the permissions again replaces them.
+	require.NoError(keeper.SetDelegatorOperator(ctx, accAddrs[0], operator, types.OperatorPermissionAll))
+	require.NoError(keeper.SetDelegatorOperator(ctx, accAddrs[0], operator, types.OperatorPermissionUnjail))
+	permissions, err := keeper.DelegatorOperators.Get(ctx, collections.Join(accAddrs[0].String(), operator.String()))
+	require.NoError(err)
+	require.Equal(types.OperatorPermissionUnjail, permissions)
+}
+
+func (s *TestSuite) TestProcessWithdraw_OperatorPermission() {
+	require := s.Require()
+	ctx, keeper := s.Ctx, s.EVMStakingKeeper
+	require.NoError(keeper.WithdrawalQueue.Initialize(ctx))
+
+	// Withdrawals are only processed after the singularity.
+	singularityHeight, err := s.StakingKeeper.GetSingularityHeight(ctx)
+	require.NoError(err)
+	ctx = ctx.WithBlockHeight(int64(singularityHeight))
+
+	// The min unstake amount is checked right after the operator, so a permitted operator fails on it.
+	params, err := keeper.GetParams(ctx)
+	require.NoError(err)
+	params.MinUnstakeAmount = 1024
+	require.NoError(keeper.SetParams(ctx, params))
+
+	pubKeys, accAddrs, _ := createAddresses(2)
+	delPubKey, valPubKey := pubKeys[0].Bytes(), pubKeys[1].Bytes()
+	unstaker := common.HexToAddress("0x01")
+	redelegator := common.HexToAddress("0x02")
+	require.NoError(keeper.SetDelegatorOperator(ctx, accAddrs[0], unstaker, types.OperatorPermissionUnstake))
+	require.NoError(keeper.SetDelegatorOperator(ctx, accAddrs[0], redelegator, types.OperatorPermissionRedelegate))
+
+	tcs := []struct {
+		name         string
+		sender       common.Address
+		expectedCode errors.ErrCode
+		expectedErr  string
+	}{
+		{
+			name:         "pass: operator permitted to unstake",
+			sender:       unstaker,
+			expectedCode: errors.InvalidDelegationAmount,
+			expectedErr:  "unstake amount under min",
+		},
+		{
+			name:         "fail: operator not permitted to unstake",
+			sender:       redelegator,
+			expectedCode: errors.InvalidOperator,
+			expectedErr:  "operator not permitted",
+		},
+		{
+			name:         "fail: not from operator",
+			sender:       common.HexToAddress("0x03"),
+			expectedCode: errors.InvalidOperator,
+			expectedErr:  "not from operator",
+		},
+	}
+
+	for _, tc := range tcs {
+		s.Run(tc.name, func() {
+			err := keeper.ProcessWithdraw(ctx, &bindings.IPTokenStakingWithdraw{
+				DelegatorUncmpPubkey: cmpToUncmp(delPubKey),
+				ValidatorUncmpPubkey: cmpToUncmp(valPubKey),
+				StakeAmount:          big.NewInt(1),
+				DelegationId:         big.NewInt(0),
+				OperatorAddress:      tc.sender,
+			})
+			require.ErrorContains(err, tc.expectedErr)
+			require.Equal(tc.expectedCode, errors.UnwrapErrCode(err))
+		})
+	}
+}
@@ -36,6 +36,33 @@ func (k Keeper) MinPartialWithdrawalAmount(ctx context.Context) (uint64, error)
 	return params.MinPartialWithdrawalAmount, nil
 }
 
+func (k Keeper) MinStakeAmount(ctx context.Context) (uint64, error) {
+	params, err := k.GetParams(ctx)
+	if err != nil {
+		return 0, err
+	}
+
+	return params.MinStakeAmount, nil
+}
+
+func (k Keeper) MinUnstakeAmount(ctx context.Context) (uint64, error) {
+	params, err := k.GetParams(ctx)
+	if err != nil {
+		return 0, err
+	}
+
+	return params.MinUnstakeAmount, nil
+}
+
+func (k Keeper) MinCommissionRate(ctx context.Context) (uint32, error) {
+	params, err := k.GetParams(ctx)
+	if err != nil {
+		return 0, err
+	}
+
+	return params.MinCommissionRate, nil
+}
+
 // This method performs no validation of the parameters.
 func (k Keeper) SetParams(ctx context.Context, params types.Params) error {
 	store := k.storeService.OpenKVStore(ctx)
@@ -6,8 +6,6 @@ import (
 	"encoding/hex"
 	"strconv"
 
-	"cosmossdk.io/collections"
-
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	skeeper "github.com/cosmos/cosmos-sdk/x/staking/keeper"
 	stypes "github.com/cosmos/cosmos-sdk/x/staking/types"
@@ -98,22 +96,10 @@ func (k Keeper) ProcessRedelegate(ctx context.Context, ev *bindings.IPTokenStaki
 		return errors.Wrap(err, "dst validator pubkey to evm address")
 	}
 
-	// redelegateOnBehalf txn, need to check if it's from the operator
+	// redelegateOnBehalf txn, need to check if it's from an operator permitted to redelegate
 	if delEvmAddr.String() != ev.OperatorAddress.String() {
-		operatorAddr, err := k.DelegatorOperatorAddress.Get(cachedCtx, depositorAddr.String())
-		if errors.Is(err, collections.ErrNotFound) {
-			return errors.WrapErrWithCode(
-				errors.InvalidOperator,
-				errors.New("invalid redelegateOnBehalf txn, no operator"),
-			)
-		} else if err != nil {
-			return errors.Wrap(err, "get delegator's operator address failed")
-		}
-		if operatorAddr != ev.OperatorAddress.String() {
-			return errors.WrapErrWithCode(
-				errors.InvalidOperator,
-				errors.New("invalid redelegateOnBehalf txn, not from operator"),
-			)
+		if err := k.checkOperatorPermission(cachedCtx, depositorAddr, ev.OperatorAddress, types.OperatorPermissionRedelegate, "redelegateOnBehalf"); err != nil {
+			return err
 		}
 	}
 
@@ -0,0 +1,59 @@
+package keeper
+
+import (
+	"context"
+	"math/big"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/common"
+
+	"github.com/piplabs/story/client/x/evmstaking/types"
+	"github.com/piplabs/story/lib/errors"
+	"github.com/piplabs/story/lib/log"
+)
+
+// RefundFailedStake enqueues a withdrawal that returns the amount (in gwei) of a failed EL stake event
+// to the given EVM address, since the IPTokenStaking contract already took the IP on the EL side.
+// The refund is tagged with the code of the failure.
+//
+// Note that it must be called with the parent context, as the cached context of the failed event is discarded.
+func (k Keeper) RefundFailedStake(ctx context.Context, evmAddr common.Address, amount *big.Int, code errors.ErrCode) error {
+	if amount == nil || amount.Sign() <= 0 {
+		return nil // Nothing to refund.
+	} else if !amount.IsUint64() {
+		return errors.New("refund amount overflows uint64", "amount", amount.String())
+	}
+
+	log.Info(ctx, "Refunding failed stake event",
+		"evm_addr", evmAddr.String(),
+		"amount", amount.String(),
+		"code", code.String(),
+	)
+
+	if err := k.AddWithdrawalToQueue(ctx, types.NewRefundWithdrawal(
+		uint64(sdk.UnwrapSDKContext(ctx).BlockHeight()),
+		evmAddr.String(),
+		amount.Uint64(),
+		code,
+	)); err != nil {
+		return errors.Wrap(err, "add refund withdrawal to queue")
+	}
+
+	return nil
+}
+
+// refundAddress returns the EVM address of the given uncompressed public key,
+// or the fallback address if the public key is invalid.
+func refundAddress(uncmpPubkey []byte, fallback common.Address) common.Address {
+	cmpPubkey, err := UncmpPubKeyToCmpPubKey(uncmpPubkey)
+	if err != nil {
+		return fallback
+	}
+
+	evmAddr, err := CmpPubKeyToEVMAddress(cmpPubkey)
+	if err != nil {
+		return fallback
+	}
+
+	return evmAddr
+}
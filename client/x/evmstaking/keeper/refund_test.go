@@ -0,0 +1,120 @@
+package keeper_test
+
+import (
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/common"
+
+	"github.com/piplabs/story/client/x/evmstaking/types"
+	"github.com/piplabs/story/contracts/bindings"
+	"github.com/piplabs/story/lib/errors"
+
+	"go.uber.org/mock/gomock"
+)
+
+func (s *TestSuite) TestRefundFailedStake() {
+	require := s.Require()
+	ctx, keeper := s.Ctx, s.EVMStakingKeeper
+	s.initQueue()
+
+	// zero amount is not refunded
+	require.NoError(keeper.RefundFailedStake(ctx, evmAddr, big.NewInt(0), errors.ValidatorNotFound))
+	require.Equal(uint64(0), keeper.WithdrawalQueue.Len(ctx))
+
+	// amount overflowing uint64 is rejected
+	overflow := new(big.Int).Lsh(big.NewInt(1), 64)
+	require.ErrorContains(keeper.RefundFailedStake(ctx, evmAddr, overflow, errors.ValidatorNotFound), "overflows uint64")
+
+	require.NoError(keeper.RefundFailedStake(ctx, evmAddr, big.NewInt(100), errors.InvalidPeriodType))
+	require.Equal(uint64(1), keeper.WithdrawalQueue.Len(ctx))
+	w, err := keeper.WithdrawalQueue.Get(ctx, 0)
+	require.NoError(err)
+	require.Equal(types.NewRefundWithdrawal(uint64(ctx.BlockHeight()), evmAddr.String(), 100, errors.InvalidPeriodType), w)
+}
+
+func (s *TestSuite) TestProcessDepositRefund() {
+	require := s.Require()
+	ctx, keeper := s.Ctx, s.EVMStakingKeeper
+	pubKeys, accAddrs, _ := createAddresses(2)
+	delPubKey, valPubKey := pubKeys[0], pubKeys[1]
+	operator := common.HexToAddress("0x00000000000000000000000000000000000000aa")
+
+	tcs := []struct {
+		name         string
+		setupMock    func()
+		deposit      *bindings.IPTokenStakingDeposit
+		expectedAddr common.Address
+		expectedCode errors.ErrCode
+	}{
+		{
+			name: "refund to delegator: validator not found",
+			setupMock: func() {
+				s.AccountKeeper.EXPECT().HasAccount(gomock.Any(), accAddrs[0]).Return(true)
+			},
+			deposit: &bindings.IPTokenStakingDeposit{
+				DelegatorUncmpPubkey: cmpToUncmp(delPubKey.Bytes()),
+				ValidatorUncmpPubkey: cmpToUncmp(valPubKey.Bytes()),
+				StakeAmount:          big.NewInt(100),
+				StakingPeriod:        big.NewInt(0),
+				DelegationId:         big.NewInt(0),
+				OperatorAddress:      operator,
+			},
+			expectedAddr: cmpToEVM(delPubKey.Bytes()),
+			expectedCode: errors.ValidatorNotFound,
+		},
+		{
+			name: "refund to operator: invalid delegator pubkey",
+			deposit: &bindings.IPTokenStakingDeposit{
+				DelegatorUncmpPubkey: cmpToUncmp(delPubKey.Bytes())[:16],
+				ValidatorUncmpPubkey: cmpToUncmp(valPubKey.Bytes()),
+				StakeAmount:          big.NewInt(100),
+				StakingPeriod:        big.NewInt(0),
+				DelegationId:         big.NewInt(0),
+				OperatorAddress:      operator,
+			},
+			expectedAddr: operator,
+			expectedCode: errors.InvalidUncmpPubKey,
+		},
+	}
+
+	for _, tc := range tcs {
+		s.Run(tc.name, func() {
+			s.initQueue()
+			if tc.setupMock != nil {
+				tc.setupMock()
+			}
+			cachedCtx, _ := ctx.CacheContext()
+			require.Error(keeper.ProcessDeposit(cachedCtx, tc.deposit))
+
+			require.Equal(uint64(1), keeper.WithdrawalQueue.Len(cachedCtx))
+			w, err := keeper.WithdrawalQueue.Get(cachedCtx, 0)
+			require.NoError(err)
+			require.True(w.IsRefund)
+			require.Equal(uint32(tc.expectedCode), w.RefundCode)
+			require.Equal(tc.expectedAddr.String(), w.ExecutionAddress)
+			require.Equal(tc.deposit.StakeAmount.Uint64(), w.Amount)
+		})
+	}
+}
+
+func (s *TestSuite) TestProcessCreateValidatorRefund() {
+	require := s.Require()
+	ctx, keeper := s.Ctx, s.EVMStakingKeeper
+	pubKeys, _, _ := createAddresses(1)
+	operator := common.HexToAddress("0x00000000000000000000000000000000000000aa")
+	s.initQueue()
+
+	cachedCtx, _ := ctx.CacheContext()
+	err := keeper.ProcessCreateValidator(cachedCtx, &bindings.IPTokenStakingCreateValidator{
+		ValidatorUncmpPubkey: cmpToUncmp(pubKeys[0].Bytes())[:16],
+		Moniker:              "moniker",
+		StakeAmount:          big.NewInt(100),
+		OperatorAddress:      operator,
+	})
+	require.Error(err)
+
+	require.Equal(uint64(1), keeper.WithdrawalQueue.Len(cachedCtx))
+	w, err := keeper.WithdrawalQueue.Get(cachedCtx, 0)
+	require.NoError(err)
+	require.Equal(types.NewRefundWithdrawal(uint64(cachedCtx.BlockHeight()), operator.String(), 100, errors.InvalidUncmpPubKey), w)
+}
@@ -2,14 +2,13 @@ package keeper
 
 import (
 	"context"
-	"errors"
 
 	"github.com/ethereum/go-ethereum/common"
 	etypes "github.com/ethereum/go-ethereum/core/types"
 
 	addcollections "github.com/piplabs/story/client/collections"
 	"github.com/piplabs/story/client/x/evmstaking/types"
-	"github.com/piplabs/story/lib/log"
+	"github.com/piplabs/story/lib/errors"
 )
 
 // AddRewardWithdrawalToQueue inserts a reward withdrawal into the queue.
@@ -17,19 +16,16 @@ func (k Keeper) AddRewardWithdrawalToQueue(ctx context.Context, withdrawal types
 	return k.RewardWithdrawalQueue.Enqueue(ctx, withdrawal)
 }
 
+// DequeueEligibleRewardWithdrawals dequeues at most maxDequeue reward withdrawals. As the reward withdrawals
+// share the global withdrawal index with the withdrawals, they must be dequeued after the withdrawals of the
+// same block (see DequeueEligibleWithdrawals).
 func (k Keeper) DequeueEligibleRewardWithdrawals(ctx context.Context, maxDequeue uint32) (withdrawals etypes.Withdrawals, err error) {
-	// front is the unique monotonically increasing index of a withdrawal in the queue.
-	// It's used as the value in etypes.Withdrawal.Index for later validation purposes,
-	// when evmengine's msg_server receives withdrawals as part of the execution payload
-	// and needs to verify that the received withdrawals are in the correct order from
-	// the front of the queue.
-	front, err := k.RewardWithdrawalQueue.Front(ctx)
+	nextIndex, err := k.GetNextWithdrawalIndex(ctx)
 	if err != nil {
-		log.Debug(ctx, "Front", "err", err)
 		return nil, err
 	}
 
-	for i := range maxDequeue {
+	for i := range uint64(maxDequeue) {
 		withdrawal, err := k.RewardWithdrawalQueue.Dequeue(ctx)
 		if err != nil {
 			// Dequeue will return ErrEmptyQueue if the queue is empty
@@ -40,34 +36,37 @@ func (k Keeper) DequeueEligibleRewardWithdrawals(ctx context.Context, maxDequeue
 			return nil, err
 		}
 		withdrawals = append(withdrawals, &etypes.Withdrawal{
-			Index:     front + uint64(i), // increment front by i to get the correct index in the loop
-			Validator: 0,                 // does not matter for EL
+			Index:     nextIndex + i, // increment the next index by i to get the correct index in the loop
+			Validator: 0,             // does not matter for EL
 			Address:   common.HexToAddress(withdrawal.ExecutionAddress),
 			Amount:    withdrawal.Amount,
 		})
 	}
 
+	if err := k.SetNextWithdrawalIndex(ctx, nextIndex+uint64(len(withdrawals))); err != nil {
+		return nil, err
+	}
+
 	return withdrawals, nil
 }
 
-func (k Keeper) PeekEligibleRewardWithdrawals(ctx context.Context, maxPeek uint32) (withdrawals etypes.Withdrawals, err error) {
+// PeekEligibleRewardWithdrawals peeks at most maxPeek reward withdrawals. The indexOffset is the number of
+// withdrawals peeked for the same block (see PeekEligibleWithdrawals), as those are dequeued first and take
+// the preceding global withdrawal indexes.
+func (k Keeper) PeekEligibleRewardWithdrawals(ctx context.Context, maxPeek uint32, indexOffset uint64) (withdrawals etypes.Withdrawals, err error) {
 	if k.RewardWithdrawalQueue.IsEmpty(ctx) {
 		return withdrawals, nil
 	}
 
-	// front is the unique monotonically increasing index of a withdrawal in the queue.
-	// It's used as the value in etypes.Withdrawal.Index for later validation purposes,
-	// when evmengine's msg_server receives withdrawals as part of the execution payload
-	// and needs to verify that the received withdrawals are in the correct order from
-	// the front of the queue.
-	front, err := k.RewardWithdrawalQueue.Front(ctx)
+	nextIndex, err := k.GetNextWithdrawalIndex(ctx)
 	if err != nil {
 		return nil, err
 	}
+	nextIndex += indexOffset
 
-	for i := range maxPeek {
+	for i := range uint64(maxPeek) {
 		// NOTE: Get adjusts the provided index by the front index of the queue
-		withdrawal, err := k.RewardWithdrawalQueue.Get(ctx, uint64(i))
+		withdrawal, err := k.RewardWithdrawalQueue.Get(ctx, i)
 		if err != nil {
 			// Get will return ErrOutOfBoundsQueue if the queue is empty
 			if errors.Is(err, addcollections.ErrOutOfBoundsQueue) {
@@ -77,8 +76,8 @@ func (k Keeper) PeekEligibleRewardWithdrawals(ctx context.Context, maxPeek uint3
 			return nil, err
 		}
 		withdrawals = append(withdrawals, &etypes.Withdrawal{
-			Index:     front + uint64(i), // increment front by i to get the correct index in the loop
-			Validator: 0,                 // does not matter for EL
+			Index:     nextIndex + i, // increment the next index by i to get the correct index in the loop
+			Validator: 0,             // does not matter for EL
 			Address:   common.HexToAddress(withdrawal.ExecutionAddress),
 			Amount:    withdrawal.Amount,
 		})
@@ -3,6 +3,8 @@ package keeper
 import (
 	"context"
 
+	"cosmossdk.io/collections"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
 	"github.com/piplabs/story/client/x/evmstaking/types"
@@ -42,13 +44,44 @@ func (k Keeper) ProcessUbiWithdrawal(ctx context.Context) error {
 	}
 
 	// Add withdrawal entry to the withdrawal queue.
-	if err = k.AddWithdrawalToQueue(ctx, types.NewWithdrawal(
+	withdrawal := types.NewWithdrawal(
 		uint64(sdk.UnwrapSDKContext(ctx).BlockHeight()),
 		params.UbiWithdrawAddress,
 		ubiBalance.Uint64(),
-	)); err != nil {
+	)
+	if err = k.AddWithdrawalToQueue(ctx, withdrawal); err != nil {
 		return errors.Wrap(err, "add ubi withdrawal to queue")
 	}
 
+	// Record the withdrawal in the ubi withdrawal history, at most one per block.
+	if params.GetRecordUbiWithdrawals() {
+		if err = k.UbiWithdrawals.Set(ctx, withdrawal.CreationHeight, withdrawal); err != nil {
+			return errors.Wrap(err, "set ubi withdrawal history")
+		}
+	}
+
+	return nil
+}
+
+// PruneUbiWithdrawals deletes the ubi withdrawals older than the ubi withdrawal retention param,
+// i.e. it only keeps the ubi withdrawals of the last `retention` consensus chain heights.
+func (k Keeper) PruneUbiWithdrawals(ctx context.Context) error {
+	params, err := k.GetParams(ctx)
+	if err != nil {
+		return errors.Wrap(err, "get params")
+	}
+
+	height := uint64(sdk.UnwrapSDKContext(ctx).BlockHeight())
+	retention := params.GetUbiWithdrawalRetention()
+	if retention == 0 || height < retention {
+		return nil
+	}
+
+	// The ubi withdrawals are keyed by their creation height.
+	pruned := new(collections.Range[uint64]).EndInclusive(height - retention)
+	if err := k.UbiWithdrawals.Clear(ctx, pruned); err != nil {
+		return errors.Wrap(err, "clear ubi withdrawals")
+	}
+
 	return nil
 }
@@ -0,0 +1,102 @@
+package keeper_test
+
+import (
+	"context"
+
+	sdkmath "cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+
+	"github.com/piplabs/story/client/x/evmstaking/types"
+
+	"go.uber.org/mock/gomock"
+)
+
+func (s *TestSuite) TestProcessUbiWithdrawal() {
+	require := s.Require()
+	ctx, keeper := s.Ctx, s.EVMStakingKeeper
+	require.NoError(keeper.WithdrawalQueue.Initialize(ctx))
+
+	params, err := keeper.GetParams(ctx)
+	require.NoError(err)
+
+	// The ubi balance is withdrawn at most once per block.
+	balances := []uint64{params.MinPartialWithdrawalAmount - 1, params.MinPartialWithdrawalAmount, params.MinPartialWithdrawalAmount + 100}
+	for i, balance := range balances {
+		ctx := ctx.WithBlockHeight(int64(i + 1))
+		s.DistrKeeper.EXPECT().GetUbiBalanceByDenom(gomock.Any(), sdk.DefaultBondDenom).Return(sdkmath.NewIntFromUint64(balance), nil)
+		if balance >= params.MinPartialWithdrawalAmount {
+			coin := sdk.NewCoin(sdk.DefaultBondDenom, sdkmath.NewIntFromUint64(balance))
+			s.DistrKeeper.EXPECT().WithdrawUbiByDenomToModule(gomock.Any(), sdk.DefaultBondDenom, types.ModuleName).Return(coin, nil)
+			s.BankKeeper.EXPECT().BurnCoins(gomock.Any(), types.ModuleName, sdk.NewCoins(coin)).Return(nil)
+		}
+		require.NoError(keeper.ProcessUbiWithdrawal(ctx))
+	}
+
+	// Only the withdrawals above the min partial withdrawal amount are recorded, ordered by height.
+	res, err := s.queryClient.GetUbiWithdrawals(context.Background(), &types.QueryGetUbiWithdrawalsRequest{
+		Pagination: &query.PageRequest{Limit: 1, CountTotal: true},
+	})
+	require.NoError(err)
+	require.Equal(uint64(2), res.Pagination.Total)
+	require.Len(res.Withdrawals, 1)
+	require.Equal(types.NewWithdrawal(2, params.UbiWithdrawAddress, balances[1]), *res.Withdrawals[0])
+
+	res, err = s.queryClient.GetUbiWithdrawals(context.Background(), &types.QueryGetUbiWithdrawalsRequest{
+		Pagination: &query.PageRequest{Key: res.Pagination.NextKey},
+	})
+	require.NoError(err)
+	require.Len(res.Withdrawals, 1)
+	require.Equal(types.NewWithdrawal(3, params.UbiWithdrawAddress, balances[2]), *res.Withdrawals[0])
+}
+
+func (s *TestSuite) TestProcessUbiWithdrawal_NotRecorded() {
+	require := s.Require()
+	ctx, keeper := s.Ctx, s.EVMStakingKeeper
+	require.NoError(keeper.WithdrawalQueue.Initialize(ctx))
+
+	// The ubi withdrawal isn't recorded before the record ubi withdrawals param is enabled, but still queued.
+	params, err := keeper.GetParams(ctx)
+	require.NoError(err)
+	params.RecordUbiWithdrawals = false
+	require.NoError(keeper.SetParams(ctx, params))
+
+	coin := sdk.NewCoin(sdk.DefaultBondDenom, sdkmath.NewIntFromUint64(params.MinPartialWithdrawalAmount))
+	s.DistrKeeper.EXPECT().GetUbiBalanceByDenom(gomock.Any(), sdk.DefaultBondDenom).Return(coin.Amount, nil)
+	s.DistrKeeper.EXPECT().WithdrawUbiByDenomToModule(gomock.Any(), sdk.DefaultBondDenom, types.ModuleName).Return(coin, nil)
+	s.BankKeeper.EXPECT().BurnCoins(gomock.Any(), types.ModuleName, sdk.NewCoins(coin)).Return(nil)
+	require.NoError(keeper.ProcessUbiWithdrawal(ctx.WithBlockHeight(1)))
+
+	require.Equal(uint64(1), keeper.WithdrawalQueue.Len(ctx))
+	res, err := s.queryClient.GetUbiWithdrawals(context.Background(), &types.QueryGetUbiWithdrawalsRequest{})
+	require.NoError(err)
+	require.Empty(res.Withdrawals)
+}
+
+func (s *TestSuite) TestPruneUbiWithdrawals() {
+	require := s.Require()
+	ctx, keeper := s.Ctx, s.EVMStakingKeeper
+
+	params, err := keeper.GetParams(ctx)
+	require.NoError(err)
+	params.UbiWithdrawalRetention = 10
+	require.NoError(keeper.SetParams(ctx, params))
+
+	for _, height := range []uint64{1, 5, 12} {
+		require.NoError(keeper.UbiWithdrawals.Set(ctx, height, types.NewWithdrawal(height, params.UbiWithdrawAddress, 100)))
+	}
+
+	// Nothing is pruned before the retention.
+	require.NoError(keeper.PruneUbiWithdrawals(ctx.WithBlockHeight(9)))
+	res, err := s.queryClient.GetUbiWithdrawals(context.Background(), &types.QueryGetUbiWithdrawalsRequest{})
+	require.NoError(err)
+	require.Len(res.Withdrawals, 3)
+
+	// Only the ubi withdrawals of the last 10 heights are kept.
+	require.NoError(keeper.PruneUbiWithdrawals(ctx.WithBlockHeight(15)))
+	res, err = s.queryClient.GetUbiWithdrawals(context.Background(), &types.QueryGetUbiWithdrawalsRequest{})
+	require.NoError(err)
+	require.Len(res.Withdrawals, 1)
+	require.Equal(uint64(12), res.Withdrawals[0].CreationHeight)
+}
@@ -6,8 +6,6 @@ import (
 	"encoding/hex"
 	"strconv"
 
-	"cosmossdk.io/collections"
-
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	ethtypes "github.com/ethereum/go-ethereum/core/types"
 
@@ -54,22 +52,10 @@ func (k Keeper) ProcessUnjail(ctx context.Context, ev *bindings.IPTokenStakingUn
 		return errors.Wrap(err, "validator pubkey to evm address")
 	}
 
-	// unjailOnBehalf txn, need to check if it's from the operator
+	// unjailOnBehalf txn, need to check if it's from an operator permitted to unjail
 	if valEvmAddr.String() != ev.Unjailer.String() {
-		operatorAddr, err := k.DelegatorOperatorAddress.Get(cachedCtx, valDelAddr.String())
-		if errors.Is(err, collections.ErrNotFound) {
-			return errors.WrapErrWithCode(
-				errors.InvalidOperator,
-				errors.New("invalid unjailOnBehalf txn, no operator for delegator"),
-			)
-		} else if err != nil {
-			return errors.Wrap(err, "get validator's operator address failed")
-		}
-		if operatorAddr != ev.Unjailer.String() {
-			return errors.WrapErrWithCode(
-				errors.InvalidOperator,
-				errors.New("invalid unjailOnBehalf txn, not from operator"),
-			)
+		if err := k.checkOperatorPermission(cachedCtx, valDelAddr, ev.Unjailer, types.OperatorPermissionUnjail, "unjailOnBehalf"); err != nil {
+			return err
 		}
 	}
 
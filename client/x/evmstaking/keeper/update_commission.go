@@ -53,6 +53,10 @@ func (k Keeper) ProcessUpdateValidatorCommission(ctx context.Context, ev *bindin
 		return errors.Wrap(err, "get validator")
 	}
 
+	if err := k.checkMinCommissionRate(cachedCtx, ev.CommissionRate); err != nil {
+		return err
+	}
+
 	newComm := math.LegacyNewDecWithPrec(int64(ev.CommissionRate), 4)
 	minSelfDelegation := validator.MinSelfDelegation
 	msg := stypes.NewMsgEditValidator(
@@ -45,6 +45,12 @@ func (k Keeper) ProcessCreateValidator(ctx context.Context, ev *bindings.IPToken
 				sdk.NewAttribute(types.AttributeKeyStatusCode, errors.UnwrapErrCode(err).String()),
 			),
 		})
+
+		// Refund the staked amount to the validator, as it is already taken on the EL side.
+		refundAddr := refundAddress(ev.ValidatorUncmpPubkey, ev.OperatorAddress)
+		if rerr := k.RefundFailedStake(sdkCtx, refundAddr, ev.StakeAmount, errors.UnwrapErrCode(err)); rerr != nil {
+			log.Error(sdkCtx, "Failed to refund failed create validator", rerr, "evm_addr", refundAddr.String())
+		}
 	}()
 
 	// When creating a validator, it's self-delegation. Thus, validator pubkey is also delegation pubkey.
@@ -65,6 +71,13 @@ func (k Keeper) ProcessCreateValidator(ctx context.Context, ev *bindings.IPToken
 		return errors.Wrap(err, "validator pubkey to evm address")
 	}
 
+	if err := k.checkMinStakeAmount(cachedCtx, ev.StakeAmount); err != nil {
+		return err
+	}
+	if err := k.checkMinCommissionRate(cachedCtx, ev.CommissionRate); err != nil {
+		return err
+	}
+
 	amountCoin, amountCoins := IPTokenToBondCoin(ev.StakeAmount)
 
 	// Create account if not exists
@@ -6,7 +6,6 @@ import (
 	"encoding/hex"
 	"strconv"
 
-	"cosmossdk.io/collections"
 	"cosmossdk.io/math"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -377,25 +376,17 @@ func (k Keeper) ProcessWithdraw(ctx context.Context, ev *bindings.IPTokenStaking
 		return errors.Wrap(err, "delegator pubkey to evm address")
 	}
 
-	// unstakeOnBehalf txn, need to check if it's from the operator
+	// unstakeOnBehalf txn, need to check if it's from an operator permitted to unstake
 	if delEvmAddr.String() != ev.OperatorAddress.String() {
-		operatorAddr, err := k.DelegatorOperatorAddress.Get(cachedCtx, depositorAddr.String())
-		if errors.Is(err, collections.ErrNotFound) {
-			return errors.WrapErrWithCode(
-				errors.InvalidOperator,
-				errors.New("invalid unstakeOnBehalf txn, no operator"),
-			)
-		} else if err != nil {
-			return errors.Wrap(err, "get delegator's operator address failed")
-		}
-		if operatorAddr != ev.OperatorAddress.String() {
-			return errors.WrapErrWithCode(
-				errors.InvalidOperator,
-				errors.New("invalid unstakeOnBehalf txn, not from operator"),
-			)
+		if err := k.checkOperatorPermission(cachedCtx, depositorAddr, ev.OperatorAddress, types.OperatorPermissionUnstake, "unstakeOnBehalf"); err != nil {
+			return err
 		}
 	}
 
+	if err := k.checkMinUnstakeAmount(cachedCtx, ev.StakeAmount); err != nil {
+		return err
+	}
+
 	amountCoin, _ := IPTokenToBondCoin(ev.StakeAmount)
 
 	log.Debug(cachedCtx, "Processing EVM staking withdraw",
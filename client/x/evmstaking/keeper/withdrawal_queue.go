@@ -2,14 +2,15 @@ package keeper
 
 import (
 	"context"
-	"errors"
+
+	"cosmossdk.io/collections"
 
 	"github.com/ethereum/go-ethereum/common"
 	etypes "github.com/ethereum/go-ethereum/core/types"
 
 	addcollections "github.com/piplabs/story/client/collections"
 	"github.com/piplabs/story/client/x/evmstaking/types"
-	"github.com/piplabs/story/lib/log"
+	"github.com/piplabs/story/lib/errors"
 )
 
 // AddWithdrawalToQueue inserts a withdrawal into the queue.
@@ -17,15 +18,34 @@ func (k Keeper) AddWithdrawalToQueue(ctx context.Context, withdrawal types.Withd
 	return k.WithdrawalQueue.Enqueue(ctx, withdrawal)
 }
 
+// GetNextWithdrawalIndex returns the global withdrawal index that is assigned to the next dequeued withdrawal.
+func (k Keeper) GetNextWithdrawalIndex(ctx context.Context) (uint64, error) {
+	index, err := k.NextWithdrawalIndex.Get(ctx)
+	if errors.Is(err, collections.ErrNotFound) {
+		return 0, nil
+	} else if err != nil {
+		return 0, errors.Wrap(err, "get next withdrawal index")
+	}
+
+	return index, nil
+}
+
+func (k Keeper) SetNextWithdrawalIndex(ctx context.Context, index uint64) error {
+	if err := k.NextWithdrawalIndex.Set(ctx, index); err != nil {
+		return errors.Wrap(err, "set next withdrawal index")
+	}
+
+	return nil
+}
+
 func (k Keeper) DequeueEligibleWithdrawals(ctx context.Context, maxDequeue uint32) (withdrawals etypes.Withdrawals, err error) {
-	// front is the unique monotonically increasing index of a withdrawal in the queue.
-	// It's used as the value in etypes.Withdrawal.Index for later validation purposes,
-	// when evmengine's msg_server receives withdrawals as part of the execution payload
-	// and needs to verify that the received withdrawals are in the correct order from
-	// the front of the queue.
-	front, err := k.WithdrawalQueue.Front(ctx)
+	// nextIndex is the global monotonically increasing index shared by the withdrawal and
+	// reward withdrawal queues. It's used as the value in etypes.Withdrawal.Index for later
+	// validation purposes, when evmengine's msg_server receives withdrawals as part of the
+	// execution payload and needs to verify that the received withdrawals are in the correct
+	// order from the front of the queues.
+	nextIndex, err := k.GetNextWithdrawalIndex(ctx)
 	if err != nil {
-		log.Debug(ctx, "Front", "err", err)
 		return nil, err
 	}
 
@@ -40,13 +60,17 @@ func (k Keeper) DequeueEligibleWithdrawals(ctx context.Context, maxDequeue uint3
 			return nil, err
 		}
 		withdrawals = append(withdrawals, &etypes.Withdrawal{
-			Index:     front + i, // increment front by i to get the correct index in the loop
-			Validator: 0,         // does not matter for EL
+			Index:     nextIndex + i, // increment the next index by i to get the correct index in the loop
+			Validator: 0,             // does not matter for EL
 			Address:   common.HexToAddress(withdrawal.ExecutionAddress),
 			Amount:    withdrawal.Amount,
 		})
 	}
 
+	if err := k.SetNextWithdrawalIndex(ctx, nextIndex+uint64(len(withdrawals))); err != nil {
+		return nil, err
+	}
+
 	return withdrawals, nil
 }
 
@@ -55,12 +79,9 @@ func (k Keeper) PeekEligibleWithdrawals(ctx context.Context, maxPeek uint32) (wi
 		return withdrawals, nil
 	}
 
-	// front is the unique monotonically increasing index of a withdrawal in the queue.
-	// It's used as the value in etypes.Withdrawal.Index for later validation purposes,
-	// when evmengine's msg_server receives withdrawals as part of the execution payload
-	// and needs to verify that the received withdrawals are in the correct order from
-	// the front of the queue.
-	front, err := k.WithdrawalQueue.Front(ctx)
+	// nextIndex is the global withdrawal index that the first peeked withdrawal will be
+	// assigned when it's dequeued (see DequeueEligibleWithdrawals).
+	nextIndex, err := k.GetNextWithdrawalIndex(ctx)
 	if err != nil {
 		return nil, err
 	}
@@ -77,8 +98,8 @@ func (k Keeper) PeekEligibleWithdrawals(ctx context.Context, maxPeek uint32) (wi
 			return nil, err
 		}
 		withdrawals = append(withdrawals, &etypes.Withdrawal{
-			Index:     front + i, // increment front by i to get the correct index in the loop
-			Validator: 0,         // does not matter for EL
+			Index:     nextIndex + i, // increment the next index by i to get the correct index in the loop
+			Validator: 0,             // does not matter for EL
 			Address:   common.HexToAddress(withdrawal.ExecutionAddress),
 			Amount:    withdrawal.Amount,
 		})
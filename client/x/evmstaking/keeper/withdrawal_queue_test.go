@@ -189,6 +189,53 @@ func (s *TestSuite) TestPeekEligibleWithdrawals() {
 	}
 }
 
+func (s *TestSuite) TestGlobalWithdrawalIndex() {
+	require := s.Require()
+	ctx, keeper := s.Ctx, s.EVMStakingKeeper
+	s.initQueue()
+	require.NoError(keeper.RewardWithdrawalQueue.Initialize(ctx))
+
+	// Start from a non-zero global withdrawal index
+	require.NoError(keeper.SetNextWithdrawalIndex(ctx, 10))
+	s.addWithdrawals(withdrawals[:2])
+	for _, w := range withdrawals {
+		require.NoError(keeper.AddRewardWithdrawalToQueue(ctx, w))
+	}
+
+	// Reward withdrawals are peeked after the withdrawals, continuing the global index
+	peeked, err := keeper.PeekEligibleWithdrawals(ctx, 4)
+	require.NoError(err)
+	rewardPeeked, err := keeper.PeekEligibleRewardWithdrawals(ctx, 4-uint32(len(peeked)), uint64(len(peeked)))
+	require.NoError(err)
+	peeked = append(peeked, rewardPeeked...)
+	require.Len(peeked, 4)
+
+	// Dequeued withdrawals are assigned the peeked non-overlapping indexes
+	dequeued, err := keeper.DequeueEligibleWithdrawals(ctx, 4)
+	require.NoError(err)
+	rewardDequeued, err := keeper.DequeueEligibleRewardWithdrawals(ctx, 4-uint32(len(dequeued)))
+	require.NoError(err)
+	dequeued = append(dequeued, rewardDequeued...)
+	require.Equal(peeked, dequeued)
+	for i, w := range dequeued {
+		require.Equal(uint64(10+i), w.Index)
+	}
+
+	nextIndex, err := keeper.GetNextWithdrawalIndex(ctx)
+	require.NoError(err)
+	require.Equal(uint64(14), nextIndex)
+
+	// The global index keeps increasing for the remaining reward withdrawal
+	rewardDequeued, err = keeper.DequeueEligibleRewardWithdrawals(ctx, 4)
+	require.NoError(err)
+	require.Len(rewardDequeued, 1)
+	require.Equal(uint64(14), rewardDequeued[0].Index)
+
+	nextIndex, err = keeper.GetNextWithdrawalIndex(ctx)
+	require.NoError(err)
+	require.Equal(uint64(15), nextIndex)
+}
+
 func (s *TestSuite) TestGetAllWithdrawals() {
 	require := s.Require()
 	ctx, keeper := s.Ctx, s.EVMStakingKeeper
@@ -10,6 +10,7 @@ import (
 	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
 
+	evmenginetypes "github.com/piplabs/story/client/x/evmengine/types"
 	"github.com/piplabs/story/client/x/evmstaking/keeper"
 	"github.com/piplabs/story/client/x/evmstaking/types"
 	"github.com/piplabs/story/lib/ethclient"
@@ -43,8 +44,9 @@ type ModuleInputs struct {
 type ModuleOutputs struct {
 	depinject.Out
 
-	Keeper *keeper.Keeper
-	Module appmodule.AppModule
+	Keeper         *keeper.Keeper
+	Module         appmodule.AppModule
+	EventProcessor evmenginetypes.InjectedEvmEventProcessor
 }
 
 func ProvideModule(in ModuleInputs) ModuleOutputs {
@@ -69,5 +71,9 @@ func ProvideModule(in ModuleInputs) ModuleOutputs {
 
 	m := NewAppModule(in.Cdc, k, in.AccountKeeper, in.BankKeeper, in.SlashingKeeper, &in.StakingKeeper)
 
-	return ModuleOutputs{Keeper: k, Module: m}
+	return ModuleOutputs{
+		Keeper:         k,
+		Module:         m,
+		EventProcessor: evmenginetypes.InjectedEvmEventProcessor{EvmEventProcessor: k},
+	}
 }
@@ -3,10 +3,12 @@ package types
 // evmstaking module event types.
 const (
 	EventTypeUpdateValidatorCommissionFailure = "update_validator_commission_failure"
+	EventTypeSetFeeRecipientFailure           = "set_fee_recipient_failure"
 	EventTypeSetWithdrawalAddressFailure      = "set_withdrawal_address_failure"
 	EventTypeSetRewardAddressFailure          = "set_reward_address_failure"
 	EventTypeAddOperatorFailure               = "add_operator_failure"
 	EventTypeRemoveOperatorFailure            = "remove_operator_failure"
+	EventTypeSetOperatorPermissionsFailure    = "set_operator_permissions_failure"
 	EventTypeCreateValidatorFailure           = "create_validator_failure"
 	EventTypeDelegateFailure                  = "delegate_failure"
 	EventTypeRedelegateFailure                = "redelegate_failure"
@@ -25,7 +27,9 @@ const (
 	AttributeKeySenderAddress           = "sender_address"
 	AttributeKeyWithdrawalAddress       = "withdrawal_address"
 	AttributeKeyRewardAddress           = "reward_address"
+	AttributeKeyFeeRecipient            = "fee_recipient"
 	AttributeKeyOperatorAddress         = "operator_address"
+	AttributeKeyOperatorPermissions     = "operator_permissions"
 	AttributeKeyMoniker                 = "moniker"
 	AttributeKeyCommissionRate          = "commission_rate"
 	AttributeKeyMaxCommissionRate       = "max_commission_rate"
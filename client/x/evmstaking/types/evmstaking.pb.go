@@ -29,6 +29,10 @@ type Withdrawal struct {
 	// TODO: use ethcommon.Address type
 	ExecutionAddress string `protobuf:"bytes,2,opt,name=execution_address,json=executionAddress,proto3" json:"execution_address,omitempty" yaml:"execution_address"`
 	Amount           uint64 `protobuf:"varint,3,opt,name=amount,proto3" json:"amount,omitempty" yaml:"amount"`
+	// is_refund is true if the withdrawal refunds the amount of an EL stake event that failed on CL.
+	IsRefund bool `protobuf:"varint,4,opt,name=is_refund,json=isRefund,proto3" json:"is_refund,omitempty" yaml:"is_refund"`
+	// refund_code is the lib/errors code of the failure that triggered the refund.
+	RefundCode uint32 `protobuf:"varint,5,opt,name=refund_code,json=refundCode,proto3" json:"refund_code,omitempty" yaml:"refund_code"`
 }
 
 func (m *Withdrawal) Reset()         { *m = Withdrawal{} }
@@ -64,8 +68,188 @@ func (m *Withdrawal) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_Withdrawal proto.InternalMessageInfo
 
+// FailedEvent is an EVM log event that failed to be processed, kept for auditing.
+type FailedEvent struct {
+	Id                 uint64   `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Height             uint64   `protobuf:"varint,2,opt,name=height,proto3" json:"height,omitempty"`
+	BlockHash          []byte   `protobuf:"bytes,3,opt,name=block_hash,json=blockHash,proto3" json:"block_hash,omitempty"`
+	TxHash             []byte   `protobuf:"bytes,4,opt,name=tx_hash,json=txHash,proto3" json:"tx_hash,omitempty"`
+	LogIndex           uint64   `protobuf:"varint,5,opt,name=log_index,json=logIndex,proto3" json:"log_index,omitempty"`
+	EventType          string   `protobuf:"bytes,6,opt,name=event_type,json=eventType,proto3" json:"event_type,omitempty"`
+	Payload            string   `protobuf:"bytes,7,opt,name=payload,proto3" json:"payload,omitempty"`
+	ErrorCode          string   `protobuf:"bytes,8,opt,name=error_code,json=errorCode,proto3" json:"error_code,omitempty"`
+	DelegatorAddress   string   `protobuf:"bytes,9,opt,name=delegator_address,json=delegatorAddress,proto3" json:"delegator_address,omitempty"`
+	ValidatorAddresses []string `protobuf:"bytes,10,rep,name=validator_addresses,json=validatorAddresses,proto3" json:"validator_addresses,omitempty"`
+}
+
+func (m *FailedEvent) Reset()         { *m = FailedEvent{} }
+func (m *FailedEvent) String() string { return proto.CompactTextString(m) }
+func (*FailedEvent) ProtoMessage()    {}
+func (*FailedEvent) Descriptor() ([]byte, []int) {
+	return fileDescriptor_185991fb447209d8, []int{1}
+}
+func (m *FailedEvent) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *FailedEvent) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_FailedEvent.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *FailedEvent) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_FailedEvent.Merge(m, src)
+}
+func (m *FailedEvent) XXX_Size() int {
+	return m.Size()
+}
+func (m *FailedEvent) XXX_DiscardUnknown() {
+	xxx_messageInfo_FailedEvent.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_FailedEvent proto.InternalMessageInfo
+
+func (m *FailedEvent) GetId() uint64 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
+}
+
+func (m *FailedEvent) GetHeight() uint64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+func (m *FailedEvent) GetBlockHash() []byte {
+	if m != nil {
+		return m.BlockHash
+	}
+	return nil
+}
+
+func (m *FailedEvent) GetTxHash() []byte {
+	if m != nil {
+		return m.TxHash
+	}
+	return nil
+}
+
+func (m *FailedEvent) GetLogIndex() uint64 {
+	if m != nil {
+		return m.LogIndex
+	}
+	return 0
+}
+
+func (m *FailedEvent) GetEventType() string {
+	if m != nil {
+		return m.EventType
+	}
+	return ""
+}
+
+func (m *FailedEvent) GetPayload() string {
+	if m != nil {
+		return m.Payload
+	}
+	return ""
+}
+
+func (m *FailedEvent) GetErrorCode() string {
+	if m != nil {
+		return m.ErrorCode
+	}
+	return ""
+}
+
+func (m *FailedEvent) GetDelegatorAddress() string {
+	if m != nil {
+		return m.DelegatorAddress
+	}
+	return ""
+}
+
+func (m *FailedEvent) GetValidatorAddresses() []string {
+	if m != nil {
+		return m.ValidatorAddresses
+	}
+	return nil
+}
+
+// DelegatorOperator is an operator allowed to act on behalf of a delegator within its permissions.
+type DelegatorOperator struct {
+	DelegatorAddress string `protobuf:"bytes,1,opt,name=delegator_address,json=delegatorAddress,proto3" json:"delegator_address,omitempty"`
+	OperatorAddress  string `protobuf:"bytes,2,opt,name=operator_address,json=operatorAddress,proto3" json:"operator_address,omitempty"`
+	Permissions      uint32 `protobuf:"varint,3,opt,name=permissions,proto3" json:"permissions,omitempty"`
+}
+
+func (m *DelegatorOperator) Reset()         { *m = DelegatorOperator{} }
+func (m *DelegatorOperator) String() string { return proto.CompactTextString(m) }
+func (*DelegatorOperator) ProtoMessage()    {}
+func (*DelegatorOperator) Descriptor() ([]byte, []int) {
+	return fileDescriptor_185991fb447209d8, []int{2}
+}
+func (m *DelegatorOperator) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DelegatorOperator) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DelegatorOperator.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *DelegatorOperator) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DelegatorOperator.Merge(m, src)
+}
+func (m *DelegatorOperator) XXX_Size() int {
+	return m.Size()
+}
+func (m *DelegatorOperator) XXX_DiscardUnknown() {
+	xxx_messageInfo_DelegatorOperator.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DelegatorOperator proto.InternalMessageInfo
+
+func (m *DelegatorOperator) GetDelegatorAddress() string {
+	if m != nil {
+		return m.DelegatorAddress
+	}
+	return ""
+}
+
+func (m *DelegatorOperator) GetOperatorAddress() string {
+	if m != nil {
+		return m.OperatorAddress
+	}
+	return ""
+}
+
+func (m *DelegatorOperator) GetPermissions() uint32 {
+	if m != nil {
+		return m.Permissions
+	}
+	return 0
+}
+
 func init() {
 	proto.RegisterType((*Withdrawal)(nil), "client.x.evmstaking.types.Withdrawal")
+	proto.RegisterType((*FailedEvent)(nil), "client.x.evmstaking.types.FailedEvent")
+	proto.RegisterType((*DelegatorOperator)(nil), "client.x.evmstaking.types.DelegatorOperator")
 }
 
 func init() {
@@ -73,25 +257,43 @@ func init() {
 }
 
 var fileDescriptor_185991fb447209d8 = []byte{
-	// 281 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0xd2, 0x4a, 0xce, 0xc9, 0x4c,
-	0xcd, 0x2b, 0xd1, 0xaf, 0xd0, 0x4f, 0x2d, 0xcb, 0x2d, 0x2e, 0x49, 0xcc, 0xce, 0xcc, 0x4b, 0xd7,
-	0x2f, 0xa9, 0x2c, 0x48, 0x2d, 0x46, 0x12, 0xd0, 0x2b, 0x28, 0xca, 0x2f, 0xc9, 0x17, 0x92, 0x84,
-	0xa8, 0xd5, 0xab, 0xd0, 0x43, 0x92, 0x02, 0xab, 0x95, 0x12, 0x49, 0xcf, 0x4f, 0xcf, 0x07, 0xab,
-	0xd2, 0x07, 0xb1, 0x20, 0x1a, 0xa4, 0x24, 0x93, 0xf3, 0x8b, 0x73, 0xf3, 0x8b, 0xe3, 0x21, 0x12,
-	0x10, 0x0e, 0x44, 0x4a, 0xe9, 0x2c, 0x23, 0x17, 0x57, 0x78, 0x66, 0x49, 0x46, 0x4a, 0x51, 0x62,
-	0x79, 0x62, 0x8e, 0x90, 0x3a, 0x17, 0x7f, 0x72, 0x51, 0x6a, 0x62, 0x49, 0x66, 0x7e, 0x5e, 0x7c,
-	0x46, 0x6a, 0x66, 0x7a, 0x46, 0x89, 0x04, 0xa3, 0x02, 0xa3, 0x06, 0x4b, 0x10, 0x1f, 0x4c, 0xd8,
-	0x03, 0x2c, 0x2a, 0x94, 0xc8, 0x25, 0x98, 0x5a, 0x91, 0x9a, 0x5c, 0x0a, 0x56, 0x99, 0x98, 0x92,
-	0x52, 0x94, 0x5a, 0x5c, 0x2c, 0xc1, 0xa4, 0xc0, 0xa8, 0xc1, 0xe9, 0x64, 0xf2, 0xe9, 0x9e, 0xbc,
-	0x44, 0x65, 0x62, 0x6e, 0x8e, 0x95, 0x12, 0x86, 0x12, 0xa5, 0x4b, 0x5b, 0x74, 0x45, 0xa0, 0x0e,
-	0x70, 0x84, 0x08, 0x05, 0x97, 0x14, 0x65, 0xe6, 0xa5, 0x07, 0x09, 0xc0, 0xd5, 0x42, 0xc5, 0x85,
-	0x34, 0xb9, 0xd8, 0x12, 0x73, 0xf3, 0x4b, 0xf3, 0x4a, 0x24, 0x98, 0x41, 0x4e, 0x70, 0x12, 0xfc,
-	0x74, 0x4f, 0x9e, 0x17, 0x62, 0x2e, 0x44, 0x5c, 0x29, 0x08, 0xaa, 0xc0, 0x8a, 0xa3, 0x63, 0x81,
-	0x3c, 0xc3, 0x8b, 0x05, 0xf2, 0x8c, 0x4e, 0xc6, 0x27, 0x1e, 0xc9, 0x31, 0x5e, 0x78, 0x24, 0xc7,
-	0xf8, 0xe0, 0x91, 0x1c, 0xe3, 0x84, 0xc7, 0x72, 0x0c, 0x17, 0x1e, 0xcb, 0x31, 0xdc, 0x78, 0x2c,
-	0xc7, 0x10, 0x25, 0x89, 0x33, 0x84, 0x93, 0xd8, 0xc0, 0x61, 0x61, 0x0c, 0x08, 0x00, 0x00, 0xff,
-	0xff, 0xea, 0x83, 0x36, 0x44, 0x85, 0x01, 0x00, 0x00,
+	// 570 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x74, 0x93, 0x4f, 0x6f, 0xd3, 0x30,
+	0x18, 0xc6, 0x9b, 0xac, 0x74, 0x8d, 0xc7, 0xb6, 0xd6, 0x4c, 0x23, 0x1b, 0x22, 0x89, 0x72, 0xa1,
+	0x03, 0xb1, 0x0a, 0x0d, 0x09, 0x69, 0xb7, 0x95, 0x3f, 0x1a, 0x27, 0x24, 0x83, 0x84, 0xc4, 0x25,
+	0xf2, 0x62, 0x93, 0x58, 0x4b, 0xe3, 0xc8, 0xf6, 0x46, 0xfa, 0x0d, 0xb8, 0x20, 0xf1, 0x11, 0xf6,
+	0x21, 0xb8, 0x70, 0xe3, 0xc8, 0x71, 0xe2, 0xc4, 0xa9, 0x42, 0xeb, 0x85, 0x73, 0x3f, 0x01, 0x8a,
+	0x9d, 0x54, 0x45, 0xb0, 0x53, 0xf2, 0xfe, 0x9e, 0xe7, 0xb5, 0x5f, 0xbf, 0xaf, 0x0d, 0xee, 0xc7,
+	0x19, 0xa3, 0xb9, 0x1a, 0x96, 0x43, 0x7a, 0x3e, 0x96, 0x0a, 0x9f, 0xb2, 0x3c, 0x19, 0xaa, 0x49,
+	0x41, 0xe5, 0x12, 0xd8, 0x2f, 0x04, 0x57, 0x1c, 0xee, 0x18, 0xef, 0x7e, 0xb9, 0xbf, 0x24, 0x69,
+	0xef, 0xee, 0x56, 0xc2, 0x13, 0xae, 0x5d, 0xc3, 0xea, 0xcf, 0x24, 0xec, 0xee, 0xc4, 0x5c, 0x8e,
+	0xb9, 0x8c, 0x8c, 0x60, 0x02, 0x23, 0x85, 0x5f, 0x6d, 0x00, 0xde, 0x32, 0x95, 0x12, 0x81, 0x3f,
+	0xe0, 0x0c, 0xde, 0x03, 0x9b, 0xb1, 0xa0, 0x58, 0x31, 0x9e, 0x47, 0x29, 0x65, 0x49, 0xaa, 0x5c,
+	0x2b, 0xb0, 0x06, 0x6d, 0xb4, 0xd1, 0xe0, 0x63, 0x4d, 0x21, 0x06, 0x7d, 0x5a, 0xd2, 0xf8, 0x4c,
+	0x3b, 0x31, 0x21, 0x82, 0x4a, 0xe9, 0xda, 0x81, 0x35, 0x70, 0x46, 0x8f, 0xe7, 0x53, 0xdf, 0x9d,
+	0xe0, 0x71, 0x76, 0x18, 0xfe, 0x63, 0x09, 0x7f, 0x7c, 0x79, 0xb8, 0x55, 0x17, 0x70, 0x64, 0xd0,
+	0x6b, 0x25, 0x58, 0x9e, 0xa0, 0xde, 0xc2, 0x5b, 0x73, 0xb8, 0x07, 0x3a, 0x78, 0xcc, 0xcf, 0x72,
+	0xe5, 0xae, 0x54, 0x25, 0x8c, 0xfa, 0xf3, 0xa9, 0xbf, 0x6e, 0xd6, 0x35, 0x3c, 0x44, 0xb5, 0x01,
+	0x3e, 0x02, 0x0e, 0x93, 0x91, 0xa0, 0xef, 0xcf, 0x72, 0xe2, 0xb6, 0x03, 0x6b, 0xd0, 0x1d, 0x6d,
+	0xcd, 0xa7, 0x7e, 0xcf, 0xb8, 0x17, 0x52, 0x88, 0xba, 0x4c, 0x22, 0xfd, 0x0b, 0x9f, 0x80, 0x35,
+	0x03, 0xa3, 0x98, 0x13, 0xea, 0xde, 0x08, 0xac, 0xc1, 0xfa, 0x68, 0x7b, 0x3e, 0xf5, 0xa1, 0x49,
+	0x5a, 0x12, 0x43, 0x04, 0x4c, 0xf4, 0x94, 0x13, 0x7a, 0xd8, 0xfd, 0x78, 0xe1, 0xb7, 0x7e, 0x5f,
+	0xf8, 0x56, 0xf8, 0xcd, 0x06, 0x6b, 0x2f, 0x30, 0xcb, 0x28, 0x79, 0x7e, 0x4e, 0x73, 0x05, 0x37,
+	0x80, 0xcd, 0x48, 0xdd, 0x2f, 0x9b, 0x11, 0xb8, 0x0d, 0x3a, 0x75, 0x0f, 0x6d, 0xcd, 0xea, 0x08,
+	0xde, 0x05, 0xe0, 0x24, 0xe3, 0xf1, 0x69, 0x94, 0x62, 0x99, 0xea, 0xc3, 0xdd, 0x44, 0x8e, 0x26,
+	0xc7, 0x58, 0xa6, 0xf0, 0x36, 0x58, 0x55, 0xa5, 0xd1, 0xda, 0x5a, 0xeb, 0xa8, 0x52, 0x0b, 0x77,
+	0x80, 0x93, 0xf1, 0x24, 0x62, 0x39, 0xa1, 0xa5, 0x2e, 0xb8, 0x8d, 0xba, 0x19, 0x4f, 0x5e, 0x56,
+	0x71, 0xb5, 0x28, 0xad, 0xaa, 0x88, 0xaa, 0x8b, 0xe0, 0x76, 0xaa, 0x49, 0x20, 0x47, 0x93, 0x37,
+	0x93, 0x82, 0x42, 0x17, 0xac, 0x16, 0x78, 0x92, 0x71, 0x4c, 0xdc, 0x55, 0xad, 0x35, 0xa1, 0x4e,
+	0x14, 0x82, 0x0b, 0xd3, 0x87, 0x6e, 0x9d, 0x58, 0x91, 0xea, 0xb8, 0xf0, 0x01, 0xe8, 0x13, 0x9a,
+	0xd1, 0x04, 0x2b, 0x2e, 0x16, 0x83, 0x76, 0xb4, 0xab, 0xb7, 0x10, 0x9a, 0x91, 0x0d, 0xc1, 0xad,
+	0x73, 0x9c, 0x31, 0xb2, 0x6c, 0xa6, 0xd2, 0x05, 0xc1, 0xca, 0xc0, 0x41, 0x70, 0x21, 0x1d, 0x35,
+	0x4a, 0xf8, 0xc9, 0x02, 0xfd, 0x67, 0xcd, 0x2a, 0xaf, 0x0a, 0x2a, 0xaa, 0xef, 0xff, 0xf7, 0xb4,
+	0xae, 0xd9, 0x73, 0x0f, 0xf4, 0x78, 0x9d, 0xf8, 0xf7, 0x45, 0x44, 0x9b, 0x0d, 0x6f, 0xac, 0x01,
+	0x58, 0x2b, 0xa8, 0x18, 0x33, 0x29, 0x19, 0xcf, 0xa5, 0xee, 0xfc, 0x3a, 0x5a, 0x46, 0xa3, 0x83,
+	0xef, 0x57, 0x9e, 0x75, 0x79, 0xe5, 0x59, 0xbf, 0xae, 0x3c, 0xeb, 0xf3, 0xcc, 0x6b, 0x5d, 0xce,
+	0xbc, 0xd6, 0xcf, 0x99, 0xd7, 0x7a, 0xb7, 0x73, 0xed, 0x03, 0x3d, 0xe9, 0xe8, 0xa7, 0x74, 0xf0,
+	0x67, 0x00, 0xa3, 0x5f, 0xf1, 0x2c, 0xc4, 0x03, 0x00, 0x00,
 }
 
 func (this *Withdrawal) Equal(that interface{}) bool {
@@ -122,6 +324,12 @@ func (this *Withdrawal) Equal(that interface{}) bool {
 	if this.Amount != that1.Amount {
 		return false
 	}
+	if this.IsRefund != that1.IsRefund {
+		return false
+	}
+	if this.RefundCode != that1.RefundCode {
+		return false
+	}
 	return true
 }
 func (m *Withdrawal) Marshal() (dAtA []byte, err error) {
@@ -144,6 +352,21 @@ func (m *Withdrawal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.RefundCode != 0 {
+		i = encodeVarintEvmstaking(dAtA, i, uint64(m.RefundCode))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.IsRefund {
+		i--
+		if m.IsRefund {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x20
+	}
 	if m.Amount != 0 {
 		i = encodeVarintEvmstaking(dAtA, i, uint64(m.Amount))
 		i--
@@ -164,6 +387,137 @@ func (m *Withdrawal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *FailedEvent) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *FailedEvent) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *FailedEvent) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ValidatorAddresses) > 0 {
+		for iNdEx := len(m.ValidatorAddresses) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.ValidatorAddresses[iNdEx])
+			copy(dAtA[i:], m.ValidatorAddresses[iNdEx])
+			i = encodeVarintEvmstaking(dAtA, i, uint64(len(m.ValidatorAddresses[iNdEx])))
+			i--
+			dAtA[i] = 0x52
+		}
+	}
+	if len(m.DelegatorAddress) > 0 {
+		i -= len(m.DelegatorAddress)
+		copy(dAtA[i:], m.DelegatorAddress)
+		i = encodeVarintEvmstaking(dAtA, i, uint64(len(m.DelegatorAddress)))
+		i--
+		dAtA[i] = 0x4a
+	}
+	if len(m.ErrorCode) > 0 {
+		i -= len(m.ErrorCode)
+		copy(dAtA[i:], m.ErrorCode)
+		i = encodeVarintEvmstaking(dAtA, i, uint64(len(m.ErrorCode)))
+		i--
+		dAtA[i] = 0x42
+	}
+	if len(m.Payload) > 0 {
+		i -= len(m.Payload)
+		copy(dAtA[i:], m.Payload)
+		i = encodeVarintEvmstaking(dAtA, i, uint64(len(m.Payload)))
+		i--
+		dAtA[i] = 0x3a
+	}
+	if len(m.EventType) > 0 {
+		i -= len(m.EventType)
+		copy(dAtA[i:], m.EventType)
+		i = encodeVarintEvmstaking(dAtA, i, uint64(len(m.EventType)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if m.LogIndex != 0 {
+		i = encodeVarintEvmstaking(dAtA, i, uint64(m.LogIndex))
+		i--
+		dAtA[i] = 0x28
+	}
+	if len(m.TxHash) > 0 {
+		i -= len(m.TxHash)
+		copy(dAtA[i:], m.TxHash)
+		i = encodeVarintEvmstaking(dAtA, i, uint64(len(m.TxHash)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.BlockHash) > 0 {
+		i -= len(m.BlockHash)
+		copy(dAtA[i:], m.BlockHash)
+		i = encodeVarintEvmstaking(dAtA, i, uint64(len(m.BlockHash)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.Height != 0 {
+		i = encodeVarintEvmstaking(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Id != 0 {
+		i = encodeVarintEvmstaking(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *DelegatorOperator) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DelegatorOperator) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DelegatorOperator) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Permissions != 0 {
+		i = encodeVarintEvmstaking(dAtA, i, uint64(m.Permissions))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.OperatorAddress) > 0 {
+		i -= len(m.OperatorAddress)
+		copy(dAtA[i:], m.OperatorAddress)
+		i = encodeVarintEvmstaking(dAtA, i, uint64(len(m.OperatorAddress)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.DelegatorAddress) > 0 {
+		i -= len(m.DelegatorAddress)
+		copy(dAtA[i:], m.DelegatorAddress)
+		i = encodeVarintEvmstaking(dAtA, i, uint64(len(m.DelegatorAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintEvmstaking(dAtA []byte, offset int, v uint64) int {
 	offset -= sovEvmstaking(v)
 	base := offset
@@ -191,6 +545,80 @@ func (m *Withdrawal) Size() (n int) {
 	if m.Amount != 0 {
 		n += 1 + sovEvmstaking(uint64(m.Amount))
 	}
+	if m.IsRefund {
+		n += 2
+	}
+	if m.RefundCode != 0 {
+		n += 1 + sovEvmstaking(uint64(m.RefundCode))
+	}
+	return n
+}
+
+func (m *FailedEvent) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovEvmstaking(uint64(m.Id))
+	}
+	if m.Height != 0 {
+		n += 1 + sovEvmstaking(uint64(m.Height))
+	}
+	l = len(m.BlockHash)
+	if l > 0 {
+		n += 1 + l + sovEvmstaking(uint64(l))
+	}
+	l = len(m.TxHash)
+	if l > 0 {
+		n += 1 + l + sovEvmstaking(uint64(l))
+	}
+	if m.LogIndex != 0 {
+		n += 1 + sovEvmstaking(uint64(m.LogIndex))
+	}
+	l = len(m.EventType)
+	if l > 0 {
+		n += 1 + l + sovEvmstaking(uint64(l))
+	}
+	l = len(m.Payload)
+	if l > 0 {
+		n += 1 + l + sovEvmstaking(uint64(l))
+	}
+	l = len(m.ErrorCode)
+	if l > 0 {
+		n += 1 + l + sovEvmstaking(uint64(l))
+	}
+	l = len(m.DelegatorAddress)
+	if l > 0 {
+		n += 1 + l + sovEvmstaking(uint64(l))
+	}
+	if len(m.ValidatorAddresses) > 0 {
+		for _, s := range m.ValidatorAddresses {
+			l = len(s)
+			n += 1 + l + sovEvmstaking(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *DelegatorOperator) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.DelegatorAddress)
+	if l > 0 {
+		n += 1 + l + sovEvmstaking(uint64(l))
+	}
+	l = len(m.OperatorAddress)
+	if l > 0 {
+		n += 1 + l + sovEvmstaking(uint64(l))
+	}
+	if m.Permissions != 0 {
+		n += 1 + sovEvmstaking(uint64(m.Permissions))
+	}
 	return n
 }
 
@@ -299,6 +727,513 @@ func (m *Withdrawal) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IsRefund", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvmstaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.IsRefund = bool(v != 0)
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RefundCode", wireType)
+			}
+			m.RefundCode = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvmstaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RefundCode |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvmstaking(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvmstaking
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *FailedEvent) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvmstaking
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: FailedEvent: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: FailedEvent: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvmstaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvmstaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockHash", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvmstaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthEvmstaking
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvmstaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BlockHash = append(m.BlockHash[:0], dAtA[iNdEx:postIndex]...)
+			if m.BlockHash == nil {
+				m.BlockHash = []byte{}
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TxHash", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvmstaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthEvmstaking
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvmstaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TxHash = append(m.TxHash[:0], dAtA[iNdEx:postIndex]...)
+			if m.TxHash == nil {
+				m.TxHash = []byte{}
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LogIndex", wireType)
+			}
+			m.LogIndex = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvmstaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LogIndex |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EventType", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvmstaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvmstaking
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvmstaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.EventType = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Payload", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvmstaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvmstaking
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvmstaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Payload = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ErrorCode", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvmstaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvmstaking
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvmstaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ErrorCode = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DelegatorAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvmstaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvmstaking
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvmstaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DelegatorAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddresses", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvmstaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvmstaking
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvmstaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ValidatorAddresses = append(m.ValidatorAddresses, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvmstaking(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvmstaking
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DelegatorOperator) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvmstaking
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DelegatorOperator: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DelegatorOperator: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DelegatorAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvmstaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvmstaking
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvmstaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DelegatorAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OperatorAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvmstaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvmstaking
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvmstaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.OperatorAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Permissions", wireType)
+			}
+			m.Permissions = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvmstaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Permissions |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipEvmstaking(dAtA[iNdEx:])
@@ -1,5 +1,12 @@
 package types
 
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/common"
+
+	"github.com/piplabs/story/lib/errors"
+)
+
 func NewGenesisState(params Params) *GenesisState {
 	return &GenesisState{
 		Params: params,
@@ -31,3 +38,89 @@ func DefaultValidatorSweepIndex() ValidatorSweepIndex {
 		NextValDelIndex: 0,
 	}
 }
+
+// Validate returns an error if the withdrawal queue positions don't match its withdrawals,
+// or if any withdrawal is invalid.
+func (q WithdrawalQueue) Validate() error {
+	if q.Rear < q.Front {
+		return errors.New("queue rear before front", "front", q.Front, "rear", q.Rear)
+	}
+	if q.Rear-q.Front != uint64(len(q.Withdrawals)) {
+		return errors.New("queue length mismatch", "front", q.Front, "rear", q.Rear, "withdrawals", len(q.Withdrawals))
+	}
+
+	for i, w := range q.Withdrawals {
+		if err := validateEVMAddress(w.ExecutionAddress); err != nil {
+			return errors.Wrap(err, "invalid withdrawal", "index", i)
+		}
+	}
+
+	return nil
+}
+
+// ValidateDelegatorAddressMappings returns an error if the keys of the address mappings aren't
+// unique delegator account addresses, or if their addresses aren't EVM addresses.
+func ValidateDelegatorAddressMappings(mappings []AddressMapping) error {
+	return validateAddressMappings(mappings, func(key string) error {
+		_, err := sdk.AccAddressFromBech32(key)
+		return err
+	})
+}
+
+// ValidateEVMAddressMappings returns an error if the keys of the address mappings aren't
+// unique EVM addresses, or if their addresses aren't EVM addresses.
+func ValidateEVMAddressMappings(mappings []AddressMapping) error {
+	return validateAddressMappings(mappings, validateEVMAddress)
+}
+
+// ValidateDelegatorOperators returns an error if the delegator operators aren't unique per delegator,
+// if their addresses are invalid, or if their permissions are empty or unknown.
+func ValidateDelegatorOperators(operators []DelegatorOperator) error {
+	keys := make(map[string]bool)
+	for _, o := range operators {
+		if _, err := sdk.AccAddressFromBech32(o.DelegatorAddress); err != nil {
+			return errors.Wrap(err, "invalid delegator address", "delegator", o.DelegatorAddress)
+		}
+		if err := validateEVMAddress(o.OperatorAddress); err != nil {
+			return errors.Wrap(err, "invalid operator address", "delegator", o.DelegatorAddress)
+		}
+		if o.Permissions == 0 || o.Permissions&^OperatorPermissionAll != 0 {
+			return errors.New("invalid operator permissions", "delegator", o.DelegatorAddress, "permissions", o.Permissions)
+		}
+
+		// Operators are keyed by the checksummed address, see Keeper.SetDelegatorOperator.
+		key := o.DelegatorAddress + "/" + common.HexToAddress(o.OperatorAddress).String()
+		if keys[key] {
+			return errors.New("duplicate delegator operator", "delegator", o.DelegatorAddress, "operator", o.OperatorAddress)
+		}
+		keys[key] = true
+	}
+
+	return nil
+}
+
+func validateAddressMappings(mappings []AddressMapping, validateKey func(string) error) error {
+	keys := make(map[string]bool)
+	for _, m := range mappings {
+		if err := validateKey(m.Key); err != nil {
+			return errors.Wrap(err, "invalid key", "key", m.Key)
+		}
+		if err := validateEVMAddress(m.Address); err != nil {
+			return errors.Wrap(err, "invalid address", "key", m.Key)
+		}
+		if keys[m.Key] {
+			return errors.New("duplicate key", "key", m.Key)
+		}
+		keys[m.Key] = true
+	}
+
+	return nil
+}
+
+func validateEVMAddress(addr string) error {
+	if !common.IsHexAddress(addr) {
+		return errors.New("invalid evm address", "address", addr)
+	}
+
+	return nil
+}
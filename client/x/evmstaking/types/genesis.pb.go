@@ -24,9 +24,20 @@ var _ = math.Inf
 const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
 type GenesisState struct {
-	Params Params `protobuf:"bytes,1,opt,name=params,proto3" json:"params"`
-	// TODO: Add withdrawals collections field as ORM if needed
-	ValidatorSweepIndex ValidatorSweepIndex `protobuf:"bytes,2,opt,name=validator_sweep_index,json=validatorSweepIndex,proto3" json:"validator_sweep_index"`
+	Params                Params              `protobuf:"bytes,1,opt,name=params,proto3" json:"params"`
+	ValidatorSweepIndex   ValidatorSweepIndex `protobuf:"bytes,2,opt,name=validator_sweep_index,json=validatorSweepIndex,proto3" json:"validator_sweep_index"`
+	WithdrawalQueue       WithdrawalQueue     `protobuf:"bytes,3,opt,name=withdrawal_queue,json=withdrawalQueue,proto3" json:"withdrawal_queue"`
+	RewardWithdrawalQueue WithdrawalQueue     `protobuf:"bytes,4,opt,name=reward_withdrawal_queue,json=rewardWithdrawalQueue,proto3" json:"reward_withdrawal_queue"`
+	// next_withdrawal_index is the global withdrawal index assigned to the next dequeued withdrawal.
+	NextWithdrawalIndex        uint64           `protobuf:"varint,5,opt,name=next_withdrawal_index,json=nextWithdrawalIndex,proto3" json:"next_withdrawal_index,omitempty"`
+	DelegatorWithdrawAddresses []AddressMapping `protobuf:"bytes,6,rep,name=delegator_withdraw_addresses,json=delegatorWithdrawAddresses,proto3" json:"delegator_withdraw_addresses"`
+	DelegatorRewardAddresses   []AddressMapping `protobuf:"bytes,7,rep,name=delegator_reward_addresses,json=delegatorRewardAddresses,proto3" json:"delegator_reward_addresses"`
+	// validator_fee_recipients maps the validator EVM addresses to their fee recipients.
+	ValidatorFeeRecipients []AddressMapping    `protobuf:"bytes,9,rep,name=validator_fee_recipients,json=validatorFeeRecipients,proto3" json:"validator_fee_recipients"`
+	UbiWithdrawals         []Withdrawal        `protobuf:"bytes,10,rep,name=ubi_withdrawals,json=ubiWithdrawals,proto3" json:"ubi_withdrawals"`
+	FailedEvents           []FailedEvent       `protobuf:"bytes,11,rep,name=failed_events,json=failedEvents,proto3" json:"failed_events"`
+	NextFailedEventId      uint64              `protobuf:"varint,12,opt,name=next_failed_event_id,json=nextFailedEventId,proto3" json:"next_failed_event_id,omitempty"`
+	DelegatorOperators     []DelegatorOperator `protobuf:"bytes,13,rep,name=delegator_operators,json=delegatorOperators,proto3" json:"delegator_operators"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -76,6 +87,190 @@ func (m *GenesisState) GetValidatorSweepIndex() ValidatorSweepIndex {
 	return ValidatorSweepIndex{}
 }
 
+func (m *GenesisState) GetWithdrawalQueue() WithdrawalQueue {
+	if m != nil {
+		return m.WithdrawalQueue
+	}
+	return WithdrawalQueue{}
+}
+
+func (m *GenesisState) GetRewardWithdrawalQueue() WithdrawalQueue {
+	if m != nil {
+		return m.RewardWithdrawalQueue
+	}
+	return WithdrawalQueue{}
+}
+
+func (m *GenesisState) GetNextWithdrawalIndex() uint64 {
+	if m != nil {
+		return m.NextWithdrawalIndex
+	}
+	return 0
+}
+
+func (m *GenesisState) GetDelegatorWithdrawAddresses() []AddressMapping {
+	if m != nil {
+		return m.DelegatorWithdrawAddresses
+	}
+	return nil
+}
+
+func (m *GenesisState) GetDelegatorRewardAddresses() []AddressMapping {
+	if m != nil {
+		return m.DelegatorRewardAddresses
+	}
+	return nil
+}
+
+func (m *GenesisState) GetValidatorFeeRecipients() []AddressMapping {
+	if m != nil {
+		return m.ValidatorFeeRecipients
+	}
+	return nil
+}
+
+func (m *GenesisState) GetUbiWithdrawals() []Withdrawal {
+	if m != nil {
+		return m.UbiWithdrawals
+	}
+	return nil
+}
+
+func (m *GenesisState) GetFailedEvents() []FailedEvent {
+	if m != nil {
+		return m.FailedEvents
+	}
+	return nil
+}
+
+func (m *GenesisState) GetNextFailedEventId() uint64 {
+	if m != nil {
+		return m.NextFailedEventId
+	}
+	return 0
+}
+
+func (m *GenesisState) GetDelegatorOperators() []DelegatorOperator {
+	if m != nil {
+		return m.DelegatorOperators
+	}
+	return nil
+}
+
+// WithdrawalQueue is the state of a withdrawal queue, the withdrawals are at the positions [front, rear).
+type WithdrawalQueue struct {
+	Front       uint64       `protobuf:"varint,1,opt,name=front,proto3" json:"front,omitempty"`
+	Rear        uint64       `protobuf:"varint,2,opt,name=rear,proto3" json:"rear,omitempty"`
+	Withdrawals []Withdrawal `protobuf:"bytes,3,rep,name=withdrawals,proto3" json:"withdrawals"`
+}
+
+func (m *WithdrawalQueue) Reset()         { *m = WithdrawalQueue{} }
+func (m *WithdrawalQueue) String() string { return proto.CompactTextString(m) }
+func (*WithdrawalQueue) ProtoMessage()    {}
+func (*WithdrawalQueue) Descriptor() ([]byte, []int) {
+	return fileDescriptor_bf57cf100cbaf4bd, []int{1}
+}
+func (m *WithdrawalQueue) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *WithdrawalQueue) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_WithdrawalQueue.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *WithdrawalQueue) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_WithdrawalQueue.Merge(m, src)
+}
+func (m *WithdrawalQueue) XXX_Size() int {
+	return m.Size()
+}
+func (m *WithdrawalQueue) XXX_DiscardUnknown() {
+	xxx_messageInfo_WithdrawalQueue.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_WithdrawalQueue proto.InternalMessageInfo
+
+func (m *WithdrawalQueue) GetFront() uint64 {
+	if m != nil {
+		return m.Front
+	}
+	return 0
+}
+
+func (m *WithdrawalQueue) GetRear() uint64 {
+	if m != nil {
+		return m.Rear
+	}
+	return 0
+}
+
+func (m *WithdrawalQueue) GetWithdrawals() []Withdrawal {
+	if m != nil {
+		return m.Withdrawals
+	}
+	return nil
+}
+
+// AddressMapping is an entry of an address map, e.g. a delegator address to its withdraw EVM address.
+type AddressMapping struct {
+	Key     string `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+	Address string `protobuf:"bytes,2,opt,name=address,proto3" json:"address,omitempty"`
+}
+
+func (m *AddressMapping) Reset()         { *m = AddressMapping{} }
+func (m *AddressMapping) String() string { return proto.CompactTextString(m) }
+func (*AddressMapping) ProtoMessage()    {}
+func (*AddressMapping) Descriptor() ([]byte, []int) {
+	return fileDescriptor_bf57cf100cbaf4bd, []int{2}
+}
+func (m *AddressMapping) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *AddressMapping) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_AddressMapping.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *AddressMapping) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AddressMapping.Merge(m, src)
+}
+func (m *AddressMapping) XXX_Size() int {
+	return m.Size()
+}
+func (m *AddressMapping) XXX_DiscardUnknown() {
+	xxx_messageInfo_AddressMapping.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AddressMapping proto.InternalMessageInfo
+
+func (m *AddressMapping) GetKey() string {
+	if m != nil {
+		return m.Key
+	}
+	return ""
+}
+
+func (m *AddressMapping) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
 type ValidatorSweepIndex struct {
 	NextValIndex    uint64 `protobuf:"varint,1,opt,name=next_val_index,json=nextValIndex,proto3" json:"next_val_index,omitempty" yaml:"next_val_index"`
 	NextValDelIndex uint64 `protobuf:"varint,2,opt,name=next_val_del_index,json=nextValDelIndex,proto3" json:"next_val_del_index,omitempty" yaml:"next_val_del_index"`
@@ -85,7 +280,7 @@ func (m *ValidatorSweepIndex) Reset()         { *m = ValidatorSweepIndex{} }
 func (m *ValidatorSweepIndex) String() string { return proto.CompactTextString(m) }
 func (*ValidatorSweepIndex) ProtoMessage()    {}
 func (*ValidatorSweepIndex) Descriptor() ([]byte, []int) {
-	return fileDescriptor_bf57cf100cbaf4bd, []int{1}
+	return fileDescriptor_bf57cf100cbaf4bd, []int{3}
 }
 func (m *ValidatorSweepIndex) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -130,6 +325,8 @@ func (m *ValidatorSweepIndex) GetNextValDelIndex() uint64 {
 
 func init() {
 	proto.RegisterType((*GenesisState)(nil), "client.x.evmstaking.types.GenesisState")
+	proto.RegisterType((*WithdrawalQueue)(nil), "client.x.evmstaking.types.WithdrawalQueue")
+	proto.RegisterType((*AddressMapping)(nil), "client.x.evmstaking.types.AddressMapping")
 	proto.RegisterType((*ValidatorSweepIndex)(nil), "client.x.evmstaking.types.ValidatorSweepIndex")
 }
 
@@ -138,27 +335,50 @@ func init() {
 }
 
 var fileDescriptor_bf57cf100cbaf4bd = []byte{
-	// 310 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x52, 0x4f, 0xce, 0xc9, 0x4c,
-	0xcd, 0x2b, 0xd1, 0xaf, 0xd0, 0x4f, 0x2d, 0xcb, 0x2d, 0x2e, 0x49, 0xcc, 0xce, 0xcc, 0x4b, 0xd7,
-	0x2f, 0xa9, 0x2c, 0x48, 0x2d, 0xd6, 0x4f, 0x4f, 0xcd, 0x4b, 0x2d, 0xce, 0x2c, 0xd6, 0x2b, 0x28,
-	0xca, 0x2f, 0xc9, 0x17, 0x92, 0x84, 0x28, 0xd4, 0xab, 0xd0, 0x43, 0x28, 0xd4, 0x03, 0x2b, 0x94,
-	0x12, 0x49, 0xcf, 0x4f, 0xcf, 0x07, 0xab, 0xd2, 0x07, 0xb1, 0x20, 0x1a, 0xa4, 0xd4, 0x70, 0x9b,
-	0x5c, 0x90, 0x58, 0x94, 0x98, 0x0b, 0x35, 0x58, 0x69, 0x27, 0x23, 0x17, 0x8f, 0x3b, 0xc4, 0xaa,
-	0xe0, 0x92, 0xc4, 0x92, 0x54, 0x21, 0x7b, 0x2e, 0x36, 0x88, 0x02, 0x09, 0x46, 0x05, 0x46, 0x0d,
-	0x6e, 0x23, 0x45, 0x3d, 0x9c, 0x56, 0xeb, 0x05, 0x80, 0x15, 0x3a, 0xb1, 0x9c, 0xb8, 0x27, 0xcf,
-	0x10, 0x04, 0xd5, 0x26, 0x94, 0xc1, 0x25, 0x5a, 0x96, 0x98, 0x93, 0x99, 0x92, 0x58, 0x92, 0x5f,
-	0x14, 0x5f, 0x5c, 0x9e, 0x9a, 0x5a, 0x10, 0x9f, 0x99, 0x97, 0x92, 0x5a, 0x21, 0xc1, 0x04, 0x36,
-	0x4f, 0x0f, 0x8f, 0x79, 0x61, 0x30, 0x7d, 0xc1, 0x20, 0x6d, 0x9e, 0x20, 0x5d, 0x50, 0xc3, 0x85,
-	0xcb, 0x30, 0xa5, 0x94, 0x16, 0x31, 0x72, 0x09, 0x63, 0xd1, 0x22, 0x64, 0xcf, 0xc5, 0x97, 0x97,
-	0x5a, 0x51, 0x12, 0x5f, 0x96, 0x98, 0x03, 0xb5, 0x1a, 0xe4, 0x15, 0x16, 0x27, 0xc9, 0x4f, 0xf7,
-	0xe4, 0x45, 0x2b, 0x13, 0x73, 0x73, 0xac, 0x94, 0x50, 0xe5, 0x95, 0x82, 0x78, 0x40, 0x02, 0x61,
-	0x89, 0x39, 0x10, 0x03, 0xbc, 0xb8, 0x84, 0xe0, 0x0a, 0x52, 0x52, 0x73, 0x90, 0xdc, 0xcf, 0xe2,
-	0x24, 0xfb, 0xe9, 0x9e, 0xbc, 0x24, 0x9a, 0x21, 0x70, 0x35, 0x4a, 0x41, 0xfc, 0x50, 0x83, 0x5c,
-	0x52, 0x21, 0x66, 0x39, 0x19, 0x9f, 0x78, 0x24, 0xc7, 0x78, 0xe1, 0x91, 0x1c, 0xe3, 0x83, 0x47,
-	0x72, 0x8c, 0x13, 0x1e, 0xcb, 0x31, 0x5c, 0x78, 0x2c, 0xc7, 0x70, 0xe3, 0xb1, 0x1c, 0x43, 0x94,
-	0x24, 0xce, 0x28, 0x4a, 0x62, 0x03, 0x47, 0x8e, 0x31, 0x20, 0x00, 0x00, 0xff, 0xff, 0xe2, 0xc3,
-	0x0a, 0x79, 0x20, 0x02, 0x00, 0x00,
+	// 677 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x95, 0xcb, 0x4e, 0xdb, 0x40,
+	0x14, 0x86, 0xe3, 0xc6, 0x5c, 0x72, 0x12, 0x08, 0x9d, 0x90, 0xd6, 0x89, 0x68, 0xa0, 0x91, 0x4a,
+	0x29, 0xaa, 0x1c, 0x09, 0x76, 0x55, 0x25, 0x44, 0x44, 0xa9, 0x40, 0x42, 0x2d, 0xa6, 0xa2, 0x52,
+	0xbb, 0xb0, 0x06, 0x7c, 0x12, 0x46, 0x38, 0xb6, 0xf1, 0x38, 0x17, 0x5e, 0xa1, 0xab, 0x3e, 0x43,
+	0x9f, 0x86, 0x25, 0xcb, 0xae, 0x50, 0x05, 0x7d, 0x02, 0x9e, 0xa0, 0xf2, 0xf8, 0x4a, 0x68, 0xa2,
+	0x96, 0xdd, 0x78, 0xce, 0xff, 0x7f, 0xff, 0xf1, 0xcc, 0x78, 0x0c, 0x2f, 0x8f, 0x4d, 0x86, 0x96,
+	0xd7, 0x18, 0x34, 0xb0, 0xd7, 0xe1, 0x1e, 0x3d, 0x65, 0x56, 0xbb, 0xe1, 0x9d, 0x3b, 0xc8, 0x1b,
+	0x6d, 0xb4, 0x90, 0x33, 0xae, 0x3a, 0xae, 0xed, 0xd9, 0xa4, 0x12, 0x08, 0xd5, 0x81, 0x9a, 0x08,
+	0x55, 0x21, 0xac, 0xce, 0xb7, 0xed, 0xb6, 0x2d, 0x54, 0x0d, 0x7f, 0x14, 0x18, 0xaa, 0xcb, 0xa3,
+	0xc9, 0x0e, 0x75, 0x69, 0x27, 0x04, 0x57, 0x57, 0x47, 0xeb, 0x52, 0x49, 0x42, 0x5b, 0xff, 0x3d,
+	0x0d, 0x85, 0xf7, 0x41, 0x5b, 0x07, 0x1e, 0xf5, 0x90, 0x6c, 0xc0, 0x64, 0x00, 0x53, 0xa4, 0x25,
+	0x69, 0x25, 0xbf, 0xf6, 0x5c, 0x1d, 0xd9, 0xa6, 0xfa, 0x51, 0x08, 0x9b, 0xf2, 0xc5, 0xd5, 0x62,
+	0x46, 0x0b, 0x6d, 0xe4, 0x04, 0xca, 0x3d, 0x6a, 0x32, 0x83, 0x7a, 0xb6, 0xab, 0xf3, 0x3e, 0xa2,
+	0xa3, 0x33, 0xcb, 0xc0, 0x81, 0xf2, 0x48, 0xf0, 0xd4, 0x31, 0xbc, 0xc3, 0xc8, 0x77, 0xe0, 0xdb,
+	0x76, 0x7c, 0x57, 0x08, 0x2f, 0xf5, 0xee, 0x97, 0xc8, 0x57, 0x98, 0xeb, 0x33, 0xef, 0xc4, 0x70,
+	0x69, 0x9f, 0x9a, 0xfa, 0x59, 0x17, 0xbb, 0xa8, 0x64, 0x45, 0xc8, 0xea, 0x98, 0x90, 0xcf, 0xb1,
+	0x65, 0xdf, 0x77, 0x84, 0x01, 0xc5, 0xfe, 0xdd, 0x69, 0x72, 0x02, 0x4f, 0x5d, 0xec, 0x53, 0xd7,
+	0xd0, 0xef, 0x65, 0xc8, 0x0f, 0xcc, 0x28, 0x07, 0xc0, 0xa1, 0x22, 0x59, 0x83, 0xb2, 0x85, 0x03,
+	0x2f, 0x9d, 0x13, 0x2c, 0xd8, 0xc4, 0x92, 0xb4, 0x22, 0x6b, 0x25, 0xbf, 0x98, 0x78, 0x82, 0x57,
+	0x3f, 0x83, 0x05, 0x03, 0x4d, 0x6c, 0x8b, 0x45, 0x8e, 0x8c, 0x3a, 0x35, 0x0c, 0x17, 0x39, 0x47,
+	0xae, 0x4c, 0x2e, 0x65, 0x57, 0xf2, 0x6b, 0xaf, 0xc6, 0xb4, 0xb8, 0x19, 0x68, 0xf7, 0xa8, 0xe3,
+	0x30, 0xab, 0x1d, 0x76, 0x58, 0x8d, 0xa1, 0x51, 0xe0, 0x66, 0x84, 0x24, 0x1d, 0x48, 0xaa, 0x7a,
+	0xb8, 0x34, 0x49, 0xe0, 0xd4, 0xc3, 0x02, 0x95, 0x18, 0xa9, 0x09, 0x62, 0x12, 0xc7, 0x40, 0x49,
+	0x8e, 0x51, 0x0b, 0x51, 0x77, 0xf1, 0x98, 0x39, 0x3e, 0x9c, 0x2b, 0xb9, 0x87, 0x85, 0x3d, 0x89,
+	0x81, 0xdb, 0x88, 0x5a, 0x8c, 0x23, 0x9f, 0xa0, 0xd8, 0x3d, 0x62, 0xa9, 0xf5, 0xe7, 0x0a, 0x88,
+	0x84, 0x17, 0xff, 0xb4, 0xc5, 0x21, 0x7d, 0xb6, 0x7b, 0xc4, 0x92, 0x49, 0x4e, 0xf6, 0x61, 0xa6,
+	0x45, 0x99, 0x89, 0x86, 0x8e, 0x3d, 0xd1, 0x75, 0x5e, 0x30, 0x97, 0xc7, 0x30, 0xb7, 0x85, 0xfe,
+	0x9d, 0x2f, 0x0f, 0xa1, 0x85, 0x56, 0x32, 0xc5, 0x49, 0x03, 0xe6, 0xc5, 0x49, 0x49, 0x73, 0x75,
+	0x66, 0x28, 0x05, 0x71, 0x50, 0x1e, 0xfb, 0xb5, 0x14, 0x62, 0xc7, 0x20, 0xc7, 0x50, 0x4a, 0xf6,
+	0xcc, 0x76, 0xd0, 0xf5, 0x07, 0x5c, 0x99, 0x11, 0x9d, 0xbc, 0x1e, 0xd3, 0xc9, 0x56, 0xe4, 0xfa,
+	0x10, 0x9a, 0xc2, 0x7e, 0x88, 0x31, 0x5c, 0xe0, 0xbb, 0xf2, 0xf4, 0xf4, 0x5c, 0x4e, 0x5b, 0xb8,
+	0x1f, 0x94, 0x1c, 0x8f, 0xfa, 0x37, 0x09, 0x8a, 0xc3, 0xe7, 0x7e, 0x1e, 0x26, 0x5a, 0xae, 0x6d,
+	0x79, 0xe2, 0xa2, 0x91, 0xb5, 0xe0, 0x81, 0x10, 0x90, 0x5d, 0xa4, 0xae, 0xb8, 0x2d, 0x64, 0x4d,
+	0x8c, 0xc9, 0x1e, 0xe4, 0xd3, 0x9b, 0x93, 0xfd, 0xff, 0xcd, 0x49, 0xfb, 0xeb, 0x6f, 0x61, 0xf6,
+	0xee, 0xf9, 0x20, 0x73, 0x90, 0x3d, 0xc5, 0x73, 0xd1, 0x48, 0x4e, 0xf3, 0x87, 0x44, 0x81, 0xa9,
+	0xb0, 0x7b, 0xd1, 0x49, 0x4e, 0x8b, 0x1e, 0xeb, 0x3f, 0x24, 0x28, 0xfd, 0xe5, 0xa2, 0x22, 0x1b,
+	0x30, 0x2b, 0x36, 0xa7, 0x17, 0x7f, 0xbf, 0xe2, 0xbd, 0x9a, 0x95, 0xdb, 0xab, 0xc5, 0xf2, 0x39,
+	0xed, 0x98, 0x6f, 0xea, 0x77, 0xeb, 0x75, 0xad, 0xe0, 0x4f, 0x1c, 0x46, 0xdf, 0xf4, 0x2e, 0x90,
+	0x58, 0x60, 0xa0, 0x99, 0xba, 0x35, 0xe5, 0xe6, 0xb3, 0xdb, 0xab, 0xc5, 0xca, 0x10, 0x24, 0xd6,
+	0xd4, 0xb5, 0x62, 0x08, 0xda, 0xc2, 0x80, 0xd5, 0x5c, 0xbf, 0xb8, 0xae, 0x49, 0x97, 0xd7, 0x35,
+	0xe9, 0xd7, 0x75, 0x4d, 0xfa, 0x7e, 0x53, 0xcb, 0x5c, 0xde, 0xd4, 0x32, 0x3f, 0x6f, 0x6a, 0x99,
+	0x2f, 0x95, 0x91, 0x3f, 0x87, 0xa3, 0x49, 0xf1, 0x4b, 0x58, 0xff, 0x33, 0x00, 0x1a, 0xeb, 0xb3,
+	0xd7, 0xc2, 0x06, 0x00, 0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -181,6 +401,120 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.DelegatorOperators) > 0 {
+		for iNdEx := len(m.DelegatorOperators) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.DelegatorOperators[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x6a
+		}
+	}
+	if m.NextFailedEventId != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.NextFailedEventId))
+		i--
+		dAtA[i] = 0x60
+	}
+	if len(m.FailedEvents) > 0 {
+		for iNdEx := len(m.FailedEvents) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.FailedEvents[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x5a
+		}
+	}
+	if len(m.UbiWithdrawals) > 0 {
+		for iNdEx := len(m.UbiWithdrawals) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.UbiWithdrawals[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x52
+		}
+	}
+	if len(m.ValidatorFeeRecipients) > 0 {
+		for iNdEx := len(m.ValidatorFeeRecipients) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ValidatorFeeRecipients[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x4a
+		}
+	}
+	if len(m.DelegatorRewardAddresses) > 0 {
+		for iNdEx := len(m.DelegatorRewardAddresses) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.DelegatorRewardAddresses[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x3a
+		}
+	}
+	if len(m.DelegatorWithdrawAddresses) > 0 {
+		for iNdEx := len(m.DelegatorWithdrawAddresses) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.DelegatorWithdrawAddresses[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x32
+		}
+	}
+	if m.NextWithdrawalIndex != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.NextWithdrawalIndex))
+		i--
+		dAtA[i] = 0x28
+	}
+	{
+		size, err := m.RewardWithdrawalQueue.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	{
+		size, err := m.WithdrawalQueue.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
 	{
 		size, err := m.ValidatorSweepIndex.MarshalToSizedBuffer(dAtA[:i])
 		if err != nil {
@@ -204,6 +538,90 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *WithdrawalQueue) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *WithdrawalQueue) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *WithdrawalQueue) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Withdrawals) > 0 {
+		for iNdEx := len(m.Withdrawals) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Withdrawals[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if m.Rear != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.Rear))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Front != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.Front))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *AddressMapping) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AddressMapping) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AddressMapping) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Key) > 0 {
+		i -= len(m.Key)
+		copy(dAtA[i:], m.Key)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.Key)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *ValidatorSweepIndex) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -258,6 +676,90 @@ func (m *GenesisState) Size() (n int) {
 	n += 1 + l + sovGenesis(uint64(l))
 	l = m.ValidatorSweepIndex.Size()
 	n += 1 + l + sovGenesis(uint64(l))
+	l = m.WithdrawalQueue.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	l = m.RewardWithdrawalQueue.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	if m.NextWithdrawalIndex != 0 {
+		n += 1 + sovGenesis(uint64(m.NextWithdrawalIndex))
+	}
+	if len(m.DelegatorWithdrawAddresses) > 0 {
+		for _, e := range m.DelegatorWithdrawAddresses {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.DelegatorRewardAddresses) > 0 {
+		for _, e := range m.DelegatorRewardAddresses {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.ValidatorFeeRecipients) > 0 {
+		for _, e := range m.ValidatorFeeRecipients {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.UbiWithdrawals) > 0 {
+		for _, e := range m.UbiWithdrawals {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.FailedEvents) > 0 {
+		for _, e := range m.FailedEvents {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if m.NextFailedEventId != 0 {
+		n += 1 + sovGenesis(uint64(m.NextFailedEventId))
+	}
+	if len(m.DelegatorOperators) > 0 {
+		for _, e := range m.DelegatorOperators {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *WithdrawalQueue) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Front != 0 {
+		n += 1 + sovGenesis(uint64(m.Front))
+	}
+	if m.Rear != 0 {
+		n += 1 + sovGenesis(uint64(m.Rear))
+	}
+	if len(m.Withdrawals) > 0 {
+		for _, e := range m.Withdrawals {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *AddressMapping) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Key)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
 	return n
 }
 
@@ -377,6 +879,550 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WithdrawalQueue", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.WithdrawalQueue.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RewardWithdrawalQueue", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.RewardWithdrawalQueue.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NextWithdrawalIndex", wireType)
+			}
+			m.NextWithdrawalIndex = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.NextWithdrawalIndex |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DelegatorWithdrawAddresses", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DelegatorWithdrawAddresses = append(m.DelegatorWithdrawAddresses, AddressMapping{})
+			if err := m.DelegatorWithdrawAddresses[len(m.DelegatorWithdrawAddresses)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DelegatorRewardAddresses", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DelegatorRewardAddresses = append(m.DelegatorRewardAddresses, AddressMapping{})
+			if err := m.DelegatorRewardAddresses[len(m.DelegatorRewardAddresses)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorFeeRecipients", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ValidatorFeeRecipients = append(m.ValidatorFeeRecipients, AddressMapping{})
+			if err := m.ValidatorFeeRecipients[len(m.ValidatorFeeRecipients)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UbiWithdrawals", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.UbiWithdrawals = append(m.UbiWithdrawals, Withdrawal{})
+			if err := m.UbiWithdrawals[len(m.UbiWithdrawals)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FailedEvents", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FailedEvents = append(m.FailedEvents, FailedEvent{})
+			if err := m.FailedEvents[len(m.FailedEvents)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 12:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NextFailedEventId", wireType)
+			}
+			m.NextFailedEventId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.NextFailedEventId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 13:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DelegatorOperators", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DelegatorOperators = append(m.DelegatorOperators, DelegatorOperator{})
+			if err := m.DelegatorOperators[len(m.DelegatorOperators)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *WithdrawalQueue) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: WithdrawalQueue: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: WithdrawalQueue: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Front", wireType)
+			}
+			m.Front = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Front |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Rear", wireType)
+			}
+			m.Rear = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Rear |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Withdrawals", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Withdrawals = append(m.Withdrawals, Withdrawal{})
+			if err := m.Withdrawals[len(m.Withdrawals)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *AddressMapping) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AddressMapping: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AddressMapping: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Key", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Key = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
@@ -11,6 +11,10 @@ const (
 
 	// RouterKey is the msg router key for the evmstaking module.
 	RouterKey = ModuleName
+
+	// EvmEventProcessorOrder is the delivery order of the staking EVM event processor, delivered before
+	// the evmengine contract processors (upgrade and UBI) so that the staking state of a block lands first.
+	EvmEventProcessorOrder uint32 = 10
 )
 
 // KVStore keys.
@@ -19,7 +23,15 @@ var (
 	ValidatorSweepIndexKey         = collections.NewPrefix(1)
 	DelegatorWithdrawAddressMapKey = collections.NewPrefix(2)
 	DelegatorRewardAddressMapKey   = collections.NewPrefix(3)
-	DelegatorOperatorAddressMapKey = collections.NewPrefix(4)
+	DelegatorOperatorAddressMapKey = collections.NewPrefix(4) // Deprecated: migrated to DelegatorOperatorsKey.
 	WithdrawalQueueKey             = collections.NewPrefix(5)
 	RewardWithdrawalQueueKey       = collections.NewPrefix(6)
+	NextWithdrawalIndexKey         = collections.NewPrefix(7)
+	ValidatorFeeRecipientMapKey    = collections.NewPrefix(8)
+	UbiWithdrawalsMapKey           = collections.NewPrefix(9)
+	FailedEventsMapKey             = collections.NewPrefix(10)
+	FailedEventsByDelegatorKey     = collections.NewPrefix(11)
+	FailedEventsByValidatorKey     = collections.NewPrefix(12)
+	NextFailedEventIDKey           = collections.NewPrefix(13)
+	DelegatorOperatorsKey          = collections.NewPrefix(14)
 )
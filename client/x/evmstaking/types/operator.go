@@ -0,0 +1,20 @@
+package types
+
+// OperatorPermission flags scope what an operator can do on behalf of a delegator.
+// The permissions of an operator are the bitmask of its flags.
+const (
+	// OperatorPermissionUnstake allows the operator to unstake (unstakeOnBehalf).
+	OperatorPermissionUnstake uint32 = 1 << iota
+	// OperatorPermissionRedelegate allows the operator to redelegate (redelegateOnBehalf).
+	OperatorPermissionRedelegate
+	// OperatorPermissionUnjail allows the operator to unjail the delegator's validator (unjailOnBehalf).
+	OperatorPermissionUnjail
+
+	// OperatorPermissionAll is the bitmask of all the operator permissions.
+	OperatorPermissionAll = OperatorPermissionUnstake | OperatorPermissionRedelegate | OperatorPermissionUnjail
+)
+
+// HasPermission returns true if the operator is granted the permission.
+func (o DelegatorOperator) HasPermission(permission uint32) bool {
+	return o.Permissions&permission == permission
+}
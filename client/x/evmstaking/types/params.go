@@ -11,6 +11,22 @@ const (
 	DefaultMaxSweepPerBlock uint32 = 1024
 
 	DefaultMinPartialWithdrawalAmount uint64 = 600_000
+
+	DefaultFailedEventRetention uint64 = 1_000_000
+
+	DefaultUbiWithdrawalRetention uint64 = 1_000_000
+
+	// MaxCommissionRate is the commission rate of 100% in bips.
+	MaxCommissionRate uint32 = 10_000
+)
+
+// IPTokenStaking contract params of the genesis alloc, see contracts/script/GenerateAlloc.s.sol.
+// The contract is initialized in the genesis alloc without emitting events to sync, so they are set in genesis.
+const (
+	GenesisMinStakeAmount    uint64 = 1024 * 1e9 // 1024 IP in gwei.
+	GenesisMinUnstakeAmount  uint64 = 1024 * 1e9 // 1024 IP in gwei.
+	GenesisMinCommissionRate uint32 = 5_00       // 5% in bips.
+	GenesisFee               uint64 = 1e9        // 1 IP in gwei.
 )
 
 // NewParams creates a new Params instance.
@@ -23,12 +39,21 @@ func NewParams(maxWithdrawalPerBlock uint32, maxSweepPerBlock uint32, minPartial
 }
 
 // DefaultParams returns a default set of parameters.
+// New chains record failed events and UBI withdrawals and sync the IPTokenStaking contract params from genesis,
+// existing chains from the v0.13.0 upgrade.
 func DefaultParams() Params {
-	return NewParams(
+	params := NewParams(
 		DefaultMaxWithdrawalPerBlock,
 		DefaultMaxSweepPerBlock,
 		DefaultMinPartialWithdrawalAmount,
 	)
+	params.FailedEventRetention = DefaultFailedEventRetention
+	params.RecordFailedEvents = true
+	params.RecordUbiWithdrawals = true
+	params.UbiWithdrawalRetention = DefaultUbiWithdrawalRetention
+	params.SyncContractParams = true
+
+	return params
 }
 
 func (p Params) Validate() error {
@@ -40,7 +65,11 @@ func (p Params) Validate() error {
 		return err
 	}
 
-	return ValidateMinPartialWithdrawalAmount(p.MinPartialWithdrawalAmount)
+	if err := ValidateMinPartialWithdrawalAmount(p.MinPartialWithdrawalAmount); err != nil {
+		return err
+	}
+
+	return ValidateMinCommissionRate(p.MinCommissionRate)
 }
 
 func ValidateMaxWithdrawalPerBlock(v uint32) error {
@@ -70,3 +99,11 @@ func ValidateMinPartialWithdrawalAmount(v uint64) error {
 
 	return nil
 }
+
+func ValidateMinCommissionRate(v uint32) error {
+	if v > MaxCommissionRate {
+		return fmt.Errorf("min commission rate must be less than or equal to %d bips: %d", MaxCommissionRate, v)
+	}
+
+	return nil
+}
@@ -29,6 +29,27 @@ type Params struct {
 	MaxSweepPerBlock           uint32 `protobuf:"varint,2,opt,name=max_sweep_per_block,json=maxSweepPerBlock,proto3" json:"max_sweep_per_block,omitempty" yaml:"max_sweep_per_block"`
 	MinPartialWithdrawalAmount uint64 `protobuf:"varint,3,opt,name=min_partial_withdrawal_amount,json=minPartialWithdrawalAmount,proto3" json:"min_partial_withdrawal_amount,omitempty" yaml:"min_partial_withdrawal_amount"`
 	UbiWithdrawAddress         string `protobuf:"bytes,4,opt,name=ubi_withdraw_address,json=ubiWithdrawAddress,proto3" json:"ubi_withdraw_address,omitempty" yaml:"ubi_withdraw_address"`
+	// Number of consensus chain heights for which failed EVM events are kept, 0 keeps all.
+	FailedEventRetention uint64 `protobuf:"varint,5,opt,name=failed_event_retention,json=failedEventRetention,proto3" json:"failed_event_retention,omitempty" yaml:"failed_event_retention"`
+	// Min stake amount in gwei of the IPTokenStaking contract, synced from its MinStakeAmountSet event.
+	MinStakeAmount uint64 `protobuf:"varint,6,opt,name=min_stake_amount,json=minStakeAmount,proto3" json:"min_stake_amount,omitempty" yaml:"min_stake_amount"`
+	// Min unstake amount in gwei of the IPTokenStaking contract, synced from its MinUnstakeAmountSet event.
+	MinUnstakeAmount uint64 `protobuf:"varint,7,opt,name=min_unstake_amount,json=minUnstakeAmount,proto3" json:"min_unstake_amount,omitempty" yaml:"min_unstake_amount"`
+	// Min commission rate in bips of the IPTokenStaking contract, synced from its MinCommissionRateChanged event.
+	MinCommissionRate uint32 `protobuf:"varint,8,opt,name=min_commission_rate,json=minCommissionRate,proto3" json:"min_commission_rate,omitempty" yaml:"min_commission_rate"`
+	// Fee in gwei charged by the IPTokenStaking contract, synced from its FeeSet event.
+	Fee uint64 `protobuf:"varint,9,opt,name=fee,proto3" json:"fee,omitempty" yaml:"fee"`
+	// Whether EVM events that failed to be processed are recorded for auditing.
+	// Disabled on existing chains until the v0.13.0 upgrade, since recording writes new state in block finalization.
+	RecordFailedEvents bool `protobuf:"varint,10,opt,name=record_failed_events,json=recordFailedEvents,proto3" json:"record_failed_events,omitempty" yaml:"record_failed_events"`
+	// Whether UBI withdrawals are recorded in the UBI withdrawal history.
+	// Disabled on existing chains until the v0.13.0 upgrade, since recording writes new state in block finalization.
+	RecordUbiWithdrawals bool `protobuf:"varint,11,opt,name=record_ubi_withdrawals,json=recordUbiWithdrawals,proto3" json:"record_ubi_withdrawals,omitempty" yaml:"record_ubi_withdrawals"`
+	// Number of consensus chain heights for which UBI withdrawals are kept in the history, 0 keeps all.
+	UbiWithdrawalRetention uint64 `protobuf:"varint,12,opt,name=ubi_withdrawal_retention,json=ubiWithdrawalRetention,proto3" json:"ubi_withdrawal_retention,omitempty" yaml:"ubi_withdrawal_retention"`
+	// Whether the params of the IPTokenStaking contract are synced from its events and enforced.
+	// Disabled on existing chains until the v0.13.0 upgrade seeds them with the params of the genesis alloc.
+	SyncContractParams bool `protobuf:"varint,13,opt,name=sync_contract_params,json=syncContractParams,proto3" json:"sync_contract_params,omitempty" yaml:"sync_contract_params"`
 }
 
 func (m *Params) Reset()         { *m = Params{} }
@@ -92,6 +113,69 @@ func (m *Params) GetUbiWithdrawAddress() string {
 	return ""
 }
 
+func (m *Params) GetFailedEventRetention() uint64 {
+	if m != nil {
+		return m.FailedEventRetention
+	}
+	return 0
+}
+
+func (m *Params) GetMinStakeAmount() uint64 {
+	if m != nil {
+		return m.MinStakeAmount
+	}
+	return 0
+}
+
+func (m *Params) GetMinUnstakeAmount() uint64 {
+	if m != nil {
+		return m.MinUnstakeAmount
+	}
+	return 0
+}
+
+func (m *Params) GetMinCommissionRate() uint32 {
+	if m != nil {
+		return m.MinCommissionRate
+	}
+	return 0
+}
+
+func (m *Params) GetFee() uint64 {
+	if m != nil {
+		return m.Fee
+	}
+	return 0
+}
+
+func (m *Params) GetRecordFailedEvents() bool {
+	if m != nil {
+		return m.RecordFailedEvents
+	}
+	return false
+}
+
+func (m *Params) GetRecordUbiWithdrawals() bool {
+	if m != nil {
+		return m.RecordUbiWithdrawals
+	}
+	return false
+}
+
+func (m *Params) GetUbiWithdrawalRetention() uint64 {
+	if m != nil {
+		return m.UbiWithdrawalRetention
+	}
+	return 0
+}
+
+func (m *Params) GetSyncContractParams() bool {
+	if m != nil {
+		return m.SyncContractParams
+	}
+	return false
+}
+
 func init() {
 	proto.RegisterType((*Params)(nil), "client.x.evmstaking.types.Params")
 }
@@ -101,28 +185,44 @@ func init() {
 }
 
 var fileDescriptor_dddf03d6f1b350f8 = []byte{
-	// 328 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x7c, 0x91, 0xc1, 0x4a, 0xeb, 0x40,
-	0x14, 0x86, 0x3b, 0xbd, 0xa5, 0x70, 0x03, 0x82, 0xc4, 0x0a, 0x69, 0xc5, 0x49, 0x89, 0x22, 0x59,
-	0x25, 0x8b, 0xee, 0xdc, 0x35, 0x7b, 0xa1, 0xc6, 0x85, 0x20, 0x42, 0x38, 0x69, 0x87, 0x3a, 0x34,
-	0x93, 0x0c, 0x33, 0x53, 0x9b, 0xbe, 0x85, 0x8f, 0xe5, 0xb2, 0x4b, 0x57, 0x41, 0xda, 0x37, 0x08,
-	0xb8, 0x97, 0x4c, 0xb4, 0x2d, 0x62, 0xdd, 0x0d, 0xe7, 0xff, 0xfe, 0x6f, 0x0e, 0x1c, 0xe3, 0x6a,
-	0x9c, 0x50, 0x92, 0x2a, 0x3f, 0xf7, 0xc9, 0x33, 0x93, 0x0a, 0x66, 0x34, 0x9d, 0xfa, 0x6a, 0xc9,
-	0x89, 0xf4, 0x39, 0x08, 0x60, 0xd2, 0xe3, 0x22, 0x53, 0x99, 0xd9, 0xad, 0x39, 0x2f, 0xf7, 0x76,
-	0x9c, 0xa7, 0xb9, 0x5e, 0x67, 0x9a, 0x4d, 0x33, 0x4d, 0xf9, 0xd5, 0xab, 0x2e, 0x38, 0x1f, 0x4d,
-	0xa3, 0x3d, 0xd2, 0x06, 0xf3, 0xd1, 0xb0, 0x18, 0xe4, 0xd1, 0x82, 0xaa, 0xa7, 0x89, 0x80, 0x05,
-	0x24, 0x11, 0x27, 0x22, 0x8a, 0x93, 0x6c, 0x3c, 0xb3, 0x50, 0x1f, 0xb9, 0x47, 0xc1, 0x45, 0x59,
-	0xd8, 0xf6, 0x12, 0x58, 0x72, 0xed, 0x1c, 0x22, 0x9d, 0xf0, 0x94, 0x41, 0x7e, 0xbf, 0x4d, 0x46,
-	0x44, 0x04, 0xd5, 0xdc, 0xbc, 0x31, 0x4e, 0xaa, 0x8e, 0x5c, 0x10, 0xc2, 0xf7, 0xc4, 0x4d, 0x2d,
-	0xc6, 0x65, 0x61, 0xf7, 0x76, 0xe2, 0x1f, 0x90, 0x13, 0x1e, 0x33, 0xc8, 0xef, 0xaa, 0xe1, 0x56,
-	0x37, 0x33, 0xce, 0x19, 0x4d, 0x23, 0x0e, 0x42, 0x51, 0x48, 0xf6, 0x57, 0x01, 0x96, 0xcd, 0x53,
-	0x65, 0xfd, 0xeb, 0x23, 0xb7, 0x15, 0xb8, 0x65, 0x61, 0x5f, 0x7e, 0x89, 0xff, 0xc2, 0x9d, 0xb0,
-	0xc7, 0x68, 0x3a, 0xaa, 0xe3, 0xdd, 0xf6, 0x43, 0x1d, 0x9a, 0xb7, 0x46, 0x67, 0x1e, 0xd3, 0x6d,
-	0x2b, 0x82, 0xc9, 0x44, 0x10, 0x29, 0xad, 0x56, 0x1f, 0xb9, 0xff, 0x03, 0xbb, 0x2c, 0xec, 0xb3,
-	0xfa, 0x8f, 0xdf, 0x28, 0x27, 0x34, 0xe7, 0x31, 0xfd, 0x76, 0x0e, 0xeb, 0x61, 0x30, 0x78, 0x5d,
-	0x63, 0xb4, 0x5a, 0x63, 0xf4, 0xbe, 0xc6, 0xe8, 0x65, 0x83, 0x1b, 0xab, 0x0d, 0x6e, 0xbc, 0x6d,
-	0x70, 0xe3, 0xa1, 0x7b, 0xf0, 0xd4, 0x71, 0x5b, 0xdf, 0x6c, 0xf0, 0x19, 0x00, 0x00, 0xff, 0xff,
-	0x20, 0x22, 0x08, 0xef, 0x0e, 0x02, 0x00, 0x00,
+	// 585 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x7c, 0x94, 0xc1, 0x4e, 0xdb, 0x30,
+	0x18, 0xc7, 0xc9, 0x60, 0x0c, 0xbc, 0x81, 0x98, 0xe9, 0x58, 0x00, 0x91, 0x74, 0xde, 0x34, 0xf5,
+	0xd4, 0x1e, 0xb8, 0xed, 0x46, 0x11, 0xbb, 0x4c, 0x9b, 0xc0, 0x08, 0x21, 0x4d, 0x9b, 0x22, 0x37,
+	0x35, 0xcc, 0x6a, 0xec, 0x44, 0xb6, 0x4b, 0xdb, 0xb7, 0xd8, 0xb3, 0xec, 0x29, 0x76, 0xe4, 0xb8,
+	0x53, 0x34, 0xb5, 0x6f, 0x90, 0x27, 0x98, 0xec, 0xa4, 0x4d, 0x0a, 0xed, 0x6e, 0xd5, 0xff, 0xfb,
+	0xf9, 0xdf, 0xcf, 0xdf, 0xdf, 0xf9, 0xc0, 0xfb, 0x30, 0x62, 0x54, 0xe8, 0xd6, 0xb0, 0x45, 0xef,
+	0xb8, 0xd2, 0xa4, 0xc7, 0xc4, 0x6d, 0x4b, 0x8f, 0x12, 0xaa, 0x5a, 0x09, 0x91, 0x84, 0xab, 0x66,
+	0x22, 0x63, 0x1d, 0xc3, 0xfd, 0x9c, 0x6b, 0x0e, 0x9b, 0x25, 0xd7, 0xb4, 0xdc, 0x41, 0xed, 0x36,
+	0xbe, 0x8d, 0x2d, 0xd5, 0x32, 0xbf, 0xf2, 0x03, 0xe8, 0xd7, 0x06, 0x58, 0x3f, 0xb7, 0x0e, 0xf0,
+	0x1b, 0x70, 0x39, 0x19, 0x06, 0x03, 0xa6, 0x7f, 0x74, 0x25, 0x19, 0x90, 0x28, 0x48, 0xa8, 0x0c,
+	0x3a, 0x51, 0x1c, 0xf6, 0x5c, 0xa7, 0xee, 0x34, 0xb6, 0xda, 0x6f, 0xb3, 0xd4, 0xf7, 0x47, 0x84,
+	0x47, 0x1f, 0xd0, 0x32, 0x12, 0xe1, 0x57, 0x9c, 0x0c, 0xaf, 0x67, 0x95, 0x73, 0x2a, 0xdb, 0x46,
+	0x87, 0x9f, 0xc1, 0xae, 0x39, 0xa3, 0x06, 0x94, 0x26, 0x15, 0xe3, 0x27, 0xd6, 0xd8, 0xcb, 0x52,
+	0xff, 0xa0, 0x34, 0x7e, 0x00, 0x21, 0xbc, 0xc3, 0xc9, 0xf0, 0xd2, 0x88, 0x33, 0xbb, 0x1e, 0x38,
+	0xe2, 0x4c, 0x04, 0x09, 0x91, 0x9a, 0x91, 0xa8, 0xda, 0x0a, 0xe1, 0x71, 0x5f, 0x68, 0x77, 0xb5,
+	0xee, 0x34, 0xd6, 0xda, 0x8d, 0x2c, 0xf5, 0xdf, 0x15, 0xc6, 0xff, 0xc3, 0x11, 0x3e, 0xe0, 0x4c,
+	0x9c, 0xe7, 0xe5, 0xb2, 0xfb, 0x13, 0x5b, 0x84, 0x17, 0xa0, 0xd6, 0xef, 0xb0, 0xd9, 0xa9, 0x80,
+	0x74, 0xbb, 0x92, 0x2a, 0xe5, 0xae, 0xd5, 0x9d, 0xc6, 0x66, 0xdb, 0xcf, 0x52, 0xff, 0x30, 0xff,
+	0x8f, 0x45, 0x14, 0xc2, 0xb0, 0xdf, 0x61, 0x53, 0xcf, 0x93, 0x5c, 0x84, 0xd7, 0x60, 0xef, 0x86,
+	0xb0, 0x88, 0x76, 0x03, 0x7a, 0x47, 0x85, 0x0e, 0x24, 0xd5, 0x54, 0x68, 0x16, 0x0b, 0xf7, 0xa9,
+	0x6d, 0xfc, 0x4d, 0x96, 0xfa, 0x47, 0xb9, 0xe9, 0x62, 0x0e, 0xe1, 0x5a, 0x5e, 0x38, 0x33, 0x3a,
+	0x9e, 0xca, 0xf0, 0x0c, 0xec, 0x98, 0x9b, 0x9a, 0xec, 0xe9, 0x74, 0x16, 0xeb, 0xd6, 0xf2, 0x30,
+	0x4b, 0xfd, 0xd7, 0xe5, 0x2c, 0xaa, 0x04, 0xc2, 0xdb, 0x9c, 0x89, 0x4b, 0xa3, 0x14, 0x57, 0xfe,
+	0x04, 0xa0, 0x81, 0xfa, 0x62, 0xce, 0xe8, 0x99, 0x35, 0x3a, 0xca, 0x52, 0x7f, 0xbf, 0x34, 0x9a,
+	0x67, 0x4c, 0x58, 0x4c, 0x5c, 0x09, 0x55, 0x31, 0xfb, 0x02, 0x76, 0x0d, 0x18, 0xc6, 0x9c, 0x33,
+	0xa5, 0x58, 0x2c, 0x02, 0x49, 0x34, 0x75, 0x37, 0x1e, 0x65, 0xff, 0x18, 0x42, 0xf8, 0x25, 0x67,
+	0xe2, 0x74, 0x26, 0x62, 0xa2, 0x29, 0xac, 0x83, 0xd5, 0x1b, 0x4a, 0xdd, 0x4d, 0xdb, 0xcd, 0x76,
+	0x96, 0xfa, 0xa0, 0x98, 0x14, 0xa5, 0x08, 0x9b, 0x92, 0x49, 0x4c, 0xd2, 0x30, 0x96, 0xdd, 0xa0,
+	0x3a, 0x3d, 0xe5, 0x82, 0xba, 0xd3, 0xd8, 0xa8, 0x26, 0xb6, 0x88, 0x42, 0x18, 0xe6, 0xf2, 0xc7,
+	0x72, 0xc0, 0x36, 0xb1, 0x02, 0xae, 0xa6, 0x4c, 0x22, 0xe5, 0x3e, 0xb7, 0xa6, 0x95, 0xc4, 0x16,
+	0x73, 0x08, 0x17, 0x3d, 0x5d, 0x95, 0xcf, 0x81, 0x44, 0x0a, 0x7e, 0x07, 0xee, 0x3c, 0x59, 0x79,
+	0x0c, 0x2f, 0xec, 0x15, 0x2b, 0xdf, 0xdd, 0x32, 0x12, 0xe1, 0xbd, 0x7e, 0xd5, 0xb6, 0x7c, 0x10,
+	0x17, 0xa0, 0xa6, 0x46, 0x22, 0x0c, 0xc2, 0x58, 0x68, 0x49, 0x42, 0x1d, 0xe4, 0x0b, 0xc3, 0xdd,
+	0x7a, 0x38, 0x8a, 0x45, 0x14, 0xc2, 0xd0, 0xc8, 0xa7, 0x85, 0x9a, 0x6f, 0x8a, 0xf6, 0xf1, 0xef,
+	0xb1, 0xe7, 0xdc, 0x8f, 0x3d, 0xe7, 0xef, 0xd8, 0x73, 0x7e, 0x4e, 0xbc, 0x95, 0xfb, 0x89, 0xb7,
+	0xf2, 0x67, 0xe2, 0xad, 0x7c, 0xdd, 0x5f, 0xba, 0xa7, 0x3a, 0xeb, 0x76, 0xe1, 0x1c, 0xff, 0x1b,
+	0x00, 0xf5, 0x90, 0xe2, 0xf1, 0xcb, 0x04, 0x00, 0x00,
 }
 
 func (m *Params) Marshal() (dAtA []byte, err error) {
@@ -145,6 +245,66 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.SyncContractParams {
+		i--
+		if m.SyncContractParams {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x68
+	}
+	if m.UbiWithdrawalRetention != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.UbiWithdrawalRetention))
+		i--
+		dAtA[i] = 0x60
+	}
+	if m.RecordUbiWithdrawals {
+		i--
+		if m.RecordUbiWithdrawals {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x58
+	}
+	if m.RecordFailedEvents {
+		i--
+		if m.RecordFailedEvents {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x50
+	}
+	if m.Fee != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.Fee))
+		i--
+		dAtA[i] = 0x48
+	}
+	if m.MinCommissionRate != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.MinCommissionRate))
+		i--
+		dAtA[i] = 0x40
+	}
+	if m.MinUnstakeAmount != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.MinUnstakeAmount))
+		i--
+		dAtA[i] = 0x38
+	}
+	if m.MinStakeAmount != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.MinStakeAmount))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.FailedEventRetention != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.FailedEventRetention))
+		i--
+		dAtA[i] = 0x28
+	}
 	if len(m.UbiWithdrawAddress) > 0 {
 		i -= len(m.UbiWithdrawAddress)
 		copy(dAtA[i:], m.UbiWithdrawAddress)
@@ -200,6 +360,33 @@ func (m *Params) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovParams(uint64(l))
 	}
+	if m.FailedEventRetention != 0 {
+		n += 1 + sovParams(uint64(m.FailedEventRetention))
+	}
+	if m.MinStakeAmount != 0 {
+		n += 1 + sovParams(uint64(m.MinStakeAmount))
+	}
+	if m.MinUnstakeAmount != 0 {
+		n += 1 + sovParams(uint64(m.MinUnstakeAmount))
+	}
+	if m.MinCommissionRate != 0 {
+		n += 1 + sovParams(uint64(m.MinCommissionRate))
+	}
+	if m.Fee != 0 {
+		n += 1 + sovParams(uint64(m.Fee))
+	}
+	if m.RecordFailedEvents {
+		n += 2
+	}
+	if m.RecordUbiWithdrawals {
+		n += 2
+	}
+	if m.UbiWithdrawalRetention != 0 {
+		n += 1 + sovParams(uint64(m.UbiWithdrawalRetention))
+	}
+	if m.SyncContractParams {
+		n += 2
+	}
 	return n
 }
 
@@ -327,6 +514,180 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 			}
 			m.UbiWithdrawAddress = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FailedEventRetention", wireType)
+			}
+			m.FailedEventRetention = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.FailedEventRetention |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinStakeAmount", wireType)
+			}
+			m.MinStakeAmount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MinStakeAmount |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinUnstakeAmount", wireType)
+			}
+			m.MinUnstakeAmount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MinUnstakeAmount |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinCommissionRate", wireType)
+			}
+			m.MinCommissionRate = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MinCommissionRate |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Fee", wireType)
+			}
+			m.Fee = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Fee |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RecordFailedEvents", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.RecordFailedEvents = bool(v != 0)
+		case 11:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RecordUbiWithdrawals", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.RecordUbiWithdrawals = bool(v != 0)
+		case 12:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UbiWithdrawalRetention", wireType)
+			}
+			m.UbiWithdrawalRetention = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.UbiWithdrawalRetention |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 13:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SyncContractParams", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.SyncContractParams = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipParams(dAtA[iNdEx:])
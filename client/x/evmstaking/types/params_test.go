@@ -35,6 +35,10 @@ func (suite *ParamsTestSuite) TestDefaultParams() {
 	require.Equal(types.DefaultMaxWithdrawalPerBlock, params.MaxWithdrawalPerBlock)
 	require.Equal(types.DefaultMaxSweepPerBlock, params.MaxSweepPerBlock)
 	require.Equal(types.DefaultMinPartialWithdrawalAmount, params.MinPartialWithdrawalAmount)
+	require.True(params.RecordFailedEvents)
+	require.True(params.RecordUbiWithdrawals)
+	require.Equal(types.DefaultUbiWithdrawalRetention, params.UbiWithdrawalRetention)
+	require.True(params.SyncContractParams)
 }
 
 func (suite *ParamsTestSuite) TestValidateMaxWithdrawalPerBlock() {
@@ -147,6 +151,42 @@ func (suite *ParamsTestSuite) TestValidateMinPartialWithdrawatAmount() {
 	}
 }
 
+func (suite *ParamsTestSuite) TestValidateMinCommissionRate() {
+	require := suite.Require()
+
+	tcs := []struct {
+		name        string
+		input       uint32
+		expectedErr string
+	}{
+		{
+			name:  "valid value",
+			input: 0,
+		},
+		{
+			name:  "valid value",
+			input: 10_000,
+		},
+		{
+			name:        "invalid value",
+			input:       10_001,
+			expectedErr: "min commission rate must be less than or equal to 10000 bips: 10001",
+		},
+	}
+
+	for _, tc := range tcs {
+		suite.Run(tc.name, func() {
+			err := types.ValidateMinCommissionRate(tc.input)
+			if tc.expectedErr == "" {
+				require.NoError(err)
+			} else {
+				require.Error(err)
+				require.Contains(err.Error(), tc.expectedErr)
+			}
+		})
+	}
+}
+
 func TestParamsTestSuite(t *testing.T) {
 	t.Parallel()
 	suite.Run(t, new(ParamsTestSuite))
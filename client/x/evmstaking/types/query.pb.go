@@ -115,6 +115,8 @@ func (m *QueryParamsResponse) GetParams() Params {
 // QueryGetWithdrawalQueueRequest is the request type for the Query/WithdrawalQueue RPC method.
 type QueryGetWithdrawalQueueRequest struct {
 	Pagination *query.PageRequest `protobuf:"bytes,1,opt,name=pagination,proto3" json:"pagination,omitempty"`
+	// evm_address optionally filters the pending withdrawals to this execution address.
+	EvmAddress string `protobuf:"bytes,2,opt,name=evm_address,json=evmAddress,proto3" json:"evm_address,omitempty"`
 }
 
 func (m *QueryGetWithdrawalQueueRequest) Reset()         { *m = QueryGetWithdrawalQueueRequest{} }
@@ -157,6 +159,13 @@ func (m *QueryGetWithdrawalQueueRequest) GetPagination() *query.PageRequest {
 	return nil
 }
 
+func (m *QueryGetWithdrawalQueueRequest) GetEvmAddress() string {
+	if m != nil {
+		return m.EvmAddress
+	}
+	return ""
+}
+
 // QueryGetWithdrawalQueueResponse is the response type for the Query/WithdrawalQueue RPC method.
 type QueryGetWithdrawalQueueResponse struct {
 	Withdrawals []*Withdrawal `protobuf:"bytes,1,rep,name=withdrawals,proto3" json:"withdrawals,omitempty"`
@@ -211,379 +220,3520 @@ func (m *QueryGetWithdrawalQueueResponse) GetPagination() *query.PageResponse {
 	return nil
 }
 
-func init() {
-	proto.RegisterType((*QueryParamsRequest)(nil), "client.x.evmstaking.types.QueryParamsRequest")
-	proto.RegisterType((*QueryParamsResponse)(nil), "client.x.evmstaking.types.QueryParamsResponse")
-	proto.RegisterType((*QueryGetWithdrawalQueueRequest)(nil), "client.x.evmstaking.types.QueryGetWithdrawalQueueRequest")
-	proto.RegisterType((*QueryGetWithdrawalQueueResponse)(nil), "client.x.evmstaking.types.QueryGetWithdrawalQueueResponse")
+// QueryGetRewardWithdrawalQueueRequest is the request type for the Query/GetRewardWithdrawalQueue RPC method.
+type QueryGetRewardWithdrawalQueueRequest struct {
+	Pagination *query.PageRequest `protobuf:"bytes,1,opt,name=pagination,proto3" json:"pagination,omitempty"`
+	// evm_address optionally filters the pending reward withdrawals to this execution address.
+	EvmAddress string `protobuf:"bytes,2,opt,name=evm_address,json=evmAddress,proto3" json:"evm_address,omitempty"`
 }
 
-func init() {
-	proto.RegisterFile("client/x/evmstaking/types/query.proto", fileDescriptor_e9d6f66d5e677280)
+func (m *QueryGetRewardWithdrawalQueueRequest) Reset()         { *m = QueryGetRewardWithdrawalQueueRequest{} }
+func (m *QueryGetRewardWithdrawalQueueRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryGetRewardWithdrawalQueueRequest) ProtoMessage()    {}
+func (*QueryGetRewardWithdrawalQueueRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e9d6f66d5e677280, []int{4}
 }
-
-var fileDescriptor_e9d6f66d5e677280 = []byte{
-	// 439 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x93, 0xbf, 0xae, 0xd3, 0x30,
-	0x14, 0xc6, 0xe3, 0x02, 0x1d, 0xdc, 0xcd, 0xdc, 0x81, 0x1b, 0x55, 0x2e, 0x44, 0xb4, 0x54, 0x48,
-	0xd8, 0x6a, 0x3b, 0xc1, 0x82, 0xd4, 0x81, 0xae, 0x6d, 0x06, 0x90, 0x58, 0x90, 0x5b, 0xac, 0x34,
-	0xa2, 0x8d, 0xd3, 0xd8, 0xe9, 0x9f, 0x95, 0x1d, 0x09, 0x89, 0x47, 0x61, 0xe4, 0x05, 0x3a, 0x56,
-	0x42, 0x48, 0x4c, 0x08, 0xb5, 0x3c, 0x08, 0x8a, 0x6d, 0x48, 0xaa, 0x92, 0x16, 0xdd, 0x2d, 0x8a,
-	0xbf, 0xef, 0x7c, 0xbf, 0x73, 0x8e, 0x0d, 0x9b, 0x93, 0x59, 0xc8, 0x23, 0x45, 0xd7, 0x94, 0x2f,
-	0xe7, 0x52, 0xb1, 0x77, 0x61, 0x14, 0x50, 0xb5, 0x89, 0xb9, 0xa4, 0x8b, 0x94, 0x27, 0x1b, 0x12,
-	0x27, 0x42, 0x09, 0x74, 0x6d, 0x64, 0x64, 0x4d, 0x72, 0x19, 0xd1, 0x32, 0xf7, 0x2a, 0x10, 0x81,
-	0xd0, 0x2a, 0x9a, 0x7d, 0x19, 0x83, 0x5b, 0x0f, 0x84, 0x08, 0x66, 0x9c, 0xb2, 0x38, 0xa4, 0x2c,
-	0x8a, 0x84, 0x62, 0x2a, 0x14, 0x91, 0xb4, 0xa7, 0x8f, 0x27, 0x42, 0xce, 0x85, 0xa4, 0x63, 0x26,
-	0xb9, 0xc9, 0xa1, 0xcb, 0xce, 0x98, 0x2b, 0xd6, 0xa1, 0x31, 0x0b, 0xc2, 0x48, 0x8b, 0xad, 0xb6,
-	0x55, 0x4e, 0x18, 0xb3, 0x84, 0xcd, 0xf3, 0x9a, 0xa5, 0xba, 0x02, 0xb3, 0xd6, 0x7a, 0x57, 0x10,
-	0x8d, 0xb2, 0xd4, 0xa1, 0x2e, 0xe0, 0xf3, 0x45, 0xca, 0xa5, 0xf2, 0x5e, 0xc2, 0xbb, 0x47, 0x7f,
-	0x65, 0x2c, 0x22, 0xc9, 0xd1, 0x73, 0x58, 0x35, 0x41, 0xf7, 0xc0, 0x7d, 0xd0, 0xae, 0x75, 0x1f,
-	0x90, 0xd2, 0x61, 0x10, 0x63, 0xed, 0xdf, 0xde, 0xfe, 0x68, 0x38, 0xbe, 0xb5, 0x79, 0x53, 0x88,
-	0x75, 0xdd, 0x01, 0x57, 0xaf, 0x42, 0x35, 0x7d, 0x9b, 0xb0, 0x15, 0x9b, 0x8d, 0x52, 0x9e, 0x72,
-	0x9b, 0x8c, 0x5e, 0x40, 0x98, 0xf7, 0x6d, 0x63, 0x5a, 0xc4, 0x0c, 0x89, 0x64, 0x43, 0x22, 0x66,
-	0x19, 0x76, 0x48, 0x64, 0xc8, 0x82, 0x3f, 0x5e, 0xbf, 0xe0, 0xf4, 0x3e, 0x03, 0xd8, 0x28, 0x8d,
-	0xb2, 0xed, 0x0c, 0x60, 0x6d, 0xf5, 0xf7, 0x28, 0xeb, 0xe9, 0x56, 0xbb, 0xd6, 0x6d, 0x9e, 0xe9,
-	0x29, 0x2f, 0xe4, 0x17, 0x9d, 0x68, 0x70, 0x04, 0x5d, 0xd1, 0xd0, 0x8f, 0x2e, 0x42, 0x1b, 0x8a,
-	0x22, 0x75, 0xf7, 0x5b, 0x05, 0xde, 0xd1, 0xd4, 0xe8, 0x03, 0x80, 0x55, 0x33, 0x42, 0xf4, 0xe4,
-	0x0c, 0xd1, 0xe9, 0xee, 0x5c, 0xf2, 0xbf, 0x72, 0x93, 0xef, 0x3d, 0x7c, 0xff, 0xf5, 0xd7, 0xa7,
-	0x0a, 0x46, 0x75, 0x6a, 0xaf, 0x4d, 0xe1, 0xd2, 0x2c, 0x3b, 0xf6, 0x66, 0xa1, 0x2f, 0x00, 0xa2,
-	0xd3, 0x51, 0xa2, 0xa7, 0x97, 0xc2, 0x4a, 0x37, 0xed, 0x3e, 0xbb, 0x89, 0xd5, 0x32, 0x13, 0xcd,
-	0xdc, 0x46, 0xad, 0x7f, 0x33, 0xe7, 0xbb, 0x79, 0xb3, 0xc8, 0x7c, 0xfd, 0xde, 0x76, 0x8f, 0xc1,
-	0x6e, 0x8f, 0xc1, 0xcf, 0x3d, 0x06, 0x1f, 0x0f, 0xd8, 0xd9, 0x1d, 0xb0, 0xf3, 0xfd, 0x80, 0x9d,
-	0xd7, 0xd7, 0xa5, 0x6f, 0x65, 0x5c, 0xd5, 0x2f, 0xa4, 0xf7, 0x3b, 0x00, 0x00, 0xff, 0xff, 0x3d,
-	0xe2, 0xae, 0x8a, 0x19, 0x04, 0x00, 0x00,
+func (m *QueryGetRewardWithdrawalQueueRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryGetRewardWithdrawalQueueRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryGetRewardWithdrawalQueueRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryGetRewardWithdrawalQueueRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryGetRewardWithdrawalQueueRequest.Merge(m, src)
+}
+func (m *QueryGetRewardWithdrawalQueueRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryGetRewardWithdrawalQueueRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryGetRewardWithdrawalQueueRequest.DiscardUnknown(m)
 }
 
-// Reference imports to suppress errors if they are not otherwise used.
-var _ context.Context
-var _ grpc.ClientConn
-
-// This is a compile-time assertion to ensure that this generated file
-// is compatible with the grpc package it is being compiled against.
-const _ = grpc.SupportPackageIsVersion4
+var xxx_messageInfo_QueryGetRewardWithdrawalQueueRequest proto.InternalMessageInfo
 
-// QueryClient is the client API for Query service.
-//
-// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
-type QueryClient interface {
-	// Params queries the parameters of the module.
-	Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error)
-	// GetWithdrawalQueue queries the withdrawal queue of the module.
-	GetWithdrawalQueue(ctx context.Context, in *QueryGetWithdrawalQueueRequest, opts ...grpc.CallOption) (*QueryGetWithdrawalQueueResponse, error)
+func (m *QueryGetRewardWithdrawalQueueRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
 }
 
-type queryClient struct {
-	cc grpc1.ClientConn
+func (m *QueryGetRewardWithdrawalQueueRequest) GetEvmAddress() string {
+	if m != nil {
+		return m.EvmAddress
+	}
+	return ""
 }
 
-func NewQueryClient(cc grpc1.ClientConn) QueryClient {
-	return &queryClient{cc}
+// QueryGetRewardWithdrawalQueueResponse is the response type for the Query/GetRewardWithdrawalQueue RPC method.
+type QueryGetRewardWithdrawalQueueResponse struct {
+	Withdrawals []*Withdrawal `protobuf:"bytes,1,rep,name=withdrawals,proto3" json:"withdrawals,omitempty"`
+	// pagination defines an optional pagination for the request.
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func (c *queryClient) Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error) {
-	out := new(QueryParamsResponse)
-	err := c.cc.Invoke(ctx, "/client.x.evmstaking.types.Query/Params", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *QueryGetRewardWithdrawalQueueResponse) Reset()         { *m = QueryGetRewardWithdrawalQueueResponse{} }
+func (m *QueryGetRewardWithdrawalQueueResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryGetRewardWithdrawalQueueResponse) ProtoMessage()    {}
+func (*QueryGetRewardWithdrawalQueueResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e9d6f66d5e677280, []int{5}
+}
+func (m *QueryGetRewardWithdrawalQueueResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryGetRewardWithdrawalQueueResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryGetRewardWithdrawalQueueResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *QueryGetRewardWithdrawalQueueResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryGetRewardWithdrawalQueueResponse.Merge(m, src)
+}
+func (m *QueryGetRewardWithdrawalQueueResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryGetRewardWithdrawalQueueResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryGetRewardWithdrawalQueueResponse.DiscardUnknown(m)
 }
 
-func (c *queryClient) GetWithdrawalQueue(ctx context.Context, in *QueryGetWithdrawalQueueRequest, opts ...grpc.CallOption) (*QueryGetWithdrawalQueueResponse, error) {
-	out := new(QueryGetWithdrawalQueueResponse)
-	err := c.cc.Invoke(ctx, "/client.x.evmstaking.types.Query/GetWithdrawalQueue", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryGetRewardWithdrawalQueueResponse proto.InternalMessageInfo
+
+func (m *QueryGetRewardWithdrawalQueueResponse) GetWithdrawals() []*Withdrawal {
+	if m != nil {
+		return m.Withdrawals
 	}
-	return out, nil
+	return nil
 }
 
-// QueryServer is the server API for Query service.
-type QueryServer interface {
-	// Params queries the parameters of the module.
-	Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error)
-	// GetWithdrawalQueue queries the withdrawal queue of the module.
-	GetWithdrawalQueue(context.Context, *QueryGetWithdrawalQueueRequest) (*QueryGetWithdrawalQueueResponse, error)
+func (m *QueryGetRewardWithdrawalQueueResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
 }
 
-// UnimplementedQueryServer can be embedded to have forward compatible implementations.
-type UnimplementedQueryServer struct {
+// QueryGetDelegatorWithdrawAddressRequest is the request type for the Query/GetDelegatorWithdrawAddress RPC method.
+type QueryGetDelegatorWithdrawAddressRequest struct {
+	DelegatorAddress string `protobuf:"bytes,1,opt,name=delegator_address,json=delegatorAddress,proto3" json:"delegator_address,omitempty"`
 }
 
-func (*UnimplementedQueryServer) Params(ctx context.Context, req *QueryParamsRequest) (*QueryParamsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Params not implemented")
+func (m *QueryGetDelegatorWithdrawAddressRequest) Reset() {
+	*m = QueryGetDelegatorWithdrawAddressRequest{}
 }
-func (*UnimplementedQueryServer) GetWithdrawalQueue(ctx context.Context, req *QueryGetWithdrawalQueueRequest) (*QueryGetWithdrawalQueueResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetWithdrawalQueue not implemented")
+func (m *QueryGetDelegatorWithdrawAddressRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryGetDelegatorWithdrawAddressRequest) ProtoMessage()    {}
+func (*QueryGetDelegatorWithdrawAddressRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e9d6f66d5e677280, []int{6}
 }
-
-func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
-	s.RegisterService(&_Query_serviceDesc, srv)
+func (m *QueryGetDelegatorWithdrawAddressRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-
-func _Query_Params_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryParamsRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).Params(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/client.x.evmstaking.types.Query/Params",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).Params(ctx, req.(*QueryParamsRequest))
+func (m *QueryGetDelegatorWithdrawAddressRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryGetDelegatorWithdrawAddressRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return interceptor(ctx, in, info, handler)
+}
+func (m *QueryGetDelegatorWithdrawAddressRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryGetDelegatorWithdrawAddressRequest.Merge(m, src)
+}
+func (m *QueryGetDelegatorWithdrawAddressRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryGetDelegatorWithdrawAddressRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryGetDelegatorWithdrawAddressRequest.DiscardUnknown(m)
 }
 
-func _Query_GetWithdrawalQueue_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryGetWithdrawalQueueRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).GetWithdrawalQueue(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/client.x.evmstaking.types.Query/GetWithdrawalQueue",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).GetWithdrawalQueue(ctx, req.(*QueryGetWithdrawalQueueRequest))
+var xxx_messageInfo_QueryGetDelegatorWithdrawAddressRequest proto.InternalMessageInfo
+
+func (m *QueryGetDelegatorWithdrawAddressRequest) GetDelegatorAddress() string {
+	if m != nil {
+		return m.DelegatorAddress
 	}
-	return interceptor(ctx, in, info, handler)
+	return ""
 }
 
-var _Query_serviceDesc = grpc.ServiceDesc{
-	ServiceName: "client.x.evmstaking.types.Query",
-	HandlerType: (*QueryServer)(nil),
-	Methods: []grpc.MethodDesc{
-		{
-			MethodName: "Params",
-			Handler:    _Query_Params_Handler,
-		},
-		{
-			MethodName: "GetWithdrawalQueue",
-			Handler:    _Query_GetWithdrawalQueue_Handler,
-		},
-	},
-	Streams:  []grpc.StreamDesc{},
-	Metadata: "client/x/evmstaking/types/query.proto",
+// QueryGetDelegatorWithdrawAddressResponse is the response type for the Query/GetDelegatorWithdrawAddress RPC method.
+type QueryGetDelegatorWithdrawAddressResponse struct {
+	WithdrawAddress string `protobuf:"bytes,1,opt,name=withdraw_address,json=withdrawAddress,proto3" json:"withdraw_address,omitempty"`
 }
 
-func (m *QueryParamsRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *QueryGetDelegatorWithdrawAddressResponse) Reset() {
+	*m = QueryGetDelegatorWithdrawAddressResponse{}
+}
+func (m *QueryGetDelegatorWithdrawAddressResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryGetDelegatorWithdrawAddressResponse) ProtoMessage()    {}
+func (*QueryGetDelegatorWithdrawAddressResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e9d6f66d5e677280, []int{7}
+}
+func (m *QueryGetDelegatorWithdrawAddressResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryGetDelegatorWithdrawAddressResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryGetDelegatorWithdrawAddressResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
 }
-
-func (m *QueryParamsRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *QueryGetDelegatorWithdrawAddressResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryGetDelegatorWithdrawAddressResponse.Merge(m, src)
 }
-
-func (m *QueryParamsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func (m *QueryGetDelegatorWithdrawAddressResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryGetDelegatorWithdrawAddressResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryGetDelegatorWithdrawAddressResponse.DiscardUnknown(m)
 }
 
-func (m *QueryParamsResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryGetDelegatorWithdrawAddressResponse proto.InternalMessageInfo
+
+func (m *QueryGetDelegatorWithdrawAddressResponse) GetWithdrawAddress() string {
+	if m != nil {
+		return m.WithdrawAddress
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *QueryParamsResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// QueryGetDelegatorRewardAddressRequest is the request type for the Query/GetDelegatorRewardAddress RPC method.
+type QueryGetDelegatorRewardAddressRequest struct {
+	DelegatorAddress string `protobuf:"bytes,1,opt,name=delegator_address,json=delegatorAddress,proto3" json:"delegator_address,omitempty"`
 }
 
-func (m *QueryParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+func (m *QueryGetDelegatorRewardAddressRequest) Reset()         { *m = QueryGetDelegatorRewardAddressRequest{} }
+func (m *QueryGetDelegatorRewardAddressRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryGetDelegatorRewardAddressRequest) ProtoMessage()    {}
+func (*QueryGetDelegatorRewardAddressRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e9d6f66d5e677280, []int{8}
+}
+func (m *QueryGetDelegatorRewardAddressRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryGetDelegatorRewardAddressRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryGetDelegatorRewardAddressRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
-			return 0, err
+			return nil, err
 		}
-		i -= size
-		i = encodeVarintQuery(dAtA, i, uint64(size))
+		return b[:n], nil
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
+}
+func (m *QueryGetDelegatorRewardAddressRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryGetDelegatorRewardAddressRequest.Merge(m, src)
+}
+func (m *QueryGetDelegatorRewardAddressRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryGetDelegatorRewardAddressRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryGetDelegatorRewardAddressRequest.DiscardUnknown(m)
 }
 
-func (m *QueryGetWithdrawalQueueRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryGetDelegatorRewardAddressRequest proto.InternalMessageInfo
+
+func (m *QueryGetDelegatorRewardAddressRequest) GetDelegatorAddress() string {
+	if m != nil {
+		return m.DelegatorAddress
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *QueryGetWithdrawalQueueRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// QueryGetDelegatorRewardAddressResponse is the response type for the Query/GetDelegatorRewardAddress RPC method.
+type QueryGetDelegatorRewardAddressResponse struct {
+	RewardAddress string `protobuf:"bytes,1,opt,name=reward_address,json=rewardAddress,proto3" json:"reward_address,omitempty"`
 }
 
-func (m *QueryGetWithdrawalQueueRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+func (m *QueryGetDelegatorRewardAddressResponse) Reset() {
+	*m = QueryGetDelegatorRewardAddressResponse{}
+}
+func (m *QueryGetDelegatorRewardAddressResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryGetDelegatorRewardAddressResponse) ProtoMessage()    {}
+func (*QueryGetDelegatorRewardAddressResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e9d6f66d5e677280, []int{9}
+}
+func (m *QueryGetDelegatorRewardAddressResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryGetDelegatorRewardAddressResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryGetDelegatorRewardAddressResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryGetDelegatorRewardAddressResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryGetDelegatorRewardAddressResponse.Merge(m, src)
+}
+func (m *QueryGetDelegatorRewardAddressResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryGetDelegatorRewardAddressResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryGetDelegatorRewardAddressResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryGetDelegatorRewardAddressResponse proto.InternalMessageInfo
+
+func (m *QueryGetDelegatorRewardAddressResponse) GetRewardAddress() string {
+	if m != nil {
+		return m.RewardAddress
+	}
+	return ""
+}
+
+// QueryGetDelegatorOperatorAddressRequest is the request type for the Query/GetDelegatorOperatorAddress RPC method.
+type QueryGetDelegatorOperatorAddressRequest struct {
+	DelegatorAddress string `protobuf:"bytes,1,opt,name=delegator_address,json=delegatorAddress,proto3" json:"delegator_address,omitempty"`
+}
+
+func (m *QueryGetDelegatorOperatorAddressRequest) Reset() {
+	*m = QueryGetDelegatorOperatorAddressRequest{}
+}
+func (m *QueryGetDelegatorOperatorAddressRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryGetDelegatorOperatorAddressRequest) ProtoMessage()    {}
+func (*QueryGetDelegatorOperatorAddressRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e9d6f66d5e677280, []int{10}
+}
+func (m *QueryGetDelegatorOperatorAddressRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryGetDelegatorOperatorAddressRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryGetDelegatorOperatorAddressRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryGetDelegatorOperatorAddressRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryGetDelegatorOperatorAddressRequest.Merge(m, src)
+}
+func (m *QueryGetDelegatorOperatorAddressRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryGetDelegatorOperatorAddressRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryGetDelegatorOperatorAddressRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryGetDelegatorOperatorAddressRequest proto.InternalMessageInfo
+
+func (m *QueryGetDelegatorOperatorAddressRequest) GetDelegatorAddress() string {
+	if m != nil {
+		return m.DelegatorAddress
+	}
+	return ""
+}
+
+// QueryGetDelegatorOperatorAddressResponse is the response type for the Query/GetDelegatorOperatorAddress RPC method.
+type QueryGetDelegatorOperatorAddressResponse struct {
+	OperatorAddress string `protobuf:"bytes,1,opt,name=operator_address,json=operatorAddress,proto3" json:"operator_address,omitempty"`
+}
+
+func (m *QueryGetDelegatorOperatorAddressResponse) Reset() {
+	*m = QueryGetDelegatorOperatorAddressResponse{}
+}
+func (m *QueryGetDelegatorOperatorAddressResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryGetDelegatorOperatorAddressResponse) ProtoMessage()    {}
+func (*QueryGetDelegatorOperatorAddressResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e9d6f66d5e677280, []int{11}
+}
+func (m *QueryGetDelegatorOperatorAddressResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryGetDelegatorOperatorAddressResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryGetDelegatorOperatorAddressResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryGetDelegatorOperatorAddressResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryGetDelegatorOperatorAddressResponse.Merge(m, src)
+}
+func (m *QueryGetDelegatorOperatorAddressResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryGetDelegatorOperatorAddressResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryGetDelegatorOperatorAddressResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryGetDelegatorOperatorAddressResponse proto.InternalMessageInfo
+
+func (m *QueryGetDelegatorOperatorAddressResponse) GetOperatorAddress() string {
+	if m != nil {
+		return m.OperatorAddress
+	}
+	return ""
+}
+
+// QueryGetDelegatorOperatorsRequest is the request type for the Query/GetDelegatorOperators RPC method.
+type QueryGetDelegatorOperatorsRequest struct {
+	DelegatorAddress string             `protobuf:"bytes,1,opt,name=delegator_address,json=delegatorAddress,proto3" json:"delegator_address,omitempty"`
+	Pagination       *query.PageRequest `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryGetDelegatorOperatorsRequest) Reset()         { *m = QueryGetDelegatorOperatorsRequest{} }
+func (m *QueryGetDelegatorOperatorsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryGetDelegatorOperatorsRequest) ProtoMessage()    {}
+func (*QueryGetDelegatorOperatorsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e9d6f66d5e677280, []int{12}
+}
+func (m *QueryGetDelegatorOperatorsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryGetDelegatorOperatorsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryGetDelegatorOperatorsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryGetDelegatorOperatorsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryGetDelegatorOperatorsRequest.Merge(m, src)
+}
+func (m *QueryGetDelegatorOperatorsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryGetDelegatorOperatorsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryGetDelegatorOperatorsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryGetDelegatorOperatorsRequest proto.InternalMessageInfo
+
+func (m *QueryGetDelegatorOperatorsRequest) GetDelegatorAddress() string {
+	if m != nil {
+		return m.DelegatorAddress
+	}
+	return ""
+}
+
+func (m *QueryGetDelegatorOperatorsRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+// QueryGetDelegatorOperatorsResponse is the response type for the Query/GetDelegatorOperators RPC method.
+type QueryGetDelegatorOperatorsResponse struct {
+	Operators  []DelegatorOperator `protobuf:"bytes,1,rep,name=operators,proto3" json:"operators"`
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryGetDelegatorOperatorsResponse) Reset()         { *m = QueryGetDelegatorOperatorsResponse{} }
+func (m *QueryGetDelegatorOperatorsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryGetDelegatorOperatorsResponse) ProtoMessage()    {}
+func (*QueryGetDelegatorOperatorsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e9d6f66d5e677280, []int{13}
+}
+func (m *QueryGetDelegatorOperatorsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryGetDelegatorOperatorsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryGetDelegatorOperatorsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryGetDelegatorOperatorsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryGetDelegatorOperatorsResponse.Merge(m, src)
+}
+func (m *QueryGetDelegatorOperatorsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryGetDelegatorOperatorsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryGetDelegatorOperatorsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryGetDelegatorOperatorsResponse proto.InternalMessageInfo
+
+func (m *QueryGetDelegatorOperatorsResponse) GetOperators() []DelegatorOperator {
+	if m != nil {
+		return m.Operators
+	}
+	return nil
+}
+
+func (m *QueryGetDelegatorOperatorsResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+// QueryGetUbiWithdrawalsRequest is the request type for the Query/GetUbiWithdrawals RPC method.
+type QueryGetUbiWithdrawalsRequest struct {
+	Pagination *query.PageRequest `protobuf:"bytes,1,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryGetUbiWithdrawalsRequest) Reset()         { *m = QueryGetUbiWithdrawalsRequest{} }
+func (m *QueryGetUbiWithdrawalsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryGetUbiWithdrawalsRequest) ProtoMessage()    {}
+func (*QueryGetUbiWithdrawalsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e9d6f66d5e677280, []int{14}
+}
+func (m *QueryGetUbiWithdrawalsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryGetUbiWithdrawalsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryGetUbiWithdrawalsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryGetUbiWithdrawalsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryGetUbiWithdrawalsRequest.Merge(m, src)
+}
+func (m *QueryGetUbiWithdrawalsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryGetUbiWithdrawalsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryGetUbiWithdrawalsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryGetUbiWithdrawalsRequest proto.InternalMessageInfo
+
+func (m *QueryGetUbiWithdrawalsRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+// QueryGetUbiWithdrawalsResponse is the response type for the Query/GetUbiWithdrawals RPC method.
+type QueryGetUbiWithdrawalsResponse struct {
+	Withdrawals []*Withdrawal `protobuf:"bytes,1,rep,name=withdrawals,proto3" json:"withdrawals,omitempty"`
+	// pagination defines an optional pagination for the request.
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryGetUbiWithdrawalsResponse) Reset()         { *m = QueryGetUbiWithdrawalsResponse{} }
+func (m *QueryGetUbiWithdrawalsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryGetUbiWithdrawalsResponse) ProtoMessage()    {}
+func (*QueryGetUbiWithdrawalsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e9d6f66d5e677280, []int{15}
+}
+func (m *QueryGetUbiWithdrawalsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryGetUbiWithdrawalsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryGetUbiWithdrawalsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryGetUbiWithdrawalsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryGetUbiWithdrawalsResponse.Merge(m, src)
+}
+func (m *QueryGetUbiWithdrawalsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryGetUbiWithdrawalsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryGetUbiWithdrawalsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryGetUbiWithdrawalsResponse proto.InternalMessageInfo
+
+func (m *QueryGetUbiWithdrawalsResponse) GetWithdrawals() []*Withdrawal {
+	if m != nil {
+		return m.Withdrawals
+	}
+	return nil
+}
+
+func (m *QueryGetUbiWithdrawalsResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+// QueryGetFailedEventsRequest is the request type for the Query/GetFailedEvents RPC method.
+// At most one of the delegator and validator address filters can be set.
+type QueryGetFailedEventsRequest struct {
+	DelegatorAddress string             `protobuf:"bytes,1,opt,name=delegator_address,json=delegatorAddress,proto3" json:"delegator_address,omitempty"`
+	ValidatorAddress string             `protobuf:"bytes,2,opt,name=validator_address,json=validatorAddress,proto3" json:"validator_address,omitempty"`
+	Pagination       *query.PageRequest `protobuf:"bytes,3,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryGetFailedEventsRequest) Reset()         { *m = QueryGetFailedEventsRequest{} }
+func (m *QueryGetFailedEventsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryGetFailedEventsRequest) ProtoMessage()    {}
+func (*QueryGetFailedEventsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e9d6f66d5e677280, []int{16}
+}
+func (m *QueryGetFailedEventsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryGetFailedEventsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryGetFailedEventsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryGetFailedEventsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryGetFailedEventsRequest.Merge(m, src)
+}
+func (m *QueryGetFailedEventsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryGetFailedEventsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryGetFailedEventsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryGetFailedEventsRequest proto.InternalMessageInfo
+
+func (m *QueryGetFailedEventsRequest) GetDelegatorAddress() string {
+	if m != nil {
+		return m.DelegatorAddress
+	}
+	return ""
+}
+
+func (m *QueryGetFailedEventsRequest) GetValidatorAddress() string {
+	if m != nil {
+		return m.ValidatorAddress
+	}
+	return ""
+}
+
+func (m *QueryGetFailedEventsRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+// QueryGetFailedEventsResponse is the response type for the Query/GetFailedEvents RPC method.
+type QueryGetFailedEventsResponse struct {
+	FailedEvents []FailedEvent `protobuf:"bytes,1,rep,name=failed_events,json=failedEvents,proto3" json:"failed_events"`
+	// pagination defines an optional pagination for the request.
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryGetFailedEventsResponse) Reset()         { *m = QueryGetFailedEventsResponse{} }
+func (m *QueryGetFailedEventsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryGetFailedEventsResponse) ProtoMessage()    {}
+func (*QueryGetFailedEventsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e9d6f66d5e677280, []int{17}
+}
+func (m *QueryGetFailedEventsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryGetFailedEventsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryGetFailedEventsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryGetFailedEventsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryGetFailedEventsResponse.Merge(m, src)
+}
+func (m *QueryGetFailedEventsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryGetFailedEventsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryGetFailedEventsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryGetFailedEventsResponse proto.InternalMessageInfo
+
+func (m *QueryGetFailedEventsResponse) GetFailedEvents() []FailedEvent {
+	if m != nil {
+		return m.FailedEvents
+	}
+	return nil
+}
+
+func (m *QueryGetFailedEventsResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+func init() {
+	proto.RegisterType((*QueryParamsRequest)(nil), "client.x.evmstaking.types.QueryParamsRequest")
+	proto.RegisterType((*QueryParamsResponse)(nil), "client.x.evmstaking.types.QueryParamsResponse")
+	proto.RegisterType((*QueryGetWithdrawalQueueRequest)(nil), "client.x.evmstaking.types.QueryGetWithdrawalQueueRequest")
+	proto.RegisterType((*QueryGetWithdrawalQueueResponse)(nil), "client.x.evmstaking.types.QueryGetWithdrawalQueueResponse")
+	proto.RegisterType((*QueryGetRewardWithdrawalQueueRequest)(nil), "client.x.evmstaking.types.QueryGetRewardWithdrawalQueueRequest")
+	proto.RegisterType((*QueryGetRewardWithdrawalQueueResponse)(nil), "client.x.evmstaking.types.QueryGetRewardWithdrawalQueueResponse")
+	proto.RegisterType((*QueryGetDelegatorWithdrawAddressRequest)(nil), "client.x.evmstaking.types.QueryGetDelegatorWithdrawAddressRequest")
+	proto.RegisterType((*QueryGetDelegatorWithdrawAddressResponse)(nil), "client.x.evmstaking.types.QueryGetDelegatorWithdrawAddressResponse")
+	proto.RegisterType((*QueryGetDelegatorRewardAddressRequest)(nil), "client.x.evmstaking.types.QueryGetDelegatorRewardAddressRequest")
+	proto.RegisterType((*QueryGetDelegatorRewardAddressResponse)(nil), "client.x.evmstaking.types.QueryGetDelegatorRewardAddressResponse")
+	proto.RegisterType((*QueryGetDelegatorOperatorAddressRequest)(nil), "client.x.evmstaking.types.QueryGetDelegatorOperatorAddressRequest")
+	proto.RegisterType((*QueryGetDelegatorOperatorAddressResponse)(nil), "client.x.evmstaking.types.QueryGetDelegatorOperatorAddressResponse")
+	proto.RegisterType((*QueryGetDelegatorOperatorsRequest)(nil), "client.x.evmstaking.types.QueryGetDelegatorOperatorsRequest")
+	proto.RegisterType((*QueryGetDelegatorOperatorsResponse)(nil), "client.x.evmstaking.types.QueryGetDelegatorOperatorsResponse")
+	proto.RegisterType((*QueryGetUbiWithdrawalsRequest)(nil), "client.x.evmstaking.types.QueryGetUbiWithdrawalsRequest")
+	proto.RegisterType((*QueryGetUbiWithdrawalsResponse)(nil), "client.x.evmstaking.types.QueryGetUbiWithdrawalsResponse")
+	proto.RegisterType((*QueryGetFailedEventsRequest)(nil), "client.x.evmstaking.types.QueryGetFailedEventsRequest")
+	proto.RegisterType((*QueryGetFailedEventsResponse)(nil), "client.x.evmstaking.types.QueryGetFailedEventsResponse")
+}
+
+func init() {
+	proto.RegisterFile("client/x/evmstaking/types/query.proto", fileDescriptor_e9d6f66d5e677280)
+}
+
+var fileDescriptor_e9d6f66d5e677280 = []byte{
+	// 973 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x58, 0xcf, 0x6f, 0xe3, 0x44,
+	0x18, 0xcd, 0x64, 0xa1, 0xd2, 0x7e, 0x61, 0x77, 0xdb, 0x61, 0x91, 0x5a, 0x6f, 0x71, 0x77, 0xcd,
+	0x26, 0x0d, 0x94, 0xda, 0x6a, 0x57, 0xfc, 0x58, 0x04, 0xf4, 0x17, 0x4d, 0x0e, 0x08, 0x35, 0x8d,
+	0x68, 0x91, 0xb8, 0x44, 0x93, 0x66, 0x6a, 0x2c, 0x12, 0x3b, 0xb5, 0x9d, 0xa4, 0x15, 0xe2, 0xc2,
+	0x09, 0x0e, 0x08, 0x24, 0x2e, 0xfc, 0x17, 0x48, 0x70, 0x40, 0xc0, 0xa1, 0xd7, 0x1e, 0x2b, 0xc1,
+	0x01, 0x21, 0x84, 0x50, 0xcb, 0x95, 0x23, 0x12, 0x47, 0x94, 0xf1, 0x38, 0x76, 0xfc, 0x23, 0x49,
+	0x9d, 0x48, 0xf4, 0x16, 0x79, 0xbe, 0xef, 0x7d, 0xef, 0x3d, 0x7b, 0x66, 0x9e, 0x02, 0xd9, 0x83,
+	0xba, 0x46, 0x75, 0x5b, 0x39, 0x56, 0x68, 0xbb, 0x61, 0xd9, 0xe4, 0x43, 0x4d, 0x57, 0x15, 0xfb,
+	0xa4, 0x49, 0x2d, 0xe5, 0xa8, 0x45, 0xcd, 0x13, 0xb9, 0x69, 0x1a, 0xb6, 0x81, 0xe7, 0x9c, 0x32,
+	0xf9, 0x58, 0xf6, 0xca, 0x64, 0x56, 0x26, 0xdc, 0x55, 0x0d, 0xd5, 0x60, 0x55, 0x4a, 0xf7, 0x97,
+	0xd3, 0x20, 0xcc, 0xab, 0x86, 0xa1, 0xd6, 0xa9, 0x42, 0x9a, 0x9a, 0x42, 0x74, 0xdd, 0xb0, 0x89,
+	0xad, 0x19, 0xba, 0xc5, 0x57, 0x5f, 0x38, 0x30, 0xac, 0x86, 0x61, 0x29, 0x55, 0x62, 0x51, 0x67,
+	0x8e, 0xd2, 0x5e, 0xa9, 0x52, 0x9b, 0xac, 0x28, 0x4d, 0xa2, 0x6a, 0x3a, 0x2b, 0xe6, 0xb5, 0xb9,
+	0x78, 0x86, 0x4d, 0x62, 0x92, 0x86, 0x87, 0x19, 0x5b, 0xe7, 0xe3, 0xcc, 0x6a, 0xa5, 0xbb, 0x80,
+	0x77, 0xbb, 0x53, 0x4b, 0x0c, 0xa0, 0x4c, 0x8f, 0x5a, 0xd4, 0xb2, 0xa5, 0x7d, 0x78, 0xba, 0xef,
+	0xa9, 0xd5, 0x34, 0x74, 0x8b, 0xe2, 0x35, 0x98, 0x72, 0x06, 0xcd, 0xa2, 0xfb, 0x28, 0x9f, 0x59,
+	0x7d, 0x20, 0xc7, 0x9a, 0x21, 0x3b, 0xad, 0x9b, 0x4f, 0x9c, 0xfd, 0xb1, 0x90, 0x2a, 0xf3, 0x36,
+	0xe9, 0x33, 0x04, 0x22, 0x03, 0x2e, 0x52, 0xfb, 0x3d, 0xcd, 0xfe, 0xa0, 0x66, 0x92, 0x0e, 0xa9,
+	0xef, 0xb6, 0x68, 0x8b, 0xf2, 0xd1, 0xb8, 0x00, 0xe0, 0x09, 0xe7, 0x73, 0x72, 0xb2, 0xe3, 0x92,
+	0xdc, 0x75, 0x49, 0x76, 0xde, 0x06, 0x77, 0x49, 0x2e, 0x11, 0xd5, 0xed, 0x2d, 0xfb, 0x3a, 0xf1,
+	0x02, 0x64, 0x68, 0xbb, 0x51, 0x21, 0xb5, 0x9a, 0x49, 0x2d, 0x6b, 0x36, 0x7d, 0x1f, 0xe5, 0x6f,
+	0x96, 0x81, 0xb6, 0x1b, 0x1b, 0xce, 0x13, 0xe9, 0x3b, 0x04, 0x0b, 0xb1, 0x5c, 0xb8, 0xe0, 0x22,
+	0x64, 0x3a, 0xbd, 0xa5, 0xae, 0xea, 0x1b, 0xf9, 0xcc, 0x6a, 0x76, 0x80, 0x6a, 0x0f, 0xa8, 0xec,
+	0xef, 0xc4, 0xc5, 0x3e, 0x55, 0x69, 0xa6, 0x6a, 0x71, 0xa8, 0x2a, 0x87, 0x85, 0x5f, 0x96, 0xf4,
+	0x05, 0x82, 0x87, 0x2e, 0xeb, 0x32, 0xed, 0x10, 0xb3, 0xf6, 0x7f, 0xfb, 0xf8, 0x03, 0x82, 0xec,
+	0x10, 0x46, 0xd7, 0xd6, 0xcd, 0x7d, 0x58, 0x74, 0xa9, 0xbf, 0x45, 0xeb, 0x54, 0x25, 0xb6, 0x61,
+	0xba, 0x43, 0xb9, 0x3e, 0xd7, 0xcf, 0x25, 0x98, 0xa9, 0xb9, 0x25, 0x3d, 0x37, 0x10, 0x73, 0x63,
+	0xba, 0xb7, 0xe0, 0x7a, 0xb2, 0x07, 0xf9, 0xe1, 0xb8, 0xdc, 0x95, 0xe7, 0x61, 0xda, 0xd5, 0x16,
+	0xc0, 0xbd, 0xd3, 0xe9, 0x6f, 0x91, 0xde, 0x85, 0x6c, 0x08, 0xd6, 0xb1, 0x7c, 0x1c, 0xb2, 0x3b,
+	0x90, 0x1b, 0x86, 0xca, 0xa9, 0x66, 0xe1, 0xb6, 0xc9, 0x16, 0x02, 0x98, 0xb7, 0x4c, 0x7f, 0x79,
+	0xa4, 0xab, 0x3b, 0x4d, 0x6a, 0xfa, 0x86, 0x4e, 0xcc, 0xd5, 0x10, 0xae, 0xe7, 0xaa, 0xc1, 0x97,
+	0x82, 0xae, 0x1a, 0xfd, 0x2d, 0xd2, 0xd7, 0x08, 0x1e, 0xc4, 0xe2, 0x26, 0x62, 0x8a, 0x0b, 0x11,
+	0x1f, 0x68, 0x82, 0xcd, 0x27, 0x9d, 0x22, 0x90, 0x06, 0x51, 0xe3, 0x62, 0x4b, 0x70, 0xd3, 0x15,
+	0xe5, 0x6e, 0xab, 0x17, 0x07, 0x6c, 0xab, 0x10, 0x12, 0x3f, 0xa5, 0x3d, 0x90, 0xc9, 0xed, 0x30,
+	0x15, 0x9e, 0x75, 0x05, 0xec, 0x55, 0x35, 0x6f, 0x43, 0x5b, 0x13, 0x3e, 0xa7, 0xa4, 0x6f, 0x7d,
+	0x57, 0x4b, 0x70, 0xd2, 0xb5, 0x3d, 0x7f, 0x4e, 0x11, 0xdc, 0x73, 0x49, 0x17, 0x88, 0x56, 0xa7,
+	0xb5, 0xed, 0x36, 0xd5, 0xed, 0x64, 0x1f, 0xdd, 0x12, 0xcc, 0xb4, 0x49, 0x5d, 0xab, 0xf5, 0x15,
+	0x3b, 0xe7, 0xf5, 0x74, 0x6f, 0x21, 0xfa, 0x0b, 0xbd, 0x91, 0xd8, 0xf6, 0x1f, 0x11, 0xcc, 0x47,
+	0x2b, 0xe0, 0xa6, 0xef, 0xc2, 0xad, 0x43, 0xf6, 0xbc, 0x42, 0xd9, 0x02, 0xb7, 0x3d, 0x37, 0xc0,
+	0x76, 0x1f, 0x0e, 0xff, 0x32, 0x9f, 0x3a, 0xf4, 0x41, 0x4f, 0xcc, 0xfe, 0xd5, 0xdf, 0x6e, 0xc3,
+	0x93, 0x8c, 0x3c, 0xfe, 0x1c, 0xc1, 0x94, 0x93, 0x58, 0xf0, 0xf2, 0x00, 0x66, 0xe1, 0xa8, 0x24,
+	0xc8, 0xa3, 0x96, 0x3b, 0xf3, 0xa5, 0x87, 0x9f, 0xfc, 0xfc, 0xd7, 0x57, 0x69, 0x11, 0xcf, 0x2b,
+	0x4e, 0x9f, 0x3f, 0xa3, 0xb5, 0x57, 0x78, 0x90, 0xc3, 0x3f, 0x21, 0xc0, 0xe1, 0x5c, 0x82, 0x1f,
+	0x0f, 0x1b, 0x16, 0x9b, 0xab, 0x84, 0xd7, 0x92, 0xb4, 0x72, 0xce, 0x32, 0xe3, 0x9c, 0xc7, 0xb9,
+	0x68, 0xce, 0xde, 0xd6, 0xa8, 0x1c, 0x31, 0x9a, 0xbf, 0x20, 0x98, 0x8d, 0x4b, 0x03, 0x78, 0x6d,
+	0x04, 0x22, 0x83, 0x92, 0x8d, 0xb0, 0x9e, 0x1c, 0x80, 0xeb, 0x79, 0x89, 0xe9, 0x51, 0xf0, 0x72,
+	0xb4, 0x1e, 0x7e, 0xc7, 0x85, 0x64, 0xfd, 0x83, 0xe0, 0xde, 0x80, 0x1b, 0x1d, 0x6f, 0x8e, 0x40,
+	0x6c, 0x48, 0xcc, 0x10, 0xb6, 0xc6, 0xc2, 0xe0, 0xfa, 0xde, 0x61, 0xfa, 0x8a, 0x78, 0x3b, 0x5a,
+	0x5f, 0xef, 0xe4, 0xb0, 0x94, 0x8f, 0x42, 0xc7, 0xcb, 0xc7, 0x4a, 0x30, 0x8d, 0xe0, 0xbf, 0x11,
+	0xcc, 0xc5, 0x86, 0x03, 0xbc, 0x7e, 0x15, 0xc6, 0x51, 0x69, 0x45, 0xd8, 0x18, 0x03, 0x81, 0x2b,
+	0x7e, 0x9b, 0x29, 0xde, 0xc6, 0x5b, 0x89, 0x14, 0xf7, 0x87, 0x1a, 0xfc, 0x6f, 0xe0, 0x3d, 0x07,
+	0x32, 0xc6, 0xd5, 0xde, 0x73, 0x74, 0xf0, 0x11, 0xb6, 0xc6, 0xc2, 0xe0, 0xaa, 0x4b, 0x63, 0xbd,
+	0xe7, 0x60, 0x3e, 0xfa, 0x34, 0x8d, 0xf0, 0xef, 0x08, 0x9e, 0x89, 0x9a, 0x6c, 0xe1, 0xd7, 0x93,
+	0x10, 0xee, 0xc9, 0x7d, 0x23, 0x61, 0x37, 0x17, 0x5a, 0x60, 0x42, 0xd7, 0xf1, 0x9b, 0x63, 0x09,
+	0xb5, 0xf0, 0xf7, 0x08, 0x66, 0x42, 0xf9, 0x00, 0xbf, 0x3a, 0x02, 0xb9, 0xc8, 0xf0, 0x22, 0x3c,
+	0x4e, 0xd0, 0xc9, 0x25, 0x2d, 0x33, 0x49, 0x8b, 0x38, 0x1b, 0x2d, 0xa9, 0x55, 0xd5, 0x2a, 0xfe,
+	0xc8, 0xf1, 0x0d, 0x82, 0x3b, 0x81, 0x2b, 0x16, 0xbf, 0x3c, 0xc2, 0xf4, 0x88, 0x54, 0x21, 0xbc,
+	0x72, 0xe5, 0x3e, 0xce, 0x79, 0x89, 0x71, 0xce, 0xe2, 0xe7, 0xa2, 0x39, 0xf7, 0xdd, 0xf3, 0x9b,
+	0x8f, 0xce, 0x2e, 0x44, 0x74, 0x7e, 0x21, 0xa2, 0x3f, 0x2f, 0x44, 0xf4, 0xe5, 0xa5, 0x98, 0x3a,
+	0xbf, 0x14, 0x53, 0xbf, 0x5e, 0x8a, 0xa9, 0xf7, 0xe7, 0x62, 0xff, 0x9f, 0xa8, 0x4e, 0xb1, 0x7f,
+	0x25, 0x1e, 0xfd, 0x37, 0x00, 0xb0, 0x96, 0x47, 0x02, 0x8d, 0x11, 0x00, 0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// QueryClient is the client API for Query service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type QueryClient interface {
+	// Params queries the parameters of the module.
+	Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error)
+	// GetWithdrawalQueue queries the withdrawal queue of the module, optionally by EVM address.
+	GetWithdrawalQueue(ctx context.Context, in *QueryGetWithdrawalQueueRequest, opts ...grpc.CallOption) (*QueryGetWithdrawalQueueResponse, error)
+	// GetRewardWithdrawalQueue queries the reward withdrawal queue of the module, optionally by EVM address.
+	GetRewardWithdrawalQueue(ctx context.Context, in *QueryGetRewardWithdrawalQueueRequest, opts ...grpc.CallOption) (*QueryGetRewardWithdrawalQueueResponse, error)
+	// GetDelegatorWithdrawAddress queries the EVM address the unstaked tokens of a delegator are withdrawn to.
+	GetDelegatorWithdrawAddress(ctx context.Context, in *QueryGetDelegatorWithdrawAddressRequest, opts ...grpc.CallOption) (*QueryGetDelegatorWithdrawAddressResponse, error)
+	// GetDelegatorRewardAddress queries the EVM address the rewards of a delegator are withdrawn to.
+	GetDelegatorRewardAddress(ctx context.Context, in *QueryGetDelegatorRewardAddressRequest, opts ...grpc.CallOption) (*QueryGetDelegatorRewardAddressResponse, error)
+	// GetDelegatorOperatorAddress queries the EVM address of an operator of a delegator granted all permissions.
+	// Deprecated: delegators can have several scoped operators, use GetDelegatorOperators instead.
+	GetDelegatorOperatorAddress(ctx context.Context, in *QueryGetDelegatorOperatorAddressRequest, opts ...grpc.CallOption) (*QueryGetDelegatorOperatorAddressResponse, error)
+	// GetDelegatorOperators queries the operators of a delegator and their permissions.
+	GetDelegatorOperators(ctx context.Context, in *QueryGetDelegatorOperatorsRequest, opts ...grpc.CallOption) (*QueryGetDelegatorOperatorsResponse, error)
+	// GetUbiWithdrawals queries the history of UBI withdrawals to the UBI withdraw address.
+	GetUbiWithdrawals(ctx context.Context, in *QueryGetUbiWithdrawalsRequest, opts ...grpc.CallOption) (*QueryGetUbiWithdrawalsResponse, error)
+	// GetFailedEvents queries the EVM events that failed to be processed, optionally by delegator or validator.
+	GetFailedEvents(ctx context.Context, in *QueryGetFailedEventsRequest, opts ...grpc.CallOption) (*QueryGetFailedEventsResponse, error)
+}
+
+type queryClient struct {
+	cc grpc1.ClientConn
+}
+
+func NewQueryClient(cc grpc1.ClientConn) QueryClient {
+	return &queryClient{cc}
+}
+
+func (c *queryClient) Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error) {
+	out := new(QueryParamsResponse)
+	err := c.cc.Invoke(ctx, "/client.x.evmstaking.types.Query/Params", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) GetWithdrawalQueue(ctx context.Context, in *QueryGetWithdrawalQueueRequest, opts ...grpc.CallOption) (*QueryGetWithdrawalQueueResponse, error) {
+	out := new(QueryGetWithdrawalQueueResponse)
+	err := c.cc.Invoke(ctx, "/client.x.evmstaking.types.Query/GetWithdrawalQueue", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) GetRewardWithdrawalQueue(ctx context.Context, in *QueryGetRewardWithdrawalQueueRequest, opts ...grpc.CallOption) (*QueryGetRewardWithdrawalQueueResponse, error) {
+	out := new(QueryGetRewardWithdrawalQueueResponse)
+	err := c.cc.Invoke(ctx, "/client.x.evmstaking.types.Query/GetRewardWithdrawalQueue", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) GetDelegatorWithdrawAddress(ctx context.Context, in *QueryGetDelegatorWithdrawAddressRequest, opts ...grpc.CallOption) (*QueryGetDelegatorWithdrawAddressResponse, error) {
+	out := new(QueryGetDelegatorWithdrawAddressResponse)
+	err := c.cc.Invoke(ctx, "/client.x.evmstaking.types.Query/GetDelegatorWithdrawAddress", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) GetDelegatorRewardAddress(ctx context.Context, in *QueryGetDelegatorRewardAddressRequest, opts ...grpc.CallOption) (*QueryGetDelegatorRewardAddressResponse, error) {
+	out := new(QueryGetDelegatorRewardAddressResponse)
+	err := c.cc.Invoke(ctx, "/client.x.evmstaking.types.Query/GetDelegatorRewardAddress", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// Deprecated: Do not use.
+func (c *queryClient) GetDelegatorOperatorAddress(ctx context.Context, in *QueryGetDelegatorOperatorAddressRequest, opts ...grpc.CallOption) (*QueryGetDelegatorOperatorAddressResponse, error) {
+	out := new(QueryGetDelegatorOperatorAddressResponse)
+	err := c.cc.Invoke(ctx, "/client.x.evmstaking.types.Query/GetDelegatorOperatorAddress", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) GetDelegatorOperators(ctx context.Context, in *QueryGetDelegatorOperatorsRequest, opts ...grpc.CallOption) (*QueryGetDelegatorOperatorsResponse, error) {
+	out := new(QueryGetDelegatorOperatorsResponse)
+	err := c.cc.Invoke(ctx, "/client.x.evmstaking.types.Query/GetDelegatorOperators", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) GetUbiWithdrawals(ctx context.Context, in *QueryGetUbiWithdrawalsRequest, opts ...grpc.CallOption) (*QueryGetUbiWithdrawalsResponse, error) {
+	out := new(QueryGetUbiWithdrawalsResponse)
+	err := c.cc.Invoke(ctx, "/client.x.evmstaking.types.Query/GetUbiWithdrawals", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) GetFailedEvents(ctx context.Context, in *QueryGetFailedEventsRequest, opts ...grpc.CallOption) (*QueryGetFailedEventsResponse, error) {
+	out := new(QueryGetFailedEventsResponse)
+	err := c.cc.Invoke(ctx, "/client.x.evmstaking.types.Query/GetFailedEvents", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// QueryServer is the server API for Query service.
+type QueryServer interface {
+	// Params queries the parameters of the module.
+	Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error)
+	// GetWithdrawalQueue queries the withdrawal queue of the module, optionally by EVM address.
+	GetWithdrawalQueue(context.Context, *QueryGetWithdrawalQueueRequest) (*QueryGetWithdrawalQueueResponse, error)
+	// GetRewardWithdrawalQueue queries the reward withdrawal queue of the module, optionally by EVM address.
+	GetRewardWithdrawalQueue(context.Context, *QueryGetRewardWithdrawalQueueRequest) (*QueryGetRewardWithdrawalQueueResponse, error)
+	// GetDelegatorWithdrawAddress queries the EVM address the unstaked tokens of a delegator are withdrawn to.
+	GetDelegatorWithdrawAddress(context.Context, *QueryGetDelegatorWithdrawAddressRequest) (*QueryGetDelegatorWithdrawAddressResponse, error)
+	// GetDelegatorRewardAddress queries the EVM address the rewards of a delegator are withdrawn to.
+	GetDelegatorRewardAddress(context.Context, *QueryGetDelegatorRewardAddressRequest) (*QueryGetDelegatorRewardAddressResponse, error)
+	// GetDelegatorOperatorAddress queries the EVM address of an operator of a delegator granted all permissions.
+	// Deprecated: delegators can have several scoped operators, use GetDelegatorOperators instead.
+	GetDelegatorOperatorAddress(context.Context, *QueryGetDelegatorOperatorAddressRequest) (*QueryGetDelegatorOperatorAddressResponse, error)
+	// GetDelegatorOperators queries the operators of a delegator and their permissions.
+	GetDelegatorOperators(context.Context, *QueryGetDelegatorOperatorsRequest) (*QueryGetDelegatorOperatorsResponse, error)
+	// GetUbiWithdrawals queries the history of UBI withdrawals to the UBI withdraw address.
+	GetUbiWithdrawals(context.Context, *QueryGetUbiWithdrawalsRequest) (*QueryGetUbiWithdrawalsResponse, error)
+	// GetFailedEvents queries the EVM events that failed to be processed, optionally by delegator or validator.
+	GetFailedEvents(context.Context, *QueryGetFailedEventsRequest) (*QueryGetFailedEventsResponse, error)
+}
+
+// UnimplementedQueryServer can be embedded to have forward compatible implementations.
+type UnimplementedQueryServer struct {
+}
+
+func (*UnimplementedQueryServer) Params(ctx context.Context, req *QueryParamsRequest) (*QueryParamsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Params not implemented")
+}
+func (*UnimplementedQueryServer) GetWithdrawalQueue(ctx context.Context, req *QueryGetWithdrawalQueueRequest) (*QueryGetWithdrawalQueueResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetWithdrawalQueue not implemented")
+}
+func (*UnimplementedQueryServer) GetRewardWithdrawalQueue(ctx context.Context, req *QueryGetRewardWithdrawalQueueRequest) (*QueryGetRewardWithdrawalQueueResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetRewardWithdrawalQueue not implemented")
+}
+func (*UnimplementedQueryServer) GetDelegatorWithdrawAddress(ctx context.Context, req *QueryGetDelegatorWithdrawAddressRequest) (*QueryGetDelegatorWithdrawAddressResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetDelegatorWithdrawAddress not implemented")
+}
+func (*UnimplementedQueryServer) GetDelegatorRewardAddress(ctx context.Context, req *QueryGetDelegatorRewardAddressRequest) (*QueryGetDelegatorRewardAddressResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetDelegatorRewardAddress not implemented")
+}
+func (*UnimplementedQueryServer) GetDelegatorOperatorAddress(ctx context.Context, req *QueryGetDelegatorOperatorAddressRequest) (*QueryGetDelegatorOperatorAddressResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetDelegatorOperatorAddress not implemented")
+}
+func (*UnimplementedQueryServer) GetDelegatorOperators(ctx context.Context, req *QueryGetDelegatorOperatorsRequest) (*QueryGetDelegatorOperatorsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetDelegatorOperators not implemented")
+}
+func (*UnimplementedQueryServer) GetUbiWithdrawals(ctx context.Context, req *QueryGetUbiWithdrawalsRequest) (*QueryGetUbiWithdrawalsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetUbiWithdrawals not implemented")
+}
+func (*UnimplementedQueryServer) GetFailedEvents(ctx context.Context, req *QueryGetFailedEventsRequest) (*QueryGetFailedEventsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetFailedEvents not implemented")
+}
+
+func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
+	s.RegisterService(&_Query_serviceDesc, srv)
+}
+
+func _Query_Params_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryParamsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Params(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/client.x.evmstaking.types.Query/Params",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Params(ctx, req.(*QueryParamsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_GetWithdrawalQueue_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryGetWithdrawalQueueRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).GetWithdrawalQueue(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/client.x.evmstaking.types.Query/GetWithdrawalQueue",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).GetWithdrawalQueue(ctx, req.(*QueryGetWithdrawalQueueRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_GetRewardWithdrawalQueue_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryGetRewardWithdrawalQueueRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).GetRewardWithdrawalQueue(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/client.x.evmstaking.types.Query/GetRewardWithdrawalQueue",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).GetRewardWithdrawalQueue(ctx, req.(*QueryGetRewardWithdrawalQueueRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_GetDelegatorWithdrawAddress_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryGetDelegatorWithdrawAddressRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).GetDelegatorWithdrawAddress(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/client.x.evmstaking.types.Query/GetDelegatorWithdrawAddress",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).GetDelegatorWithdrawAddress(ctx, req.(*QueryGetDelegatorWithdrawAddressRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_GetDelegatorRewardAddress_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryGetDelegatorRewardAddressRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).GetDelegatorRewardAddress(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/client.x.evmstaking.types.Query/GetDelegatorRewardAddress",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).GetDelegatorRewardAddress(ctx, req.(*QueryGetDelegatorRewardAddressRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_GetDelegatorOperatorAddress_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryGetDelegatorOperatorAddressRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).GetDelegatorOperatorAddress(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/client.x.evmstaking.types.Query/GetDelegatorOperatorAddress",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).GetDelegatorOperatorAddress(ctx, req.(*QueryGetDelegatorOperatorAddressRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_GetDelegatorOperators_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryGetDelegatorOperatorsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).GetDelegatorOperators(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/client.x.evmstaking.types.Query/GetDelegatorOperators",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).GetDelegatorOperators(ctx, req.(*QueryGetDelegatorOperatorsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_GetUbiWithdrawals_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryGetUbiWithdrawalsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).GetUbiWithdrawals(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/client.x.evmstaking.types.Query/GetUbiWithdrawals",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).GetUbiWithdrawals(ctx, req.(*QueryGetUbiWithdrawalsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_GetFailedEvents_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryGetFailedEventsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).GetFailedEvents(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/client.x.evmstaking.types.Query/GetFailedEvents",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).GetFailedEvents(ctx, req.(*QueryGetFailedEventsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _Query_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "client.x.evmstaking.types.Query",
+	HandlerType: (*QueryServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Params",
+			Handler:    _Query_Params_Handler,
+		},
+		{
+			MethodName: "GetWithdrawalQueue",
+			Handler:    _Query_GetWithdrawalQueue_Handler,
+		},
+		{
+			MethodName: "GetRewardWithdrawalQueue",
+			Handler:    _Query_GetRewardWithdrawalQueue_Handler,
+		},
+		{
+			MethodName: "GetDelegatorWithdrawAddress",
+			Handler:    _Query_GetDelegatorWithdrawAddress_Handler,
+		},
+		{
+			MethodName: "GetDelegatorRewardAddress",
+			Handler:    _Query_GetDelegatorRewardAddress_Handler,
+		},
+		{
+			MethodName: "GetDelegatorOperatorAddress",
+			Handler:    _Query_GetDelegatorOperatorAddress_Handler,
+		},
+		{
+			MethodName: "GetDelegatorOperators",
+			Handler:    _Query_GetDelegatorOperators_Handler,
+		},
+		{
+			MethodName: "GetUbiWithdrawals",
+			Handler:    _Query_GetUbiWithdrawals_Handler,
+		},
+		{
+			MethodName: "GetFailedEvents",
+			Handler:    _Query_GetFailedEvents_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "client/x/evmstaking/types/query.proto",
+}
+
+func (m *QueryParamsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryParamsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryParamsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryParamsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryParamsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryGetWithdrawalQueueRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGetWithdrawalQueueRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGetWithdrawalQueueRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.EvmAddress) > 0 {
+		i -= len(m.EvmAddress)
+		copy(dAtA[i:], m.EvmAddress)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.EvmAddress)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryGetWithdrawalQueueResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGetWithdrawalQueueResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGetWithdrawalQueueResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Withdrawals) > 0 {
+		for iNdEx := len(m.Withdrawals) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Withdrawals[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryGetRewardWithdrawalQueueRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGetRewardWithdrawalQueueRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGetRewardWithdrawalQueueRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.EvmAddress) > 0 {
+		i -= len(m.EvmAddress)
+		copy(dAtA[i:], m.EvmAddress)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.EvmAddress)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryGetRewardWithdrawalQueueResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGetRewardWithdrawalQueueResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGetRewardWithdrawalQueueResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Withdrawals) > 0 {
+		for iNdEx := len(m.Withdrawals) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Withdrawals[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryGetDelegatorWithdrawAddressRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGetDelegatorWithdrawAddressRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGetDelegatorWithdrawAddressRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.DelegatorAddress) > 0 {
+		i -= len(m.DelegatorAddress)
+		copy(dAtA[i:], m.DelegatorAddress)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.DelegatorAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryGetDelegatorWithdrawAddressResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGetDelegatorWithdrawAddressResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGetDelegatorWithdrawAddressResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.WithdrawAddress) > 0 {
+		i -= len(m.WithdrawAddress)
+		copy(dAtA[i:], m.WithdrawAddress)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.WithdrawAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryGetDelegatorRewardAddressRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGetDelegatorRewardAddressRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGetDelegatorRewardAddressRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.DelegatorAddress) > 0 {
+		i -= len(m.DelegatorAddress)
+		copy(dAtA[i:], m.DelegatorAddress)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.DelegatorAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryGetDelegatorRewardAddressResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGetDelegatorRewardAddressResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGetDelegatorRewardAddressResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.RewardAddress) > 0 {
+		i -= len(m.RewardAddress)
+		copy(dAtA[i:], m.RewardAddress)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.RewardAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryGetDelegatorOperatorAddressRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGetDelegatorOperatorAddressRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGetDelegatorOperatorAddressRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.DelegatorAddress) > 0 {
+		i -= len(m.DelegatorAddress)
+		copy(dAtA[i:], m.DelegatorAddress)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.DelegatorAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryGetDelegatorOperatorAddressResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGetDelegatorOperatorAddressResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGetDelegatorOperatorAddressResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.OperatorAddress) > 0 {
+		i -= len(m.OperatorAddress)
+		copy(dAtA[i:], m.OperatorAddress)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.OperatorAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryGetDelegatorOperatorsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGetDelegatorOperatorsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGetDelegatorOperatorsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.DelegatorAddress) > 0 {
+		i -= len(m.DelegatorAddress)
+		copy(dAtA[i:], m.DelegatorAddress)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.DelegatorAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryGetDelegatorOperatorsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGetDelegatorOperatorsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGetDelegatorOperatorsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Operators) > 0 {
+		for iNdEx := len(m.Operators) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Operators[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryGetUbiWithdrawalsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGetUbiWithdrawalsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGetUbiWithdrawalsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryGetUbiWithdrawalsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGetUbiWithdrawalsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGetUbiWithdrawalsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Withdrawals) > 0 {
+		for iNdEx := len(m.Withdrawals) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Withdrawals[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryGetFailedEventsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGetFailedEventsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGetFailedEventsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.ValidatorAddress) > 0 {
+		i -= len(m.ValidatorAddress)
+		copy(dAtA[i:], m.ValidatorAddress)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ValidatorAddress)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.DelegatorAddress) > 0 {
+		i -= len(m.DelegatorAddress)
+		copy(dAtA[i:], m.DelegatorAddress)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.DelegatorAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryGetFailedEventsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGetFailedEventsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGetFailedEventsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.FailedEvents) > 0 {
+		for iNdEx := len(m.FailedEvents) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.FailedEvents[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
+	offset -= sovQuery(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *QueryParamsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryParamsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Params.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryGetWithdrawalQueueRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.EvmAddress)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryGetWithdrawalQueueResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Withdrawals) > 0 {
+		for _, e := range m.Withdrawals {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryGetRewardWithdrawalQueueRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.EvmAddress)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryGetRewardWithdrawalQueueResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Withdrawals) > 0 {
+		for _, e := range m.Withdrawals {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryGetDelegatorWithdrawAddressRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.DelegatorAddress)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryGetDelegatorWithdrawAddressResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.WithdrawAddress)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryGetDelegatorRewardAddressRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.DelegatorAddress)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryGetDelegatorRewardAddressResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.RewardAddress)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryGetDelegatorOperatorAddressRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.DelegatorAddress)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryGetDelegatorOperatorAddressResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.OperatorAddress)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryGetDelegatorOperatorsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.DelegatorAddress)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryGetDelegatorOperatorsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Operators) > 0 {
+		for _, e := range m.Operators {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryGetUbiWithdrawalsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryGetUbiWithdrawalsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Withdrawals) > 0 {
+		for _, e := range m.Withdrawals {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryGetFailedEventsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.DelegatorAddress)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.ValidatorAddress)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryGetFailedEventsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.FailedEvents) > 0 {
+		for _, e := range m.FailedEvents {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func sovQuery(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozQuery(x uint64) (n int) {
+	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryParamsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryParamsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryParamsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryGetWithdrawalQueueRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryGetWithdrawalQueueRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryGetWithdrawalQueueRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EvmAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.EvmAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryGetWithdrawalQueueResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryGetWithdrawalQueueResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryGetWithdrawalQueueResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Withdrawals", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Withdrawals = append(m.Withdrawals, &Withdrawal{})
+			if err := m.Withdrawals[len(m.Withdrawals)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryGetRewardWithdrawalQueueRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryGetRewardWithdrawalQueueRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryGetRewardWithdrawalQueueRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EvmAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.EvmAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryGetRewardWithdrawalQueueResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryGetRewardWithdrawalQueueResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryGetRewardWithdrawalQueueResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Withdrawals", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Withdrawals = append(m.Withdrawals, &Withdrawal{})
+			if err := m.Withdrawals[len(m.Withdrawals)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryGetDelegatorWithdrawAddressRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryGetDelegatorWithdrawAddressRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryGetDelegatorWithdrawAddressRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DelegatorAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DelegatorAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryGetDelegatorWithdrawAddressResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryGetDelegatorWithdrawAddressResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryGetDelegatorWithdrawAddressResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WithdrawAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.WithdrawAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryGetDelegatorRewardAddressRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryGetDelegatorRewardAddressRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryGetDelegatorRewardAddressRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DelegatorAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DelegatorAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryGetDelegatorRewardAddressResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryGetDelegatorRewardAddressResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryGetDelegatorRewardAddressResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RewardAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RewardAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryGetDelegatorOperatorAddressRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryGetDelegatorOperatorAddressRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryGetDelegatorOperatorAddressRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DelegatorAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DelegatorAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryGetDelegatorOperatorAddressResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryGetDelegatorOperatorAddressResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryGetDelegatorOperatorAddressResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OperatorAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.OperatorAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
 			if err != nil {
-				return 0, err
+				return err
 			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
 		}
-		i--
-		dAtA[i] = 0xa
 	}
-	return len(dAtA) - i, nil
-}
 
-func (m *QueryGetWithdrawalQueueResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	return dAtA[:n], nil
+	return nil
 }
+func (m *QueryGetDelegatorOperatorsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryGetDelegatorOperatorsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryGetDelegatorOperatorsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DelegatorAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DelegatorAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
 
-func (m *QueryGetWithdrawalQueueResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
 }
-
-func (m *QueryGetWithdrawalQueueResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+func (m *QueryGetDelegatorOperatorsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryGetDelegatorOperatorsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryGetDelegatorOperatorsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Operators", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Operators = append(m.Operators, DelegatorOperator{})
+			if err := m.Operators[len(m.Operators)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
 			if err != nil {
-				return 0, err
+				return err
 			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.Withdrawals) > 0 {
-		for iNdEx := len(m.Withdrawals) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Withdrawals[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
 			}
-			i--
-			dAtA[i] = 0xa
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
 		}
 	}
-	return len(dAtA) - i, nil
-}
-
-func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
-	offset -= sovQuery(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
-	}
-	dAtA[offset] = uint8(v)
-	return base
-}
-func (m *QueryParamsRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	return n
-}
-
-func (m *QueryParamsResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = m.Params.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
-}
-
-func (m *QueryGetWithdrawalQueueRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	return n
-}
 
-func (m *QueryGetWithdrawalQueueResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if len(m.Withdrawals) > 0 {
-		for _, e := range m.Withdrawals {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
-		}
-	}
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	return n
-}
-
-func sovQuery(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozQuery(x uint64) (n int) {
-	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	return nil
 }
-func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryGetUbiWithdrawalsRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -606,12 +3756,48 @@ func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryParamsRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryGetUbiWithdrawalsRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryParamsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryGetUbiWithdrawalsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -633,7 +3819,7 @@ func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryGetUbiWithdrawalsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -656,15 +3842,15 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryParamsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryGetUbiWithdrawalsResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryGetUbiWithdrawalsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Withdrawals", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -691,7 +3877,44 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Withdrawals = append(m.Withdrawals, &Withdrawal{})
+			if err := m.Withdrawals[len(m.Withdrawals)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -716,7 +3939,7 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryGetWithdrawalQueueRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryGetFailedEventsRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -739,13 +3962,77 @@ func (m *QueryGetWithdrawalQueueRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryGetWithdrawalQueueRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryGetFailedEventsRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryGetWithdrawalQueueRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryGetFailedEventsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DelegatorAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DelegatorAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ValidatorAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
 			}
@@ -802,7 +4089,7 @@ func (m *QueryGetWithdrawalQueueRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryGetWithdrawalQueueResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryGetFailedEventsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -825,15 +4112,15 @@ func (m *QueryGetWithdrawalQueueResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryGetWithdrawalQueueResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryGetFailedEventsResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryGetWithdrawalQueueResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryGetFailedEventsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Withdrawals", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field FailedEvents", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -860,8 +4147,8 @@ func (m *QueryGetWithdrawalQueueResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Withdrawals = append(m.Withdrawals, &Withdrawal{})
-			if err := m.Withdrawals[len(m.Withdrawals)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.FailedEvents = append(m.FailedEvents, FailedEvent{})
+			if err := m.FailedEvents[len(m.FailedEvents)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -8,17 +8,23 @@ import (
 )
 
 var (
-	ipTokenStakingABI         = mustGetABI(bindings.IPTokenStakingMetaData)
-	UpdateValidatorCommission = mustGetEvent(ipTokenStakingABI, "UpdateValidatorCommssion")
-	SetWithdrawalAddress      = mustGetEvent(ipTokenStakingABI, "SetWithdrawalAddress")
-	SetRewardAddress          = mustGetEvent(ipTokenStakingABI, "SetRewardAddress")
-	AddOperator               = mustGetEvent(ipTokenStakingABI, "AddOperator")
-	RemoveOperator            = mustGetEvent(ipTokenStakingABI, "RemoveOperator")
-	CreateValidatorEvent      = mustGetEvent(ipTokenStakingABI, "CreateValidator")
-	DepositEvent              = mustGetEvent(ipTokenStakingABI, "Deposit")
-	RedelegateEvent           = mustGetEvent(ipTokenStakingABI, "Redelegate")
-	WithdrawEvent             = mustGetEvent(ipTokenStakingABI, "Withdraw")
-	UnjailEvent               = mustGetEvent(ipTokenStakingABI, "Unjail")
+	ipTokenStakingABI             = mustGetABI(bindings.IPTokenStakingMetaData)
+	UpdateValidatorCommission     = mustGetEvent(ipTokenStakingABI, "UpdateValidatorCommssion")
+	SetFeeRecipient               = mustGetEvent(ipTokenStakingABI, "SetFeeRecipient")
+	SetWithdrawalAddress          = mustGetEvent(ipTokenStakingABI, "SetWithdrawalAddress")
+	SetRewardAddress              = mustGetEvent(ipTokenStakingABI, "SetRewardAddress")
+	AddOperator                   = mustGetEvent(ipTokenStakingABI, "AddOperator")
+	RemoveOperator                = mustGetEvent(ipTokenStakingABI, "RemoveOperator")
+	SetOperatorPermissions        = mustGetEvent(ipTokenStakingABI, "SetOperatorPermissions")
+	CreateValidatorEvent          = mustGetEvent(ipTokenStakingABI, "CreateValidator")
+	DepositEvent                  = mustGetEvent(ipTokenStakingABI, "Deposit")
+	RedelegateEvent               = mustGetEvent(ipTokenStakingABI, "Redelegate")
+	WithdrawEvent                 = mustGetEvent(ipTokenStakingABI, "Withdraw")
+	UnjailEvent                   = mustGetEvent(ipTokenStakingABI, "Unjail")
+	MinStakeAmountSetEvent        = mustGetEvent(ipTokenStakingABI, "MinStakeAmountSet")
+	MinUnstakeAmountSetEvent      = mustGetEvent(ipTokenStakingABI, "MinUnstakeAmountSet")
+	MinCommissionRateChangedEvent = mustGetEvent(ipTokenStakingABI, "MinCommissionRateChanged")
+	FeeSetEvent                   = mustGetEvent(ipTokenStakingABI, "FeeSet")
 )
 
 // mustGetABI returns the metadata's ABI as an abi.ABI type.
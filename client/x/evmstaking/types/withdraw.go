@@ -4,6 +4,8 @@ import (
 	"strings"
 
 	"cosmossdk.io/core/address"
+
+	"github.com/piplabs/story/lib/errors"
 )
 
 // Withdrawals is a collection of Withdrawal.
@@ -32,3 +34,15 @@ func NewWithdrawal(creationHeight uint64, executionAddr string, amount uint64) W
 		Amount:           amount,
 	}
 }
+
+// NewRefundWithdrawal returns a withdrawal that refunds the amount of a failed EL stake event,
+// tagged with the code of the failure.
+func NewRefundWithdrawal(creationHeight uint64, executionAddr string, amount uint64, code errors.ErrCode) Withdrawal {
+	return Withdrawal{
+		CreationHeight:   creationHeight,
+		ExecutionAddress: executionAddr,
+		Amount:           amount,
+		IsRefund:         true,
+		RefundCode:       uint32(code),
+	}
+}
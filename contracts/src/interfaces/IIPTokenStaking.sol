@@ -70,6 +70,11 @@ interface IIPTokenStaking {
     /// @param commissionRate The new commission rate of the validator.
     event UpdateValidatorCommssion(bytes validatorUncmpPubkey, uint32 commissionRate);
 
+    /// @notice Emitted when the fee recipient of the validator's proposed execution blocks is set/changed.
+    /// @param validatorUncmpPubkey 65 bytes uncompressed secp256k1 public key.
+    /// @param feeRecipient EVM address to receive the fees of the blocks proposed by the validator.
+    event SetFeeRecipient(bytes validatorUncmpPubkey, address feeRecipient);
+
     /// @notice Emitted when a user deposits token into the contract.
     /// @param delegatorUncmpPubkey Delegator's 65 bytes uncompressed secp256k1 public key.
     /// @param validatorUncmpPubkey Validator's 65 bytes uncompressed secp256k1 public key.
@@ -130,6 +135,12 @@ interface IIPTokenStaking {
     /// @param operator address
     event RemoveOperator(bytes uncmpPubkey, address operator);
 
+    /// @notice Emitted to request setting the permissions of an operator of a delegator
+    /// @param uncmpPubkey delegator's 65 bytes uncompressed secp256k1 public key.
+    /// @param operator address
+    /// @param permissions bitmask of the permissions granted to the operator
+    event SetOperatorPermissions(bytes uncmpPubkey, address operator, uint32 permissions);
+
     /// @notice Emitted when the minimum stake amount is set.
     /// @param minStakeAmount The new minimum stake amount.
     event MinStakeAmountSet(uint256 minStakeAmount);
@@ -161,6 +172,13 @@ interface IIPTokenStaking {
     /// @param operator The operator address to remove.
     function removeOperator(bytes calldata uncmpPubkey, address operator) external;
 
+    /// @notice Sets the permissions of an operator for a delegator, adding the operator if needed.
+    /// Charges fee for adding to CL storage. Must be exact amount.
+    /// @param uncmpPubkey 65 bytes uncompressed secp256k1 public key.
+    /// @param operator The operator address.
+    /// @param permissions The bitmask of the permissions granted to the operator.
+    function setOperatorPermissions(bytes calldata uncmpPubkey, address operator, uint32 permissions) external payable;
+
     /// @notice Set/Update the withdrawal address that receives the withdrawals.
     /// Charges fee for adding to CL storage. Must be exact amount.
     /// @param delegatorUncmpPubkey Delegator's 65 bytes uncompressed secp256k1 public key.
@@ -179,6 +197,12 @@ interface IIPTokenStaking {
     /// @param commissionRate The new commission rate of the validator.
     function updateValidatorCommission(bytes calldata validatorUncmpPubkey, uint32 commissionRate) external payable;
 
+    /// @notice Set/Update the fee recipient of the execution blocks proposed by a validator.
+    /// Charges fee for adding to CL storage. Must be exact amount.
+    /// @param validatorUncmpPubkey 65 bytes uncompressed secp256k1 public key.
+    /// @param feeRecipient EVM address to receive the fees of the blocks proposed by the validator.
+    function setFeeRecipient(bytes calldata validatorUncmpPubkey, address feeRecipient) external payable;
+
     /// @notice Entry point for creating a new validator with self delegation.
     /// @dev The caller must provide the uncompressed public key that matches the expected EVM address.
     /// Use this method to make sure the caller is the owner of the validator.
@@ -14,6 +14,13 @@ interface IUBIPool {
     /// @param amounts The amounts of the UBI for each validator
     event UBIDistributionSet(uint256 month, uint256 totalUBI, bytes[] validatorUncmpPubKeys, uint256[] amounts);
 
+    /// @notice Emitted when a validator claims its UBI for a distribution
+    /// @param distributionId The distribution id
+    /// @param validatorUncmpPubkey The validator uncompressed public key
+    /// @param recipient The address receiving the UBI
+    /// @param amount The amount of UBI claimed
+    event UBIClaimed(uint256 distributionId, bytes validatorUncmpPubkey, address recipient, uint256 amount);
+
     /// @notice Sets the UBI percentage
     /// @param percentage The percentage of the UBI
     function setUBIPercentage(uint32 percentage) external;
@@ -163,6 +163,19 @@ contract IPTokenStaking is IIPTokenStaking, Ownable2StepUpgradeable, ReentrancyG
         emit RemoveOperator(uncmpPubkey, operator);
     }
 
+    /// @notice Sets the permissions of an operator for a delegator, adding the operator if needed.
+    /// @param uncmpPubkey 65 bytes uncompressed secp256k1 public key.
+    /// @param operator The operator address.
+    /// @param permissions The bitmask of the permissions granted to the operator.
+    function setOperatorPermissions(
+        bytes calldata uncmpPubkey,
+        address operator,
+        uint32 permissions
+    ) external payable verifyUncmpPubkeyWithExpectedAddress(uncmpPubkey, msg.sender) chargesFee {
+        require(permissions != 0, "IPTokenStaking: Zero operator permissions");
+        emit SetOperatorPermissions(uncmpPubkey, operator, permissions);
+    }
+
     /*//////////////////////////////////////////////////////////////////////////
     //                     Staking Configuration functions                    //
     //////////////////////////////////////////////////////////////////////////*/
@@ -312,6 +325,17 @@ contract IPTokenStaking is IIPTokenStaking, Ownable2StepUpgradeable, ReentrancyG
         emit UpdateValidatorCommssion(validatorUncmpPubkey, commissionRate);
     }
 
+    /// @notice Set/Update the fee recipient of the execution blocks proposed by a validator.
+    /// @param validatorUncmpPubkey 65 bytes uncompressed secp256k1 public key.
+    /// @param feeRecipient EVM address to receive the fees of the blocks proposed by the validator.
+    function setFeeRecipient(
+        bytes calldata validatorUncmpPubkey,
+        address feeRecipient
+    ) external payable verifyUncmpPubkeyWithExpectedAddress(validatorUncmpPubkey, msg.sender) chargesFee {
+        require(feeRecipient != address(0), "IPTokenStaking: Zero fee recipient");
+        emit SetFeeRecipient(validatorUncmpPubkey, feeRecipient);
+    }
+
     /*//////////////////////////////////////////////////////////////////////////
     //                             Token Staking                              //
     //////////////////////////////////////////////////////////////////////////*/
@@ -88,5 +88,6 @@ contract UBIPool is
         validatorUBIAmounts[distributionId][validatorUncmpPubkey] = 0;
         (bool success, ) = msg.sender.call{ value: amount }("");
         require(success, "UBIPool: failed to send UBI");
+        emit UBIClaimed(distributionId, validatorUncmpPubkey, msg.sender, amount);
     }
 }
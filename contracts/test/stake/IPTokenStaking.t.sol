@@ -624,6 +624,33 @@ contract IPTokenStakingTest is Test {
         ipTokenStaking.updateValidatorCommission{ value: feeAmount - 1 }(delegatorUncmpPubkey, commissionRate);
     }
 
+    function testIPTokenStaking_setFeeRecipient() public {
+        address feeRecipient = address(0xb0b);
+        uint256 feeAmount = ipTokenStaking.fee();
+        vm.deal(delegatorAddr, feeAmount * 10);
+        vm.prank(delegatorAddr);
+        vm.expectEmit(address(ipTokenStaking));
+        emit IIPTokenStaking.SetFeeRecipient(delegatorUncmpPubkey, feeRecipient);
+        ipTokenStaking.setFeeRecipient{ value: feeAmount }(delegatorUncmpPubkey, feeRecipient);
+
+        // Network shall not allow anyone to set the fee recipient of a validator if it is not the validator itself.
+        address otherAddress = address(0xf398c12A45BC409b6C652e25bb0A3e702492A4AA);
+        vm.deal(otherAddress, feeAmount * 10);
+        vm.prank(otherAddress);
+        vm.expectRevert("PubKeyVerifier: Invalid pubkey derived address");
+        ipTokenStaking.setFeeRecipient{ value: feeAmount }(delegatorUncmpPubkey, feeRecipient);
+
+        // Network shall not allow the zero address as fee recipient.
+        vm.prank(delegatorAddr);
+        vm.expectRevert("IPTokenStaking: Zero fee recipient");
+        ipTokenStaking.setFeeRecipient{ value: feeAmount }(delegatorUncmpPubkey, address(0));
+
+        // Network shall not allow anyone to set the fee recipient of a validator if the fee is not paid.
+        vm.prank(delegatorAddr);
+        vm.expectRevert("IPTokenStaking: Invalid fee amount");
+        ipTokenStaking.setFeeRecipient{ value: feeAmount - 1 }(delegatorUncmpPubkey, feeRecipient);
+    }
+
     function testIPTokenStaking_addOperator() public {
         // Network shall not allow others to add operators for a delegator
         address operator = address(0xf398c12A45BC409b6C652e25bb0A3e702492A4AA);
@@ -672,6 +699,35 @@ contract IPTokenStakingTest is Test {
         ipTokenStaking.removeOperator(delegatorUncmpPubkey, operator);
     }
 
+    function testIPTokenStaking_setOperatorPermissions() public {
+        address operator = address(0xf398c12A45BC409b6C652e25bb0A3e702492A4AA);
+        uint32 permissions = 1;
+        uint256 feeAmount = 1 ether;
+        // Network shall not allow others to set the operator permissions of a delegator
+        bytes
+            memory otherDelegatorUncmpPubkey = hex"04e38d15ae6cc5d41cce27a2307903cb12a406cbf463fe5fef215bdf8aa988ced195e9327ac89cd362eaa0397f8d7f007c02b2a75642f174e455d339e4a1000000"; // pragma: allowlist secret
+        vm.deal(delegatorAddr, feeAmount);
+        vm.prank(delegatorAddr);
+        vm.expectRevert("PubKeyVerifier: Invalid pubkey derived address");
+        ipTokenStaking.setOperatorPermissions{ value: feeAmount }(otherDelegatorUncmpPubkey, operator, permissions);
+
+        // Network shall not allow anyone to set the operator permissions if the fee is not paid.
+        vm.prank(delegatorAddr);
+        vm.expectRevert("IPTokenStaking: Invalid fee amount");
+        ipTokenStaking.setOperatorPermissions(delegatorUncmpPubkey, operator, permissions);
+
+        // Network shall not allow operators without permissions
+        vm.prank(delegatorAddr);
+        vm.expectRevert("IPTokenStaking: Zero operator permissions");
+        ipTokenStaking.setOperatorPermissions{ value: feeAmount }(delegatorUncmpPubkey, operator, 0);
+
+        // Network should allow delegators to set the permissions of their operators
+        vm.prank(delegatorAddr);
+        vm.expectEmit(address(ipTokenStaking));
+        emit IIPTokenStaking.SetOperatorPermissions(delegatorUncmpPubkey, operator, permissions);
+        ipTokenStaking.setOperatorPermissions{ value: feeAmount }(delegatorUncmpPubkey, operator, permissions);
+    }
+
     function testIPTokenStaking_setMinStakeAmount() public {
         // Set amount that will be rounded down to 0
         performTimelocked(
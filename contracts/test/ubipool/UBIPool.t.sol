@@ -61,6 +61,8 @@ contract UBIPoolTest is Test, ValidatorData {
             vm.prank(validators[i].evmAddress);
             uint256 balanceBefore = address(validators[i].evmAddress).balance;
             uint256 poolBalanceBefore = address(ubiPool).balance;
+            vm.expectEmit(address(ubiPool));
+            emit IUBIPool.UBIClaimed(1, validatorUncmpPubKeys[i], validators[i].evmAddress, amount);
             ubiPool.claimUBI(1, validatorUncmpPubKeys[i]);
             assertEq(address(validators[i].evmAddress).balance, balanceBefore + amount);
             assertEq(address(ubiPool).balance, poolBalanceBefore - amount);
@@ -34,4 +34,19 @@ contract UpgradeEntrypointTest is Test {
         vm.expectRevert();
         upgradeEntrypoint.planUpgrade(name, height, info);
     }
+
+    function testUpgradeEntrypoint_cancelUpgrade() public {
+        // Network shall allow the protocol owner to cancel an upgrade plan.
+        schedule(address(upgradeEntrypoint), abi.encodeWithSelector(IUpgradeEntrypoint.cancelUpgrade.selector));
+        waitForTimelock();
+        vm.expectEmit(address(upgradeEntrypoint));
+        emit IUpgradeEntrypoint.CancelUpgrade();
+        executeTimelocked(address(upgradeEntrypoint), abi.encodeWithSelector(IUpgradeEntrypoint.cancelUpgrade.selector));
+
+        // Network shall not allow non-protocol owner to cancel an upgrade plan.
+        address otherAddr = address(0xf398C12A45Bc409b6C652E25bb0a3e702492A4ab);
+        vm.prank(otherAddr);
+        vm.expectRevert();
+        upgradeEntrypoint.cancelUpgrade();
+    }
 }
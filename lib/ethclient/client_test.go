@@ -11,11 +11,13 @@ import (
 
 	"github.com/ethereum/go-ethereum/beacon/engine"
 	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
 	"github.com/golang-jwt/jwt/v5"
 	fuzz "github.com/google/gofuzz"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 
+	"github.com/piplabs/story/lib/errors"
 	"github.com/piplabs/story/lib/ethclient"
 )
 
@@ -53,6 +55,24 @@ func TestGetPayloadV3(t *testing.T) {
 	testEndpoint(t, call, resp, param1)
 }
 
+func TestGetPayloadV4(t *testing.T) {
+	t.Parallel()
+	fuzzer := fuzz.New().NilChance(0)
+
+	var param1 engine.PayloadID
+	fuzzer.Fuzz(&param1)
+
+	var resp ethclient.ExecutionPayloadEnvelopeV4
+	fuzzer.Fuzz(&resp.ExecutionPayloadEnvelope)
+	fuzzer.Fuzz(&resp.ExecutionRequests)
+
+	call := func(ctx context.Context, engineCl ethclient.EngineClient) (any, error) {
+		return engineCl.GetPayloadV4(ctx, param1)
+	}
+
+	testEndpoint(t, call, resp, param1)
+}
+
 func TestNewPayloadV2(t *testing.T) {
 	t.Parallel()
 	fuzzer := fuzz.New().NilChance(0)
@@ -93,6 +113,32 @@ func TestNewPayloadV3(t *testing.T) {
 	testEndpoint(t, call, resp, param1, param2, param3)
 }
 
+func TestNewPayloadV4(t *testing.T) {
+	t.Parallel()
+	fuzzer := fuzz.New().NilChance(0)
+
+	var param1 engine.ExecutableData
+	fuzzer.Fuzz(&param1)
+
+	var param2 []common.Hash
+	fuzzer.Fuzz(&param2)
+
+	var param3 common.Hash
+	fuzzer.Fuzz(&param3)
+
+	var param4 []hexutil.Bytes
+	fuzzer.Fuzz(&param4)
+
+	var resp engine.PayloadStatusV1
+	fuzzer.Fuzz(&resp)
+
+	call := func(ctx context.Context, engineCl ethclient.EngineClient) (any, error) {
+		return engineCl.NewPayloadV4(ctx, param1, param2, &param3, param4)
+	}
+
+	testEndpoint(t, call, resp, param1, param2, param3, param4)
+}
+
 func TestForkchoiceUpdatedV2(t *testing.T) {
 	t.Parallel()
 	fuzzer := fuzz.New().NilChance(0)
@@ -133,6 +179,82 @@ func TestForkchoiceUpdatedV3(t *testing.T) {
 	testEndpoint(t, call, resp, param1, param2)
 }
 
+func TestEngineErrors(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name      string
+		code      int
+		target    error
+		retryable bool
+	}{
+		{name: "unknown payload", code: -38001, target: ethclient.ErrUnknownPayload},
+		{name: "invalid forkchoice state", code: -38002, target: ethclient.ErrInvalidForkchoiceState},
+		{name: "invalid payload attributes", code: -38003, target: ethclient.ErrInvalidPayloadAttributes},
+		{name: "too large request", code: -38004, target: ethclient.ErrTooLargeRequest},
+		{name: "unsupported fork", code: -38005, target: ethclient.ErrUnsupportedFork},
+		{name: "invalid params", code: -32602, target: ethclient.ErrInvalidParams},
+		{name: "server error", code: -32000, retryable: true},
+		{name: "server error range", code: -32099, retryable: true},
+		{name: "method not found", code: -32601},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				var rpcReq jsonRPCRequest
+				assert.NoError(t, json.NewDecoder(r.Body).Decode(&rpcReq))
+
+				buf, err := json.Marshal(jsonRPCErrorResponse{
+					JSONRPC: "2.0",
+					ID:      rpcReq.ID,
+					Error: jsonRPCError{
+						Code:    tt.code,
+						Message: tt.name,
+						Data:    "details",
+					},
+				})
+				assert.NoError(t, err)
+
+				_, _ = w.Write(buf)
+			}))
+			defer srv.Close()
+
+			ctx := context.Background()
+			api, err := ethclient.NewAuthClient(ctx, srv.URL, nil)
+			require.NoError(t, err)
+
+			_, err = api.GetPayloadV3(ctx, engine.PayloadID{})
+			require.Error(t, err)
+			require.Contains(t, err.Error(), "rpc get payload v3")
+			require.Equal(t, tt.retryable, ethclient.IsRetryable(err))
+
+			var engErr *ethclient.EngineError
+			require.ErrorAs(t, err, &engErr)
+			require.Equal(t, tt.code, engErr.Code)
+			require.Equal(t, tt.name, engErr.Message)
+			require.Equal(t, "details", engErr.Data)
+
+			if tt.target != nil {
+				require.ErrorIs(t, err, tt.target)
+			}
+		})
+	}
+}
+
+func TestIsRetryable(t *testing.T) {
+	t.Parallel()
+
+	require.False(t, ethclient.IsRetryable(nil))
+	require.True(t, ethclient.IsRetryable(context.DeadlineExceeded))
+	require.True(t, ethclient.IsRetryable(errors.Wrap(errors.New("connection refused"), "rpc new payload v3")))
+	require.False(t, ethclient.IsRetryable(errors.Wrap(ethclient.ErrUnknownPayload, "rpc get payload v3")))
+	require.False(t, ethclient.IsRetryable(engine.UnsupportedFork))
+	require.True(t, ethclient.IsRetryable(engine.GenericServerError))
+}
+
 func testEndpoint(t *testing.T, callback func(context.Context, ethclient.EngineClient) (any, error),
 	resp any, params ...any,
 ) {
@@ -197,6 +319,18 @@ type jsonRPCRequest struct {
 	Params  []json.RawMessage `json:"params"`
 }
 
+type jsonRPCErrorResponse struct {
+	JSONRPC string       `json:"jsonrpc"`
+	ID      any          `json:"id"`
+	Error   jsonRPCError `json:"error"`
+}
+
+type jsonRPCError struct {
+	Code    int    `json:"code"`
+	Message string `json:"message"`
+	Data    any    `json:"data,omitempty"`
+}
+
 type jsonRPCResponse struct {
 	JSONRPC string `json:"jsonrpc"`
 	ID      any    `json:"id"`
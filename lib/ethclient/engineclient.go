@@ -7,6 +7,7 @@ import (
 
 	"github.com/ethereum/go-ethereum/beacon/engine"
 	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
 	"github.com/ethereum/go-ethereum/rpc"
 
 	"github.com/piplabs/story/lib/errors"
@@ -17,16 +18,19 @@ const (
 
 	newPayloadV2 = "engine_newPayloadV2"
 	newPayloadV3 = "engine_newPayloadV3"
+	newPayloadV4 = "engine_newPayloadV4"
 
 	forkchoiceUpdatedV2 = "engine_forkchoiceUpdatedV2"
 	forkchoiceUpdatedV3 = "engine_forkchoiceUpdatedV3"
 
 	getPayloadV2 = "engine_getPayloadV2"
 	getPayloadV3 = "engine_getPayloadV3"
+	getPayloadV4 = "engine_getPayloadV4"
 )
 
 // EngineClient defines the Engine API authenticated JSON-RPC endpoints.
 // It extends the normal Client interface with the Engine API.
+// JSON-RPC error objects are returned as wrapped *EngineError, see IsRetryable.
 type EngineClient interface {
 	Client
 
@@ -35,6 +39,9 @@ type EngineClient interface {
 	// NewPayloadV3 creates an Eth1 block, inserts it in the chain, and returns the status of the chain.
 	NewPayloadV3(ctx context.Context, params engine.ExecutableData, versionedHashes []common.Hash,
 		beaconRoot *common.Hash) (engine.PayloadStatusV1, error)
+	// NewPayloadV4 is equivalent to V3 with the addition of the EIP-7685 execution requests (Prague).
+	NewPayloadV4(ctx context.Context, params engine.ExecutableData, versionedHashes []common.Hash,
+		beaconRoot *common.Hash, executionRequests []hexutil.Bytes) (engine.PayloadStatusV1, error)
 
 	// ForkchoiceUpdatedV2 has several responsibilities:
 	//  - It sets the chain the head.
@@ -51,6 +58,8 @@ type EngineClient interface {
 	GetPayloadV2(ctx context.Context, payloadID engine.PayloadID) (*engine.ExecutionPayloadEnvelope, error)
 	// GetPayloadV3 returns a cached payload by id.
 	GetPayloadV3(ctx context.Context, payloadID engine.PayloadID) (*engine.ExecutionPayloadEnvelope, error)
+	// GetPayloadV4 returns a cached payload by id, including its EIP-7685 execution requests (Prague).
+	GetPayloadV4(ctx context.Context, payloadID engine.PayloadID) (*ExecutionPayloadEnvelopeV4, error)
 }
 
 // engineClient implements EngineClient using JSON-RPC.
@@ -85,7 +94,7 @@ func (c engineClient) NewPayloadV2(ctx context.Context, params engine.Executable
 	err := c.cl.Client().CallContext(ctx, &resp, newPayloadV2, params)
 	if err != nil {
 		incError(c.chain, endpoint)
-		return engine.PayloadStatusV1{}, errors.Wrap(err, "rpc new payload v2")
+		return engine.PayloadStatusV1{}, errors.Wrap(toEngineError(err), "rpc new payload v2")
 	}
 
 	return resp, nil
@@ -101,7 +110,23 @@ func (c engineClient) NewPayloadV3(ctx context.Context, params engine.Executable
 	err := c.cl.Client().CallContext(ctx, &resp, newPayloadV3, params, versionedHashes, beaconRoot)
 	if err != nil {
 		incError(c.chain, endpoint)
-		return engine.PayloadStatusV1{}, errors.Wrap(err, "rpc new payload v3")
+		return engine.PayloadStatusV1{}, errors.Wrap(toEngineError(err), "rpc new payload v3")
+	}
+
+	return resp, nil
+}
+
+func (c engineClient) NewPayloadV4(ctx context.Context, params engine.ExecutableData, versionedHashes []common.Hash,
+	beaconRoot *common.Hash, executionRequests []hexutil.Bytes,
+) (engine.PayloadStatusV1, error) {
+	const endpoint = "new_payload_v4"
+	defer latency(c.chain, endpoint)()
+
+	var resp engine.PayloadStatusV1
+	err := c.cl.Client().CallContext(ctx, &resp, newPayloadV4, params, versionedHashes, beaconRoot, executionRequests)
+	if err != nil {
+		incError(c.chain, endpoint)
+		return engine.PayloadStatusV1{}, errors.Wrap(toEngineError(err), "rpc new payload v4")
 	}
 
 	return resp, nil
@@ -117,7 +142,7 @@ func (c engineClient) ForkchoiceUpdatedV2(ctx context.Context, update engine.For
 	err := c.cl.Client().CallContext(ctx, &resp, forkchoiceUpdatedV2, update, payloadAttributes)
 	if err != nil {
 		incError(c.chain, endpoint)
-		return engine.ForkChoiceResponse{}, errors.Wrap(err, "rpc forkchoice updated v2")
+		return engine.ForkChoiceResponse{}, errors.Wrap(toEngineError(err), "rpc forkchoice updated v2")
 	}
 
 	return resp, nil
@@ -133,7 +158,7 @@ func (c engineClient) ForkchoiceUpdatedV3(ctx context.Context, update engine.For
 	err := c.cl.Client().CallContext(ctx, &resp, forkchoiceUpdatedV3, update, payloadAttributes)
 	if err != nil {
 		incError(c.chain, endpoint)
-		return engine.ForkChoiceResponse{}, errors.Wrap(err, "rpc forkchoice updated v3")
+		return engine.ForkChoiceResponse{}, errors.Wrap(toEngineError(err), "rpc forkchoice updated v3")
 	}
 
 	return resp, nil
@@ -149,7 +174,7 @@ func (c engineClient) GetPayloadV2(ctx context.Context, payloadID engine.Payload
 	err := c.cl.Client().CallContext(ctx, &resp, getPayloadV2, payloadID)
 	if err != nil {
 		incError(c.chain, endpoint)
-		return nil, errors.Wrap(err, "rpc get payload v2")
+		return nil, errors.Wrap(toEngineError(err), "rpc get payload v2")
 	}
 
 	return &resp, nil
@@ -165,7 +190,23 @@ func (c engineClient) GetPayloadV3(ctx context.Context, payloadID engine.Payload
 	err := c.cl.Client().CallContext(ctx, &resp, getPayloadV3, payloadID)
 	if err != nil {
 		incError(c.chain, endpoint)
-		return nil, errors.Wrap(err, "rpc get payload v3")
+		return nil, errors.Wrap(toEngineError(err), "rpc get payload v3")
+	}
+
+	return &resp, nil
+}
+
+func (c engineClient) GetPayloadV4(ctx context.Context, payloadID engine.PayloadID) (
+	*ExecutionPayloadEnvelopeV4, error,
+) {
+	const endpoint = "get_payload_v4"
+	defer latency(c.chain, endpoint)()
+
+	var resp ExecutionPayloadEnvelopeV4
+	err := c.cl.Client().CallContext(ctx, &resp, getPayloadV4, payloadID)
+	if err != nil {
+		incError(c.chain, endpoint)
+		return nil, errors.Wrap(toEngineError(err), "rpc get payload v4")
 	}
 
 	return &resp, nil
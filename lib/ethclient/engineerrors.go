@@ -0,0 +1,117 @@
+package ethclient
+
+import (
+	"fmt"
+
+	"github.com/ethereum/go-ethereum/rpc"
+
+	"github.com/piplabs/story/lib/errors"
+)
+
+// Engine API and JSON-RPC error codes, see https://github.com/ethereum/execution-apis/blob/main/src/engine/common.md#errors.
+const (
+	codeServerErrorMax      = -32000
+	codeServerErrorMin      = -32099
+	codeInvalidParams       = -32602
+	codeUnknownPayload      = -38001
+	codeInvalidForkchoice   = -38002
+	codeInvalidPayloadAttrs = -38003
+	codeTooLargeRequest     = -38004
+	codeUnsupportedFork     = -38005
+)
+
+// Typed Engine API errors. Use errors.Is to check whether an error returned by the EngineClient
+// is one of these, it matches any EngineError with the same code, irrespective of message or data.
+var (
+	ErrUnknownPayload           = &EngineError{Code: codeUnknownPayload, Message: "Unknown payload"}
+	ErrInvalidForkchoiceState   = &EngineError{Code: codeInvalidForkchoice, Message: "Invalid forkchoice state"}
+	ErrInvalidPayloadAttributes = &EngineError{Code: codeInvalidPayloadAttrs, Message: "Invalid payload attributes"}
+	ErrTooLargeRequest          = &EngineError{Code: codeTooLargeRequest, Message: "Too large request"}
+	ErrUnsupportedFork          = &EngineError{Code: codeUnsupportedFork, Message: "Unsupported fork"}
+	ErrInvalidParams            = &EngineError{Code: codeInvalidParams, Message: "Invalid params"}
+)
+
+var _ rpc.DataError = (*EngineError)(nil)
+
+// EngineError is a JSON-RPC error object returned by the execution client.
+type EngineError struct {
+	Code    int
+	Message string
+	Data    any
+}
+
+func (e *EngineError) Error() string {
+	if e.Data != nil {
+		return fmt.Sprintf("%s (code=%d, data=%v)", e.Message, e.Code, e.Data)
+	}
+
+	return fmt.Sprintf("%s (code=%d)", e.Message, e.Code)
+}
+
+// ErrorCode returns the JSON-RPC error code.
+func (e *EngineError) ErrorCode() int {
+	return e.Code
+}
+
+// ErrorData returns the JSON-RPC error data, if any.
+func (e *EngineError) ErrorData() any {
+	return e.Data
+}
+
+// Is returns true if the target is an EngineError with the same code.
+func (e *EngineError) Is(target error) bool {
+	var t *EngineError
+	if !errors.As(target, &t) {
+		return false
+	}
+
+	return t.Code == e.Code
+}
+
+// IsServerError returns true if the error is a JSON-RPC server error (-32000 to -32099).
+// These are implementation defined, e.g. the execution client is temporarily unavailable.
+func (e *EngineError) IsServerError() bool {
+	return e.Code >= codeServerErrorMin && e.Code <= codeServerErrorMax
+}
+
+// toEngineError converts JSON-RPC error objects into typed EngineErrors.
+// Other errors (e.g. networking or decoding errors) are returned as is.
+func toEngineError(err error) error {
+	var engErr *EngineError
+	if errors.As(err, &engErr) {
+		return err
+	}
+
+	var rpcErr rpc.Error
+	if !errors.As(err, &rpcErr) {
+		return err
+	}
+
+	var data any
+	var dataErr rpc.DataError
+	if errors.As(err, &dataErr) {
+		data = dataErr.ErrorData()
+	}
+
+	return &EngineError{
+		Code:    rpcErr.ErrorCode(),
+		Message: rpcErr.Error(),
+		Data:    data,
+	}
+}
+
+// IsRetryable returns true if an Engine API call that failed with the error may succeed when retried.
+// Errors that aren't JSON-RPC error objects (e.g. networking faults or timeouts) and JSON-RPC
+// server errors are retryable. All other JSON-RPC errors are permanent rejections of the request.
+func IsRetryable(err error) bool {
+	if err == nil {
+		return false
+	}
+
+	var engErr *EngineError
+	if !errors.As(toEngineError(err), &engErr) {
+		return true
+	}
+
+	return engErr.IsServerError()
+}
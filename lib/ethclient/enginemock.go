@@ -19,6 +19,7 @@ import (
 	"github.com/ethereum/go-ethereum/accounts/abi/bind"
 	"github.com/ethereum/go-ethereum/beacon/engine"
 	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
 	"github.com/ethereum/go-ethereum/core/types"
 	"github.com/ethereum/go-ethereum/params"
 	"github.com/ethereum/go-ethereum/rlp"
@@ -38,8 +39,9 @@ const (
 )
 
 type payloadArgs struct {
-	params     engine.ExecutableData
-	beaconRoot *common.Hash
+	params            engine.ExecutableData
+	beaconRoot        *common.Hash
+	executionRequests []hexutil.Bytes
 }
 
 //nolint:gochecknoglobals // This is a static mapping.
@@ -61,6 +63,10 @@ type engineMock struct {
 	// headKey is the key to store the head block.
 	headKey      []byte
 	genesisBlock *types.Block
+	// pragueTime is the Prague fork timestamp, from which the V4 payload methods must be used.
+	pragueTime *uint64
+	// executionRequests are included in the payloads built after the Prague fork.
+	executionRequests []hexutil.Bytes
 	// consider the following maps also dependent on sdk.Context if needed.
 	pendingLogs map[common.Address][]types.Log
 	logs        map[common.Hash][]types.Log
@@ -101,6 +107,27 @@ func WithMockSelfDelegation(pubkey crypto.PubKey, ether int64) func(*engineMock)
 	}
 }
 
+// WithMockPragueTime returns an option to activate the Prague fork at the given timestamp.
+func WithMockPragueTime(timestamp uint64) func(*engineMock) {
+	return func(mock *engineMock) {
+		mock.mu.Lock()
+		defer mock.mu.Unlock()
+
+		mock.pragueTime = &timestamp
+	}
+}
+
+// WithMockExecutionRequests returns an option to include the execution requests in the payloads
+// built after the Prague fork.
+func WithMockExecutionRequests(requests ...hexutil.Bytes) func(*engineMock) {
+	return func(mock *engineMock) {
+		mock.mu.Lock()
+		defer mock.mu.Unlock()
+
+		mock.executionRequests = requests
+	}
+}
+
 type randomErrKey struct{}
 
 // WithRandomErr returns a context that results in random engineMock errors.
@@ -162,6 +189,11 @@ func NewEngineMock(key *storetypes.KVStoreKey, opts ...func(mock *engineMock)) (
 	return m, nil
 }
 
+// isPrague returns true if the Prague fork is active at the given timestamp.
+func (m *engineMock) isPrague(timestamp uint64) bool {
+	return m.pragueTime != nil && timestamp >= *m.pragueTime
+}
+
 func (m *engineMock) maybeErr(ctx context.Context) error {
 	if !hasRandomErr(ctx) {
 		return nil
@@ -321,6 +353,28 @@ func (m *engineMock) BlockByNumber(ctx context.Context, number *big.Int) (*types
 }
 
 func (m *engineMock) NewPayloadV3(ctx context.Context, params engine.ExecutableData, _ []common.Hash, beaconRoot *common.Hash) (engine.PayloadStatusV1, error) {
+	if m.isPrague(params.Timestamp) {
+		return engine.PayloadStatusV1{}, ErrUnsupportedFork
+	}
+
+	return m.newPayload(ctx, params, beaconRoot, nil)
+}
+
+func (m *engineMock) NewPayloadV4(ctx context.Context, params engine.ExecutableData, _ []common.Hash, beaconRoot *common.Hash,
+	executionRequests []hexutil.Bytes,
+) (engine.PayloadStatusV1, error) {
+	if !m.isPrague(params.Timestamp) {
+		return engine.PayloadStatusV1{}, ErrUnsupportedFork
+	} else if executionRequests == nil {
+		return engine.PayloadStatusV1{}, errors.Wrap(ErrInvalidParams, "nil executionRequests post-prague")
+	}
+
+	return m.newPayload(ctx, params, beaconRoot, executionRequests)
+}
+
+func (m *engineMock) newPayload(ctx context.Context, params engine.ExecutableData, beaconRoot *common.Hash,
+	executionRequests []hexutil.Bytes,
+) (engine.PayloadStatusV1, error) {
 	if err := m.maybeErr(ctx); err != nil {
 		return engine.PayloadStatusV1{}, err
 	}
@@ -331,8 +385,9 @@ func (m *engineMock) NewPayloadV3(ctx context.Context, params engine.ExecutableD
 	// if Withdrawals is nil, cannot rlp encode and decode properly.
 	params.Withdrawals = make([]*types.Withdrawal, 0)
 	args := payloadArgs{
-		params:     params,
-		beaconRoot: beaconRoot,
+		params:            params,
+		beaconRoot:        beaconRoot,
+		executionRequests: executionRequests,
 	}
 
 	id, err := MockPayloadID(args.params, args.beaconRoot)
@@ -419,6 +474,9 @@ func (m *engineMock) ForkchoiceUpdatedV3(ctx context.Context, update engine.Fork
 		}
 
 		args := payloadArgs{params: payload, beaconRoot: attrs.BeaconRoot}
+		if m.isPrague(attrs.Timestamp) {
+			args.executionRequests = m.executionRequests
+		}
 
 		id, err := MockPayloadID(args.params, args.beaconRoot)
 		if err != nil {
@@ -439,8 +497,42 @@ func (m *engineMock) ForkchoiceUpdatedV3(ctx context.Context, update engine.Fork
 }
 
 func (m *engineMock) GetPayloadV3(ctx context.Context, payloadID engine.PayloadID) (*engine.ExecutionPayloadEnvelope, error) {
-	if err := m.maybeErr(ctx); err != nil {
+	args, err := m.getPayload(ctx, payloadID)
+	if err != nil {
+		return nil, err
+	} else if m.isPrague(args.params.Timestamp) {
+		return nil, ErrUnsupportedFork
+	}
+
+	return &engine.ExecutionPayloadEnvelope{
+		ExecutionPayload: &args.params,
+	}, nil
+}
+
+func (m *engineMock) GetPayloadV4(ctx context.Context, payloadID engine.PayloadID) (*ExecutionPayloadEnvelopeV4, error) {
+	args, err := m.getPayload(ctx, payloadID)
+	if err != nil {
 		return nil, err
+	} else if !m.isPrague(args.params.Timestamp) {
+		return nil, ErrUnsupportedFork
+	}
+
+	requests := args.executionRequests
+	if requests == nil {
+		requests = make([]hexutil.Bytes, 0)
+	}
+
+	return &ExecutionPayloadEnvelopeV4{
+		ExecutionPayloadEnvelope: engine.ExecutionPayloadEnvelope{
+			ExecutionPayload: &args.params,
+		},
+		ExecutionRequests: requests,
+	}, nil
+}
+
+func (m *engineMock) getPayload(ctx context.Context, payloadID engine.PayloadID) (payloadArgs, error) {
+	if err := m.maybeErr(ctx); err != nil {
+		return payloadArgs{}, err
 	}
 
 	m.mu.Lock()
@@ -448,12 +540,10 @@ func (m *engineMock) GetPayloadV3(ctx context.Context, payloadID engine.PayloadI
 
 	args, ok := m.payloads[payloadID]
 	if !ok {
-		return nil, errors.New("payload not found")
+		return payloadArgs{}, ErrUnknownPayload
 	}
 
-	return &engine.ExecutionPayloadEnvelope{
-		ExecutionPayload: &args.params,
-	}, nil
+	return args, nil
 }
 
 // TODO(corver): Add support for V3
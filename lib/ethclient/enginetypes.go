@@ -0,0 +1,67 @@
+package ethclient
+
+import (
+	"encoding/json"
+
+	"github.com/ethereum/go-ethereum/beacon/engine"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+
+	"github.com/piplabs/story/lib/errors"
+)
+
+// ExecutionPayloadEnvelopeV4 is the response of engine_getPayloadV4. It extends
+// engine.ExecutionPayloadEnvelope with the EIP-7685 execution requests, which
+// aren't supported by the go-ethereum engine types yet.
+type ExecutionPayloadEnvelopeV4 struct {
+	engine.ExecutionPayloadEnvelope
+	ExecutionRequests []hexutil.Bytes
+}
+
+// MarshalJSON marshals the envelope, adding the execution requests to the embedded envelope fields.
+func (e ExecutionPayloadEnvelopeV4) MarshalJSON() ([]byte, error) {
+	bz, err := e.ExecutionPayloadEnvelope.MarshalJSON()
+	if err != nil {
+		return nil, errors.Wrap(err, "marshal envelope")
+	}
+
+	var fields map[string]json.RawMessage
+	if err := json.Unmarshal(bz, &fields); err != nil {
+		return nil, errors.Wrap(err, "unmarshal envelope fields")
+	}
+
+	requests := e.ExecutionRequests
+	if requests == nil {
+		requests = make([]hexutil.Bytes, 0) // Execution requests are required in V4.
+	}
+	fields["executionRequests"], err = json.Marshal(requests)
+	if err != nil {
+		return nil, errors.Wrap(err, "marshal execution requests")
+	}
+
+	bz, err = json.Marshal(fields)
+	if err != nil {
+		return nil, errors.Wrap(err, "marshal envelope fields")
+	}
+
+	return bz, nil
+}
+
+// UnmarshalJSON unmarshals the embedded envelope and the execution requests.
+func (e *ExecutionPayloadEnvelopeV4) UnmarshalJSON(input []byte) error {
+	if err := e.ExecutionPayloadEnvelope.UnmarshalJSON(input); err != nil {
+		return errors.Wrap(err, "unmarshal envelope")
+	}
+
+	var dec struct {
+		ExecutionRequests []hexutil.Bytes `json:"executionRequests"`
+	}
+	if err := json.Unmarshal(input, &dec); err != nil {
+		return errors.Wrap(err, "unmarshal execution requests")
+	}
+	if dec.ExecutionRequests == nil {
+		return errors.New("missing required field 'executionRequests' for ExecutionPayloadEnvelopeV4")
+	}
+	e.ExecutionRequests = dec.ExecutionRequests
+
+	return nil
+}
@@ -27,6 +27,9 @@ func NewFuzzer(seed int64) *fuzz.Fuzzer {
 			h.WithdrawalsHash = nil
 			h.ParentBeaconRoot = nil
 			h.Nonce = types.BlockNonce{}
+			if h.GasUsed > h.GasLimit { // Gas used can't exceed the gas limit.
+				h.GasUsed, h.GasLimit = h.GasLimit, h.GasUsed
+			}
 		},
 		func(b *types.Block, c fuzz.Continue) {
 			var header types.Header
@@ -21,6 +21,14 @@ type Static struct {
 	ConsensusSeedTXT      []byte
 	ExecutionGenesisJSON  []byte
 	ExecutionSeedTXT      []byte
+	// PragueTime is the timestamp of the execution chain Prague fork, from which the
+	// Engine API V4 payload methods are used. Nil means that the fork isn't scheduled.
+	PragueTime *uint64
+	// RandaoForkHeight is the consensus chain height from which the PrevRandao of execution payloads
+	// is mixed with the vote extension signatures of the extended commits. The mix is biasable by proposers, so it
+	// must not be relied on as a secure randomness source. It must not be below the vote extensions enable height
+	// of the genesis consensus params. Nil means that the fork isn't scheduled.
+	RandaoForkHeight *int64
 }
 
 type Deployment struct {